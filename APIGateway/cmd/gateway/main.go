@@ -1,132 +1,297 @@
 package main
 
 import (
-	"log"
-	"os"
+	"context"
+	"crypto/rand"
+	"log/slog"
+	"net/http"
 
 	"github.com/gin-contrib/cors"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
 
-	"github.com/joho/godotenv"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"github.com/nats-io/nats.go"
 
 	handler "github.com/KaminurOrynbek/BiznesAsh/APIGateway/handler"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/blob"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/grpcclient"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/stream"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/upload"
 	contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	redisclient "github.com/KaminurOrynbek/BiznesAsh_lib/adapter/redis"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/auth"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/config"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/denylist"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/jwks"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
 	notificationpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/notification"
 	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 
 	conpb "github.com/KaminurOrynbek/BiznesAsh/ConsultationService/proto"
 	paypb "github.com/KaminurOrynbek/BiznesAsh/PaymentService/proto"
 	subpb "github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/proto"
 )
 
-var banMismatchCounter = prometheus.NewCounterVec(
-	prometheus.CounterOpts{
-		Name: "user_ban_mismatch_total",
-		Help: "Counts how often a user is marked for ban but their status remains false in DB.",
-	},
-	[]string{"user_id"},
+// The unversioned paths were deprecated when /api/v1 became the canonical
+// namespace and are removed after the sunset date.
+var (
+	legacyDeprecatedSince = time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)
+	legacySunset          = time.Date(2027, time.April, 18, 0, 0, 0, 0, time.UTC)
 )
 
-func init() {
-	prometheus.MustRegister(banMismatchCounter)
-}
-
 func main() {
-	err := godotenv.Load()
-	if err != nil {
-		log.Println("No .env file found or error loading .env file")
+	var cfg config.APIGateway
+	config.MustLoad("APIGateway", &cfg)
+	logging.Init("APIGateway")
+
+	shutdownTracing := tracing.MustInit("APIGateway")
+	defer shutdownTracing(context.Background())
+
+	userConn := grpcclient.MustDial(grpcclient.LoadConfig("UserService", "USER_SERVICE", cfg.Services.User,
+		userpb.UserService_GetUser_FullMethodName,
+		userpb.UserService_GetUsersByIDs_FullMethodName,
+		userpb.UserService_GetCurrentUser_FullMethodName,
+		userpb.UserService_ListUsers_FullMethodName,
+		userpb.UserService_GetUserStats_FullMethodName,
+		userpb.UserService_ListSessions_FullMethodName,
+		userpb.UserService_GetJWKS_FullMethodName,
+	))
+	contentConn := grpcclient.MustDial(grpcclient.LoadConfig("ContentService", "CONTENT_SERVICE", cfg.Services.Content,
+		contentpb.ContentService_GetPost_FullMethodName,
+		contentpb.ContentService_ListPosts_FullMethodName,
+		contentpb.ContentService_SearchPosts_FullMethodName,
+		contentpb.ContentService_ListComments_FullMethodName,
+		contentpb.ContentService_GetMedia_FullMethodName,
+		contentpb.ContentService_GetContentStats_FullMethodName,
+	))
+	notificationConn := grpcclient.MustDial(grpcclient.LoadConfig("NotificationService", "NOTIFICATION_SERVICE", cfg.Services.Notification,
+		notificationpb.NotificationService_GetNotifications_FullMethodName,
+		notificationpb.NotificationService_GetSubscriptions_FullMethodName,
+	))
+	subscriptionConn := grpcclient.MustDial(grpcclient.LoadConfig("SubscriptionService", "SUBSCRIPTION_SERVICE", cfg.Services.Subscription,
+		subpb.SubscriptionService_GetSubscription_FullMethodName,
+		subpb.SubscriptionService_GetSubscriptionHistory_FullMethodName,
+		subpb.SubscriptionService_ListSubscriptions_FullMethodName,
+		subpb.SubscriptionService_GetSubscriptionStats_FullMethodName,
+	))
+	paymentConn := grpcclient.MustDial(grpcclient.LoadConfig("PaymentService", "PAYMENT_SERVICE", cfg.Services.Payment,
+		paypb.PaymentService_GetTransactionHistory_FullMethodName,
+		paypb.PaymentService_GetPaymentStats_FullMethodName,
+	))
+	consultationConn := grpcclient.MustDial(grpcclient.LoadConfig("ConsultationService", "CONSULTATION_SERVICE", cfg.Services.Consultation,
+		conpb.ConsultationService_ListAvailableExperts_FullMethodName,
+		conpb.ConsultationService_GetUserBookings_FullMethodName,
+	))
+
+	userClient := userpb.NewUserServiceClient(userConn)
+	contentClient := contentpb.NewContentServiceClient(contentConn)
+	notificationClient := notificationpb.NewNotificationServiceClient(notificationConn)
+	subscriptionClient := subpb.NewSubscriptionServiceClient(subscriptionConn)
+	paymentClient := paypb.NewPaymentServiceClient(paymentConn)
+	consultationClient := conpb.NewConsultationServiceClient(consultationConn)
+
+	router := gin.New()
+	if err := router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		logging.Fatal("invalid TRUSTED_PROXIES", logging.Err(err))
 	}
+	router.TrustedPlatform = cfg.ClientIPHeader
+	router.Use(gin.Recovery())
 
-	userURL := os.Getenv("USER_SERVICE_URL")
-	if userURL == "" {
-		userURL = "localhost:8081"
+	router.Use(cors.New(cors.Config{
+		AllowOrigins:     cfg.AllowedOrigins,
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "If-None-Match", "Last-Event-ID", middleware.HeaderIdempotencyKey, apierror.HeaderRequestID, "traceparent", "tracestate"},
+		ExposeHeaders:    []string{apierror.HeaderRequestID, "Retry-After", "Deprecation", "Sunset", "Link", "ETag", "X-Cache", middleware.HeaderIdempotentReplayed},
+		AllowCredentials: true,
+		MaxAge:           12 * time.Hour,
+	}))
+	redisClient := connectRedis(cfg.Redis)
+	events := connectNATS(cfg.NATSURL)
+	hub := stream.NewHub(stream.DefaultBacklog)
+	cacheStore := newResponseCacheStore(redisClient)
+	if events != nil {
+		if err := hub.Consume(events); err != nil {
+			slog.Error("failed to subscribe event streams to NATS", logging.Err(err))
+		}
+		if err := middleware.InvalidateCacheOnEvents(events, cacheStore); err != nil {
+			slog.Error("failed to subscribe response cache to NATS", logging.Err(err))
+		}
 	}
-	userConn, err := grpc.Dial(userURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Fatalf("Failed to connect to UserService: %v", err)
+
+	// Access tokens are verified with the public keys UserService publishes.
+	tokenKeys := jwks.NewCache(auth.JWKSFetcher(userClient), jwks.DefaultMaxAge)
+
+	authz := policy.Default()
+	handler.RegisterOwnerChecks(authz, contentClient)
+	router.Use(
+		otelgin.Middleware("APIGateway"),
+		middleware.Metrics(),
+		middleware.RequestID(),
+		middleware.AccessLog(),
+		handler.LegacyDeprecation(legacyDeprecatedSince, legacySunset),
+		middleware.AuthMiddleware(tokenKeys, newDenylist(redisClient)),
+		middleware.RateLimitMiddleware(newRateLimitStore(redisClient), middleware.LoadRateLimits(middleware.DefaultRateLimits)),
+		middleware.PolicyMiddleware(authz),
+		middleware.ResponseCache(cacheStore, middleware.DefaultCacheRules),
+		middleware.Idempotency(newIdempotencyStore(redisClient), middleware.DefaultIdempotencyRules),
+	)
+
+	clients := handler.Clients{
+		User:         userClient,
+		Content:      contentClient,
+		Notification: notificationClient,
+		Subscription: subscriptionClient,
+		Payment:      paymentClient,
+		Consultation: consultationClient,
 	}
+	handler.Mount(router, handler.V1, clients)
+	handler.MountLegacy(router, clients)
+	handler.RegisterGraphQLRoute(router, clients)
+	handler.RegisterEventRoutes(router.Group(handler.V1.Prefix), hub, newTicketStore(redisClient))
 
-	contentURL := os.Getenv("CONTENT_SERVICE_URL")
-	if contentURL == "" {
-		contentURL = "localhost:8082"
+	blobStore, localStore := newBlobStore(cfg.Media)
+	handler.RegisterMediaRoutes(router.Group(handler.V1.Prefix), contentClient, handler.MediaConfig{
+		Store:  blobStore,
+		Limits: upload.LoadLimits(upload.DefaultLimits),
+		URLTTL: handler.DefaultMediaURLTTL,
+	})
+	if localStore != nil {
+		handler.RegisterBlobRoute(router.Group(handler.V1.Prefix), localStore)
 	}
-	contentConn, err := grpc.Dial(contentURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Fatalf("Failed to connect to ContentService: %v", err)
+
+	handler.RegisterHealthRoutes(router, []handler.HealthTarget{
+		{Name: "UserService", Conn: userConn},
+		{Name: "ContentService", Conn: contentConn},
+		{Name: "NotificationService", Conn: notificationConn},
+		{Name: "SubscriptionService", Conn: subscriptionConn},
+		{Name: "PaymentService", Conn: paymentConn},
+		{Name: "ConsultationService", Conn: consultationConn},
+	})
+
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	handler.RegisterOpenAPIRoute(router)
+	handler.RegisterJWKSRoute(router, tokenKeys)
+
+	slog.Info("REST API started", "addr", "http://localhost:"+cfg.Port)
+	if err := router.Run(":" + cfg.Port); err != nil {
+		logging.Fatal("REST API stopped", logging.Err(err))
 	}
+}
 
-	notificationURL := os.Getenv("NOTIFICATION_SERVICE_URL")
-	if notificationURL == "" {
-		notificationURL = "localhost:8083"
+// connectRedis returns a client when Redis is reachable at startup, or nil, so
+// local runs work without Redis.
+func connectRedis(cfg config.Redis) *redis.Client {
+	redisClient := redisclient.NewRedisClient(cfg.Addr, cfg.Password, cfg.DB)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := redisClient.Ping(ctx); err != nil {
+		slog.Warn("Redis unavailable, rate limiting, caching and idempotency keys in memory", logging.Err(err))
+		return nil
 	}
-	notificationConn, err := grpc.Dial(notificationURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Fatalf("Failed to connect to NotificationService: %v", err)
+	slog.Info("connected to Redis, rate limits, cache and idempotency keys shared across replicas")
+	return redisClient.Client
+}
+
+// newRateLimitStore keeps an in-memory store as a fallback for Redis.
+func newRateLimitStore(redisClient *redis.Client) middleware.RateLimitStore {
+	memory := middleware.NewMemoryRateLimitStore()
+	if redisClient == nil {
+		return memory
 	}
+	return middleware.NewFallbackRateLimitStore(middleware.NewRedisRateLimitStore(redisClient), memory)
+}
 
-	subscriptionURL := os.Getenv("SUBSCRIPTION_SERVICE_URL")
-	if subscriptionURL == "" {
-		subscriptionURL = "localhost:8086"
+func newResponseCacheStore(redisClient *redis.Client) middleware.ResponseCacheStore {
+	if redisClient == nil {
+		return middleware.NewMemoryResponseCacheStore()
 	}
-	subscriptionConn, err := grpc.Dial(subscriptionURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Fatalf("Failed to connect to SubscriptionService: %v", err)
+	return middleware.NewRedisResponseCacheStore(redisClient)
+}
+
+func newIdempotencyStore(redisClient *redis.Client) middleware.IdempotencyStore {
+	if redisClient == nil {
+		return middleware.NewMemoryIdempotencyStore()
 	}
+	return middleware.NewRedisIdempotencyStore(redisClient)
+}
 
-	paymentURL := os.Getenv("PAYMENT_SERVICE_URL")
-	if paymentURL == "" {
-		paymentURL = "localhost:8087"
+func newTicketStore(redisClient *redis.Client) stream.TicketStore {
+	if redisClient == nil {
+		return stream.NewMemoryTicketStore()
 	}
-	paymentConn, err := grpc.Dial(paymentURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		log.Fatalf("Failed to connect to PaymentService: %v", err)
+	return stream.NewRedisTicketStore(redisClient)
+}
+
+// newDenylist reads the tokens UserService revoked. Without Redis the gateway
+// can't see them and leaves revocation to the services.
+func newDenylist(redisClient *redis.Client) denylist.Denylist {
+	if redisClient == nil {
+		return nil
+	}
+	return denylist.NewRedis(redisClient)
+}
+
+// newBlobStore picks the media store from cfg.Store: "s3" for an
+// S3-compatible bucket, "local" for the filesystem. The local store is also
+// returned so its signed URLs can be served.
+func newBlobStore(cfg config.Media) (blob.Store, *blob.LocalStore) {
+	if cfg.Store == "s3" {
+		store, err := blob.NewS3Store(blob.S3Config{
+			Endpoint:        cfg.S3.Endpoint,
+			Region:          cfg.S3.Region,
+			Bucket:          cfg.S3.Bucket,
+			AccessKeyID:     cfg.S3.AccessKeyID,
+			SecretAccessKey: cfg.S3.SecretAccessKey,
+			PathStyle:       cfg.S3.PathStyle,
+		}, &http.Client{Timeout: 2 * time.Minute})
+		if err != nil {
+			logging.Fatal("failed to configure S3 media store", logging.Err(err))
+		}
+		return store, nil
 	}
 
-	consultationURL := os.Getenv("CONSULTATION_SERVICE_URL")
-	if consultationURL == "" {
-		consultationURL = "localhost:8088"
+	baseURL := cfg.PublicURL
+	if baseURL == "" {
+		baseURL = handler.V1.Prefix + "/blobs"
+	}
+	secret := []byte(cfg.SigningSecret)
+	if len(secret) == 0 {
+		// Links then stop working on restart and can't be verified by other replicas
+		slog.Warn("MEDIA_SIGNING_SECRET not set, signing media URLs with a per-process key")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			logging.Fatal("failed to generate media signing key", logging.Err(err))
+		}
 	}
-	consultationConn, err := grpc.Dial(consultationURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	store, err := blob.NewLocalStore(cfg.Dir, baseURL, secret)
 	if err != nil {
-		log.Fatalf("Failed to connect to ConsultationService: %v", err)
+		logging.Fatal("failed to configure local media store", logging.Err(err))
 	}
+	return store, store
+}
 
-	userClient := userpb.NewUserServiceClient(userConn)
-	contentClient := contentpb.NewContentServiceClient(contentConn)
-	notificationClient := notificationpb.NewNotificationServiceClient(notificationConn)
-	subscriptionClient := subpb.NewSubscriptionServiceClient(subscriptionConn)
-	paymentClient := paypb.NewPaymentServiceClient(paymentConn)
-	consultationClient := conpb.NewConsultationServiceClient(consultationConn)
-
-	router := gin.Default()
-
-	router.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"http://localhost:5173"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
-		AllowCredentials: true,
-		MaxAge:           12 * time.Hour,
-	}))
-
-	handler.RegisterUserRoutes(router, userClient)
-	handler.RegisterContentRoutes(router, contentClient, userClient)
-	handler.RegisterNotificationRoutes(router, notificationClient)
-	handler.RegisterSubscriptionRoutes(router, subscriptionClient)
-	handler.RegisterPaymentRoutes(router, paymentClient)
-	handler.RegisterConsultationRoutes(router, consultationClient)
-
-	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
-
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
+// connectNATS returns the queue the event streams and cache invalidation
+// consume. The connection retries in the background, so the gateway starts
+// before NATS is up; nil means NATS is not configured correctly.
+func connectNATS(url string) queue.MessageQueue {
+	conn, err := nats.Connect(url,
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+		nats.Timeout(2*time.Second),
+	)
+	if err != nil {
+		slog.Warn("NATS unavailable, event streams carry heartbeats only and the cache relies on TTLs", logging.Err(err))
+		return nil
 	}
-	log.Printf("REST API started at http://localhost:%s", port)
-	router.Run(":" + port)
+	return queue.NewNATSQueue(conn)
 }
@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/dto"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
+	contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+
+	paypb "github.com/KaminurOrynbek/BiznesAsh/PaymentService/proto"
+	subpb "github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/proto"
+)
+
+// dashboardTimeout bounds the whole dashboard fan-out, so one slow service
+// delays the summary by at most this long.
+const dashboardTimeout = 3 * time.Second
+
+// RegisterAdminRoutes adds the admin panel API. The policy rules make it
+// admin-only except for listing, banning and unbanning users, which moderators
+// may do too; the services check the role again.
+func RegisterAdminRoutes(r gin.IRouter, m Mappers, clients Clients) {
+	admin := r.Group("/admin", middleware.RequireAuth())
+
+	// GET /admin/users - users, newest first. Query: search (email or username),
+	// role, status (active, unverified, suspended, banned), page (default 1),
+	// limit (default 20).
+	admin.GET("/users", func(c *gin.Context) {
+		page, _ := parseIntDefault(c.Query("page"), 0)
+		limit, _ := parseIntDefault(c.Query("limit"), 0)
+
+		resp, err := clients.User.ListUsers(middleware.OutgoingContext(c), &userpb.ListUsersRequest{
+			SearchQuery: c.Query("search"),
+			Role:        c.Query("role"),
+			Status:      c.Query("status"),
+			Page:        int32(page),
+			Limit:       int32(limit),
+		})
+		if err != nil {
+			apierror.Respond(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, m.UserPage(resp))
+	})
+
+	// PUT /admin/users/:id/role - body {"role": "user" | "moderator" | "expert" | "admin"}.
+	// Experts can then register an expert profile.
+	admin.PUT("/users/:id/role", func(c *gin.Context) {
+		var req dto.RoleChangeRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+
+		change := map[string]func(context.Context, *userpb.RoleChangeRequest, ...grpc.CallOption) (*userpb.RoleChangeResponse, error){
+			"user":      clients.User.DemoteToUser,
+			"moderator": clients.User.PromoteToModerator,
+			"expert":    clients.User.PromoteToExpert,
+			"admin":     clients.User.PromoteToAdmin,
+		}[req.Role]
+
+		resp, err := change(middleware.OutgoingContext(c), &userpb.RoleChangeRequest{UserId: c.Param("id")})
+		if err != nil {
+			apierror.Respond(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, dto.Message{Message: resp.GetMessage()})
+	})
+
+	// POST /admin/users/:id/ban - optional body {"reason", "details",
+	// "expiresAt"}. With expiresAt the user is suspended until then, otherwise
+	// banned until unbanned.
+	admin.POST("/users/:id/ban", func(c *gin.Context) {
+		var req dto.BanRequest
+		if c.Request.ContentLength != 0 && !bindJSON(c, &req) {
+			return
+		}
+
+		resp, err := clients.User.BanUser(middleware.OutgoingContext(c), &userpb.BanUserRequest{
+			UserId:    c.Param("id"),
+			Reason:    req.Reason,
+			Details:   req.Details,
+			ExpiresAt: req.ExpiresAt,
+		})
+		if err != nil {
+			apierror.Respond(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, dto.Message{Message: resp.GetMessage()})
+	})
+
+	// POST /admin/users/:id/unban - optional body {"reason", "details"}.
+	admin.POST("/users/:id/unban", func(c *gin.Context) {
+		var req dto.UnbanRequest
+		if c.Request.ContentLength != 0 && !bindJSON(c, &req) {
+			return
+		}
+
+		resp, err := clients.User.UnbanUser(middleware.OutgoingContext(c), &userpb.UnbanUserRequest{
+			UserId:  c.Param("id"),
+			Reason:  req.Reason,
+			Details: req.Details,
+		})
+		if err != nil {
+			apierror.Respond(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, dto.Message{Message: resp.GetMessage()})
+	})
+
+	admin.DELETE("/users/:id", func(c *gin.Context) {
+		resp, err := clients.User.DeleteAccount(middleware.OutgoingContext(c), &userpb.UserID{UserId: c.Param("id")})
+		if err != nil {
+			apierror.Respond(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, dto.Message{Message: resp.GetMessage()})
+	})
+
+	// GET /admin/dashboard - counts from every service, fetched in parallel.
+	admin.GET("/dashboard", func(c *gin.Context) {
+		c.JSON(http.StatusOK, dashboard(middleware.OutgoingContext(c), clients))
+	})
+}
+
+// dashboard collects the stats of every service. A service that fails leaves
+// its section null and is named in Unavailable instead of failing the request.
+func dashboard(ctx context.Context, clients Clients) dto.AdminDashboard {
+	ctx, cancel := context.WithTimeout(ctx, dashboardTimeout)
+	defer cancel()
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		out = dto.AdminDashboard{GeneratedAt: time.Now().UTC().Format(time.RFC3339)}
+	)
+	sections := map[string]func() error{
+		"users": func() error {
+			resp, err := clients.User.GetUserStats(ctx, &userpb.Empty{})
+			if err == nil {
+				out.Users = dto.NewUserStats(resp)
+			}
+			return err
+		},
+		"content": func() error {
+			resp, err := clients.Content.GetContentStats(ctx, &contentpb.ContentStatsRequest{})
+			if err == nil {
+				out.Content = dto.NewContentStats(resp)
+			}
+			return err
+		},
+		"subscriptions": func() error {
+			resp, err := clients.Subscription.GetSubscriptionStats(ctx, &subpb.Empty{})
+			if err == nil {
+				out.Subscriptions = dto.NewSubscriptionStats(resp)
+			}
+			return err
+		},
+		"payments": func() error {
+			resp, err := clients.Payment.GetPaymentStats(ctx, &paypb.PaymentStatsRequest{})
+			if err == nil {
+				out.Payments = dto.NewPaymentStats(resp)
+			}
+			return err
+		},
+	}
+	for name, fetch := range sections {
+		wg.Add(1)
+		go func(name string, fetch func() error) {
+			defer wg.Done()
+			err := fetch()
+			if err != nil {
+				slog.WarnContext(ctx, "admin dashboard section unavailable", "section", name, logging.Err(err))
+			}
+			mu.Lock()
+			if err != nil {
+				out.Unavailable = append(out.Unavailable, name)
+			}
+			mu.Unlock()
+		}(name, fetch)
+	}
+	wg.Wait()
+	sort.Strings(out.Unavailable)
+	return out
+}
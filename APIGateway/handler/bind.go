@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// bindJSON decodes the request body into req and checks it against its rules
+// in dto. On failure it writes a 400, listing the offending fields, and
+// returns false.
+func bindJSON(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		apierror.BadRequest(c, err)
+		return false
+	}
+	if err := dto.Validate(req); err != nil {
+		apierror.Respond(c, err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/dto"
+	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/validate"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+)
+
+func decodeProblem(t *testing.T, rec *httptest.ResponseRecorder) apierror.Problem {
+	t.Helper()
+	var p apierror.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+		t.Fatalf("response is not a problem body: %v: %s", err, rec.Body)
+	}
+	return p
+}
+
+func fieldNames(violations []validate.Violation) []string {
+	var names []string
+	for _, v := range violations {
+		names = append(names, v.Field)
+	}
+	return names
+}
+
+func TestBindJSON(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantFields []string
+	}{
+		{
+			name:       "valid body",
+			body:       `{"amount":10,"currency":"KZT","referenceType":"SUBSCRIPTION","referenceId":"s1"}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "malformed JSON",
+			body:       `{"amount":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "wrong JSON type",
+			body:       `{"amount":"ten"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "every violation is listed",
+			body:       `{"amount":0,"currency":"XYZ","referenceType":"SUBSCRIPTION"}`,
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{"amount", "currency", "referenceId"},
+		},
+		{
+			name:       "value out of range",
+			body:       `{"amount":-5,"currency":"KZT","referenceType":"GIFT","referenceId":"s1"}`,
+			wantStatus: http.StatusBadRequest,
+			wantFields: []string{"amount", "referenceType"},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/payments", func(c *gin.Context) {
+		var req dto.ProcessPaymentRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/payments", strings.NewReader(tt.body)))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if rec.Code == http.StatusOK {
+				return
+			}
+			p := decodeProblem(t, rec)
+			if p.Code != apierror.CodeInvalidArgument {
+				t.Errorf("code %q, want %q", p.Code, apierror.CodeInvalidArgument)
+			}
+			if got := fieldNames(p.Fields); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("fields %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+// TestProtoRequestValidation checks that bodies bound straight into protobuf
+// requests are rejected by the client interceptor with the same problem body,
+// before the service is called.
+func TestProtoRequestValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        *userpb.RegisterRequest
+		wantFields []string
+	}{
+		{
+			name: "valid request",
+			req:  &userpb.RegisterRequest{Email: "alice@example.com", Username: "alice", Password: "secret123"},
+		},
+		{
+			name:       "invalid request",
+			req:        &userpb.RegisterRequest{Email: "alice", Username: "a b", Password: "x"},
+			wantFields: []string{"email", "username", "password"},
+		},
+	}
+
+	intercept := validate.UnaryClientInterceptor()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+				called = true
+				return nil
+			}
+			err := intercept(context.Background(), "/user.UserService/RegisterUser", tt.req, nil, nil, invoker)
+			if called != (tt.wantFields == nil) {
+				t.Fatalf("service called = %t, want %t", called, tt.wantFields == nil)
+			}
+			if err == nil {
+				return
+			}
+
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			apierror.Respond(c, err)
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("status %d, want 400", rec.Code)
+			}
+			if got := fieldNames(decodeProblem(t, rec).Fields); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("fields %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
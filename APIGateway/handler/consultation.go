@@ -1,84 +1,140 @@
 package handler
 
 import (
-	"context"
 	"net/http"
 
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/dto"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
 	pb "github.com/KaminurOrynbek/BiznesAsh/ConsultationService/proto"
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterConsultationRoutes(r *gin.Engine, client pb.ConsultationServiceClient) {
-	api := r.Group("/api/v1/consultations")
+func RegisterConsultationRoutes(r gin.IRouter, client pb.ConsultationServiceClient) {
+	api := r.Group("/consultations")
 
 	api.GET("/experts", func(c *gin.Context) {
-		resp, err := client.ListAvailableExperts(context.Background(), &pb.Filter{})
+		resp, err := client.ListAvailableExperts(middleware.OutgoingContext(c), &pb.Filter{})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
 	})
 
-	api.POST("/book", func(c *gin.Context) {
-		var req struct {
-			UserID      string `json:"userId"`
-			ExpertID    string `json:"expertId"`
-			ExpertName  string `json:"expertName"`
-			ScheduledAt string `json:"scheduledAt"`
+	authed := api.Group("", middleware.RequireAuth())
+
+	// Experts (and admins) publish a consultation profile; see policy.Rules.
+	authed.POST("/experts", func(c *gin.Context) {
+		var req dto.RegisterExpertRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+
+		userID, ok := targetUserID(c, req.UserID)
+		if !ok {
+			return
+		}
+
+		resp, err := client.RegisterExpert(middleware.OutgoingContext(c), &pb.ExpertData{
+			UserId:          userID,
+			Specialization:  req.Specialization,
+			PricePerSession: req.PricePerSession,
+		})
+		if err != nil {
+			apierror.Respond(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	authed.POST("/book", func(c *gin.Context) {
+		var req dto.BookConsultationRequest
+		if !bindJSON(c, &req) {
+			return
 		}
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		userID, ok := targetUserID(c, req.UserID)
+		if !ok {
 			return
 		}
 
-		resp, err := client.CreateBooking(context.Background(), &pb.BookingData{
-			UserId:      req.UserID,
+		resp, err := client.CreateBooking(middleware.OutgoingContext(c), &pb.BookingData{
+			UserId:      userID,
 			ExpertId:    req.ExpertID,
 			ExpertName:  req.ExpertName,
 			ScheduledAt: req.ScheduledAt,
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
 	})
 
-	api.POST("/confirm/:bookingId", func(c *gin.Context) {
+	authed.POST("/confirm/:bookingId", func(c *gin.Context) {
 		bookingId := c.Param("bookingId")
-		_, err := client.ConfirmBookingPayment(context.Background(), &pb.ConfirmPaymentRequest{BookingId: bookingId})
+		if !checkBookingOwner(c, client, bookingId) {
+			return
+		}
+
+		_, err := client.ConfirmBookingPayment(middleware.OutgoingContext(c), &pb.ConfirmPaymentRequest{BookingId: bookingId})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
-		c.JSON(http.StatusOK, gin.H{"status": "confirmed"})
+		c.JSON(http.StatusOK, dto.Status{Status: "confirmed"})
 	})
 
-	api.GET("/user/:userId", func(c *gin.Context) {
-		userId := c.Param("userId")
-		resp, err := client.GetUserBookings(context.Background(), &pb.GetUserBookingsRequest{UserId: userId})
+	bookings := func(c *gin.Context) {
+		userID, ok := targetUserID(c, c.Param("userId"))
+		if !ok {
+			return
+		}
+		resp, err := client.GetUserBookings(middleware.OutgoingContext(c), &pb.GetUserBookingsRequest{UserId: userID})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp.Bookings)
-	})
+	}
+	authed.GET("/me", bookings)
+	authed.GET("/user/:userId", bookings) // own id, or any id for admins
 
-	api.POST("/cancel", func(c *gin.Context) {
-		var req struct {
-			BookingID string `json:"bookingId"`
+	authed.POST("/cancel", func(c *gin.Context) {
+		var req dto.CancelBookingRequest
+		if !bindJSON(c, &req) {
+			return
 		}
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if !checkBookingOwner(c, client, req.BookingID) {
 			return
 		}
 
-		resp, err := client.CancelBooking(context.Background(), &pb.CancelBookingRequest{BookingId: req.BookingID})
+		resp, err := client.CancelBooking(middleware.OutgoingContext(c), &pb.CancelBookingRequest{BookingId: req.BookingID})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
 	})
 }
+
+// checkBookingOwner lets admins through and otherwise requires the booking to
+// belong to the caller. On refusal it writes the response and returns false.
+func checkBookingOwner(c *gin.Context, client pb.ConsultationServiceClient, bookingID string) bool {
+	if middleware.IsAdmin(c) {
+		return true
+	}
+	resp, err := client.GetUserBookings(middleware.OutgoingContext(c), &pb.GetUserBookingsRequest{UserId: middleware.UserID(c)})
+	if err != nil {
+		apierror.Respond(c, err)
+		return false
+	}
+	for _, b := range resp.GetBookings() {
+		if b.GetId() == bookingID {
+			return true
+		}
+	}
+	apierror.Abort(c, http.StatusForbidden, "you can only manage your own bookings")
+	return false
+}
@@ -2,23 +2,23 @@ package handler
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"net/http"
 	"strconv"
-	"strings"
 
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/dto"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
 	contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
 	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
 	"github.com/gin-gonic/gin"
-	"google.golang.org/grpc/metadata"
 )
 
-func RegisterContentRoutes(r *gin.Engine, contentClient contentpb.ContentServiceClient, userClient userpb.UserServiceClient) {
+func RegisterContentRoutes(r gin.IRouter, m Mappers, contentClient contentpb.ContentServiceClient, userClient userpb.UserServiceClient) {
 	content := r.Group("/content")
 
-	createPost := createPostHandler(contentClient, userClient)
-	r.POST("/posts", createPost)       // frontend may call this (e.g. cached or env)
-	content.POST("/posts", createPost) // canonical path
+	content.POST("/posts", createPostHandler(contentClient, userClient, m))
 
 	// GET /content/posts - list posts (feed). Query: skip (default 0), limit (default 20)
 	content.GET("/posts", func(c *gin.Context) {
@@ -31,87 +31,58 @@ func RegisterContentRoutes(r *gin.Engine, contentClient contentpb.ContentService
 			limit = 100
 		}
 		page := skip/limit + 1
-		userID := getCurrentUserID(c, userClient)
+		userID := middleware.UserID(c)
 
-		resp, err := contentClient.ListPosts(context.Background(), &contentpb.ListPostsRequest{
+		resp, err := contentClient.ListPosts(middleware.OutgoingContext(c), &contentpb.ListPostsRequest{
 			Page:   int32(page),
 			Limit:  int32(limit),
 			UserId: userID,
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		posts := resp.GetPosts()
-		authorMap := fetchUsernames(posts, nil, userClient)
+		authorMap := fetchUsernames(middleware.OutgoingContext(c), posts, nil, userClient)
 
-		var enriched []gin.H
+		out := make([]interface{}, 0, len(posts))
 		for _, p := range posts {
-			enriched = append(enriched, gin.H{
-				"id":             p.GetId(),
-				"content":        p.GetContent(),
-				"authorId":       p.GetAuthorId(),
-				"authorUsername": authorMap[p.GetAuthorId()],
-				"likesCount":     p.GetLikesCount(),
-				"commentsCount":  p.GetCommentsCount(),
-				"createdAt":      p.GetCreatedAt(),
-				"updatedAt":      p.GetUpdatedAt(),
-				"liked":          p.GetLiked(),
-				"images":         p.GetImages(),
-				"files":          p.GetFiles(),
-				"poll":           p.GetPoll(),
-			})
+			out = append(out, m.Post(p, authorMap[p.GetAuthorId()]))
 		}
-		c.JSON(http.StatusOK, enriched)
+		c.JSON(http.StatusOK, out)
 	})
 
 	content.GET("/posts/:id", func(c *gin.Context) {
 		id := c.Param("id")
-		userID := getCurrentUserID(c, userClient)
-		resp, err := contentClient.GetPost(context.Background(), &contentpb.PostIdRequest{Id: id, UserId: userID})
+		userID := middleware.UserID(c)
+		resp, err := contentClient.GetPost(middleware.OutgoingContext(c), &contentpb.PostIdRequest{Id: id, UserId: userID})
 		if err != nil {
-			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		p := resp.GetPost()
-		u, err := userClient.GetUser(context.Background(), &userpb.GetUserRequest{UserId: p.GetAuthorId()})
-		if err != nil {
-			log.Printf("Error fetching user %s: %v", p.GetAuthorId(), err)
-		}
-		c.JSON(http.StatusOK, gin.H{
-			"id":             p.GetId(),
-			"content":        p.GetContent(),
-			"authorId":       p.GetAuthorId(),
-			"authorUsername": u.GetUsername(),
-			"likesCount":     p.GetLikesCount(),
-			"commentsCount":  p.GetCommentsCount(),
-			"createdAt":      p.GetCreatedAt(),
-			"updatedAt":      p.GetUpdatedAt(),
-			"liked":          p.GetLiked(),
-			"images":         p.GetImages(),
-			"files":          p.GetFiles(),
-			"poll":           p.GetPoll(),
-		})
+		authorMap := fetchUsernames(middleware.OutgoingContext(c), []*contentpb.Post{p}, nil, userClient)
+		c.JSON(http.StatusOK, m.Post(p, authorMap[p.GetAuthorId()]))
 	})
 
 	// Legacy Like support
-	content.POST("/posts/:id/like", likePostHandler(contentClient, userClient))
-	content.DELETE("/posts/:id/like", unlikePostHandler(contentClient, userClient))
+	content.POST("/posts/:id/like", likePostHandler(contentClient))
+	content.DELETE("/posts/:id/like", unlikePostHandler(contentClient))
 
 	// Legacy Like Comment support
-	content.POST("/comments/:id/like", likeCommentHandler(contentClient, userClient))
-	content.DELETE("/comments/:id/like", unlikeCommentHandler(contentClient, userClient))
+	content.POST("/comments/:id/like", likeCommentHandler(contentClient))
+	content.DELETE("/comments/:id/like", unlikeCommentHandler(contentClient))
 
 	// Comment routes
-	content.POST("/posts/:id/comments", createCommentHandler(contentClient, userClient))
-	content.GET("/posts/:id/comments", listCommentsHandler(contentClient, userClient))
-	content.DELETE("/comments/:id", deleteCommentHandler(contentClient, userClient))
+	content.POST("/posts/:id/comments", createCommentHandler(contentClient, userClient, m))
+	content.GET("/posts/:id/comments", listCommentsHandler(contentClient, userClient, m))
+	content.DELETE("/comments/:id", deleteCommentHandler(contentClient))
 
 	// Poll routes
-	content.POST("/posts/:id/poll/vote", votePollHandler(contentClient, userClient))
+	content.POST("/posts/:id/poll/vote", votePollHandler(contentClient, m))
 
 	// Post Management
-	content.DELETE("/posts/:id", deletePostHandler(contentClient, userClient))
+	content.DELETE("/posts/:id", deletePostHandler(contentClient))
 }
 
 // func createPostHandler(client contentpb.ContentServiceClient) gin.HandlerFunc {
@@ -131,191 +102,151 @@ func RegisterContentRoutes(r *gin.Engine, contentClient contentpb.ContentService
 // 	}
 // }
 
-func createPostHandler(client contentpb.ContentServiceClient, userClient userpb.UserServiceClient) gin.HandlerFunc {
+func createPostHandler(client contentpb.ContentServiceClient, userClient userpb.UserServiceClient, m Mappers) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var req contentpb.CreatePostRequest
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if !bindJSON(c, &req) {
 			return
 		}
 
 		// get current user id from token
-		userID := getCurrentUserID(c, userClient)
+		userID := middleware.UserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization required"})
+			apierror.Abort(c, http.StatusUnauthorized, "authorization required")
 			return
 		}
 
 		req.AuthorId = userID
 
-		resp, err := client.CreatePost(context.Background(), &req)
+		resp, err := client.CreatePost(middleware.OutgoingContext(c), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 
 		p := resp.GetPost()
-		u, _ := userClient.GetUser(context.Background(), &userpb.GetUserRequest{UserId: p.GetAuthorId()})
-		c.JSON(http.StatusOK, gin.H{
-			"id":             p.GetId(),
-			"content":        p.GetContent(),
-			"authorId":       p.GetAuthorId(),
-			"authorUsername": u.GetUsername(),
-			"createdAt":      p.GetCreatedAt(),
-			"images":         p.GetImages(),
-			"files":          p.GetFiles(),
-			"poll":           p.GetPoll(),
-		})
+		u, _ := userClient.GetUser(middleware.OutgoingContext(c), &userpb.GetUserRequest{UserId: p.GetAuthorId()})
+		c.JSON(http.StatusOK, m.Post(p, u.GetUsername()))
 	}
 }
 
-// getCurrentUserID returns the current user's ID from the Authorization header, or empty string if missing/invalid.
-func getCurrentUserID(c *gin.Context, userClient userpb.UserServiceClient) string {
-	authHeader := c.GetHeader("Authorization")
-	if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-		return ""
-	}
-	ctx := metadata.NewOutgoingContext(c.Request.Context(), metadata.Pairs("authorization", authHeader))
-	resp, err := userClient.GetCurrentUser(ctx, &userpb.Empty{})
-	if err != nil {
-		return ""
-	}
-	return resp.GetUserId()
-}
-
-func deletePostHandler(contentClient contentpb.ContentServiceClient, userClient userpb.UserServiceClient) gin.HandlerFunc {
+func deletePostHandler(contentClient contentpb.ContentServiceClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		postID := c.Param("id")
-		userID := getCurrentUserID(c, userClient)
-		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization required"})
-			return
-		}
-
-		resp, err := contentClient.GetPost(context.Background(), &contentpb.PostIdRequest{Id: postID})
-		if err != nil {
-			c.JSON(http.StatusNotFound, gin.H{"error": "post not found"})
-			return
-		}
 
-		if resp.GetPost().GetAuthorId() != userID {
-			c.JSON(http.StatusForbidden, gin.H{"error": "you can only delete your own posts"})
-			return
-		}
-
-		_, err = contentClient.DeletePost(context.Background(), &contentpb.PostIdRequest{Id: postID})
+		// Authorship (or a moderator role) is enforced by the policy middleware.
+		_, err := contentClient.DeletePost(middleware.OutgoingContext(c), &contentpb.PostIdRequest{Id: postID})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"message": "post deleted successfully"})
+		c.JSON(http.StatusOK, dto.Message{Message: "post deleted successfully"})
 	}
 }
 
-func deleteCommentHandler(contentClient contentpb.ContentServiceClient, userClient userpb.UserServiceClient) gin.HandlerFunc {
+func deleteCommentHandler(contentClient contentpb.ContentServiceClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		commentID := c.Param("id")
 		if commentID == "" {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "comment id required"})
+			apierror.Abort(c, http.StatusBadRequest, "comment id required")
 			return
 		}
 
-		_, err := contentClient.DeleteComment(context.Background(), &contentpb.CommentIdRequest{Id: commentID})
+		_, err := contentClient.DeleteComment(middleware.OutgoingContext(c), &contentpb.CommentIdRequest{Id: commentID})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"message": "comment deleted"})
+		c.JSON(http.StatusOK, dto.Message{Message: "comment deleted"})
 	}
 }
 
-func createCommentHandler(client contentpb.ContentServiceClient, userClient userpb.UserServiceClient) gin.HandlerFunc {
+func createCommentHandler(client contentpb.ContentServiceClient, userClient userpb.UserServiceClient, m Mappers) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		postID := c.Param("id")
 		var req contentpb.CreateCommentRequest
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if !bindJSON(c, &req) {
 			return
 		}
 
-		userID := getCurrentUserID(c, userClient)
+		userID := middleware.UserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization required"})
+			apierror.Abort(c, http.StatusUnauthorized, "authorization required")
 			return
 		}
 
 		req.PostId = postID
 		req.AuthorId = userID
 
-		resp, err := client.CreateComment(context.Background(), &req)
+		resp, err := client.CreateComment(middleware.OutgoingContext(c), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, resp.GetComment())
+		com := resp.GetComment()
+		authorMap := fetchUsernames(middleware.OutgoingContext(c), nil, []*contentpb.Comment{com}, userClient)
+		c.JSON(http.StatusOK, m.Comment(com, authorMap[com.GetAuthorId()]))
 	}
 }
 
-func listCommentsHandler(client contentpb.ContentServiceClient, userClient userpb.UserServiceClient) gin.HandlerFunc {
+func listCommentsHandler(client contentpb.ContentServiceClient, userClient userpb.UserServiceClient, m Mappers) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		postID := c.Param("id")
-		userID := getCurrentUserID(c, userClient)
-		resp, err := client.ListComments(context.Background(), &contentpb.ListCommentsRequest{PostId: postID, UserId: userID})
+		userID := middleware.UserID(c)
+		resp, err := client.ListComments(middleware.OutgoingContext(c), &contentpb.ListCommentsRequest{PostId: postID, UserId: userID})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 
 		comments := resp.GetComments()
-		authorMap := fetchUsernames(nil, comments, userClient)
+		authorMap := fetchUsernames(middleware.OutgoingContext(c), nil, comments, userClient)
 
-		var enriched []gin.H
+		out := make([]interface{}, 0, len(comments))
 		for _, com := range comments {
-			enriched = append(enriched, gin.H{
-				"id":             com.GetId(),
-				"postId":         com.GetPostId(),
-				"authorId":       com.GetAuthorId(),
-				"authorUsername": authorMap[com.GetAuthorId()],
-				"content":        com.GetContent(),
-				"createdAt":      com.GetCreatedAt(),
-				"updatedAt":      com.GetUpdatedAt(),
-				"liked":          com.GetLiked(),
-				"likesCount":     com.GetLikesCount(),
-			})
+			out = append(out, m.Comment(com, authorMap[com.GetAuthorId()]))
 		}
 
-		c.JSON(http.StatusOK, enriched)
+		c.JSON(http.StatusOK, out)
 	}
 }
 
-func fetchUsernames(posts []*contentpb.Post, comments []*contentpb.Comment, userClient userpb.UserServiceClient) map[string]string {
-	authorIDs := make(map[string]bool)
+// fetchUsernames resolves the authors of posts and comments with a single
+// GetUsersByIDs call, falling back to "User <id prefix>" for unknown ids.
+func fetchUsernames(ctx context.Context, posts []*contentpb.Post, comments []*contentpb.Comment, userClient userpb.UserServiceClient) map[string]string {
+	var ids []string
 	for _, p := range posts {
-		authorIDs[p.GetAuthorId()] = true
+		ids = append(ids, p.GetAuthorId())
 	}
 	for _, c := range comments {
-		authorIDs[c.GetAuthorId()] = true
+		ids = append(ids, c.GetAuthorId())
 	}
 
-	res := make(map[string]string)
-	for id := range authorIDs {
-		u, err := userClient.GetUser(context.Background(), &userpb.GetUserRequest{UserId: id})
-		if err == nil {
-			displayName := u.GetUsername()
-			if displayName == "" {
-				displayName = "User " + id[:5] // fallback for missing username
-			}
-			res[id] = displayName
-		} else {
-			fallback := id
-			if len(id) > 5 {
-				fallback = id[:5]
-			}
-			res[id] = "User " + fallback
+	found := make(map[string]string)
+	if len(ids) > 0 {
+		resp, err := userClient.GetUsersByIDs(ctx, &userpb.GetUsersByIDsRequest{UserIds: ids})
+		if err != nil {
+			slog.WarnContext(ctx, "fetching comment authors failed", logging.Err(err))
 		}
+		for _, u := range resp.GetUsers() {
+			found[u.GetUserId()] = u.GetUsername()
+		}
+	}
+
+	res := make(map[string]string, len(ids))
+	for _, id := range ids {
+		if name := found[id]; name != "" {
+			res[id] = name
+			continue
+		}
+		fallback := id
+		if len(id) > 5 {
+			fallback = id[:5]
+		}
+		res[id] = "User " + fallback
 	}
 	return res
 }
@@ -331,112 +262,109 @@ func parseIntDefault(s string, defaultVal int) (int, bool) {
 	return n, true
 }
 
-func likePostHandler(contentClient contentpb.ContentServiceClient, userClient userpb.UserServiceClient) gin.HandlerFunc {
+func likePostHandler(contentClient contentpb.ContentServiceClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		postID := c.Param("id")
-		userID := getCurrentUserID(c, userClient)
+		userID := middleware.UserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization required"})
+			apierror.Abort(c, http.StatusUnauthorized, "authorization required")
 			return
 		}
-		resp, err := contentClient.LikePost(context.Background(), &contentpb.LikePostRequest{
+		resp, err := contentClient.LikePost(middleware.OutgoingContext(c), &contentpb.LikePostRequest{
 			PostId: postID,
 			UserId: userID,
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
-		c.JSON(http.StatusOK, gin.H{"likesCount": resp.GetLikesCount()})
+		c.JSON(http.StatusOK, dto.LikesCount{LikesCount: resp.GetLikesCount()})
 	}
 }
 
-func unlikePostHandler(contentClient contentpb.ContentServiceClient, userClient userpb.UserServiceClient) gin.HandlerFunc {
+func unlikePostHandler(contentClient contentpb.ContentServiceClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		postID := c.Param("id")
-		userID := getCurrentUserID(c, userClient)
+		userID := middleware.UserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization required"})
+			apierror.Abort(c, http.StatusUnauthorized, "authorization required")
 			return
 		}
-		resp, err := contentClient.UnlikePost(context.Background(), &contentpb.UnlikePostRequest{
+		resp, err := contentClient.UnlikePost(middleware.OutgoingContext(c), &contentpb.UnlikePostRequest{
 			PostId: postID,
 			UserId: userID,
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
-		c.JSON(http.StatusOK, gin.H{"likesCount": resp.GetLikesCount()})
+		c.JSON(http.StatusOK, dto.LikesCount{LikesCount: resp.GetLikesCount()})
 	}
 }
 
-func likeCommentHandler(contentClient contentpb.ContentServiceClient, userClient userpb.UserServiceClient) gin.HandlerFunc {
+func likeCommentHandler(contentClient contentpb.ContentServiceClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		commentID := c.Param("id")
-		userID := getCurrentUserID(c, userClient)
+		userID := middleware.UserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization required"})
+			apierror.Abort(c, http.StatusUnauthorized, "authorization required")
 			return
 		}
-		resp, err := contentClient.LikeComment(context.Background(), &contentpb.LikeCommentRequest{
+		resp, err := contentClient.LikeComment(middleware.OutgoingContext(c), &contentpb.LikeCommentRequest{
 			CommentId: commentID,
 			UserId:    userID,
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
-		c.JSON(http.StatusOK, gin.H{"likesCount": resp.GetLikesCount()})
+		c.JSON(http.StatusOK, dto.LikesCount{LikesCount: resp.GetLikesCount()})
 	}
 }
 
-func unlikeCommentHandler(contentClient contentpb.ContentServiceClient, userClient userpb.UserServiceClient) gin.HandlerFunc {
+func unlikeCommentHandler(contentClient contentpb.ContentServiceClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		commentID := c.Param("id")
-		userID := getCurrentUserID(c, userClient)
+		userID := middleware.UserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization required"})
+			apierror.Abort(c, http.StatusUnauthorized, "authorization required")
 			return
 		}
-		resp, err := contentClient.UnlikeComment(context.Background(), &contentpb.UnlikeCommentRequest{
+		resp, err := contentClient.UnlikeComment(middleware.OutgoingContext(c), &contentpb.UnlikeCommentRequest{
 			CommentId: commentID,
 			UserId:    userID,
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
-		c.JSON(http.StatusOK, gin.H{"likesCount": resp.GetLikesCount()})
+		c.JSON(http.StatusOK, dto.LikesCount{LikesCount: resp.GetLikesCount()})
 	}
 }
-func votePollHandler(contentClient contentpb.ContentServiceClient, userClient userpb.UserServiceClient) gin.HandlerFunc {
+func votePollHandler(contentClient contentpb.ContentServiceClient, m Mappers) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		postID := c.Param("id")
-		var body struct {
-			OptionId string `json:"optionId"`
-		}
-		if err := c.BindJSON(&body); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		var body dto.VotePollRequest
+		if !bindJSON(c, &body) {
 			return
 		}
 
-		userID := getCurrentUserID(c, userClient)
+		userID := middleware.UserID(c)
 		if userID == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "authorization required"})
+			apierror.Abort(c, http.StatusUnauthorized, "authorization required")
 			return
 		}
 
-		resp, err := contentClient.VotePoll(context.Background(), &contentpb.VotePollRequest{
+		resp, err := contentClient.VotePoll(middleware.OutgoingContext(c), &contentpb.VotePollRequest{
 			PostId:   postID,
-			OptionId: body.OptionId,
+			OptionId: body.OptionID,
 			UserId:   userID,
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, resp.GetPoll())
+		c.JSON(http.StatusOK, m.Poll(resp.GetPoll()))
 	}
 }
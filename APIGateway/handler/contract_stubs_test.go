@@ -0,0 +1,376 @@
+package handler_test
+
+import (
+	"context"
+
+	contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/jwks"
+	notificationpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/notification"
+	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	conpb "github.com/KaminurOrynbek/BiznesAsh/ConsultationService/proto"
+	paypb "github.com/KaminurOrynbek/BiznesAsh/PaymentService/proto"
+	subpb "github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/proto"
+)
+
+// The stubs answer the RPCs the handlers call with fully populated messages, so
+// every response field the gateway maps shows up in the checked JSON. Calling an
+// RPC a stub doesn't override panics through the nil embedded interface, which
+// flags a handler that started depending on a new RPC.
+
+var (
+	user = &userpb.UserResponse{
+		UserId:    "u1",
+		Email:     "alice@example.com",
+		Username:  "alice",
+		Role:      "admin",
+		Bio:       "hi",
+		CreatedAt: "2024-01-01T00:00:00Z",
+		UpdatedAt: "2024-01-02T00:00:00Z",
+	}
+	poll = &contentpb.Poll{
+		Id:       "p1",
+		Question: "Yes?",
+		Options: []*contentpb.PollOption{
+			{Id: "o1", Text: "Yes", VotesCount: 2},
+			{Id: "o2", Text: "No", VotesCount: 1},
+		},
+		TotalVotes:        3,
+		ExpiresAt:         "2030-01-01T00:00:00Z",
+		UserVotedOptionId: "o1",
+	}
+	media = &contentpb.Media{
+		Id:           "m1",
+		OwnerId:      "u1",
+		Kind:         contentpb.MediaKind_MEDIA_IMAGE,
+		ContentType:  "image/png",
+		Size:         70,
+		Filename:     "a.png",
+		HasThumbnail: true,
+		PostId:       "1",
+		CreatedAt:    "2024-01-01T00:00:00Z",
+	}
+	post = &contentpb.Post{
+		Id:            "1",
+		Title:         "Hello",
+		Content:       "World",
+		AuthorId:      "u1",
+		Published:     true,
+		LikesCount:    1,
+		CreatedAt:     "2024-01-01T00:00:00Z",
+		UpdatedAt:     "2024-01-01T00:00:00Z",
+		CommentsCount: 1,
+		Liked:         true,
+		Images:        []string{"a.png"},
+		Files:         []string{"a.pdf"},
+		Poll:          poll,
+		Media:         []*contentpb.Media{media},
+	}
+	comment = &contentpb.Comment{
+		Id:         "c1",
+		PostId:     "1",
+		AuthorId:   "u1",
+		Content:    "Nice",
+		CreatedAt:  timestamppb.Now(),
+		UpdatedAt:  timestamppb.Now(),
+		Liked:      true,
+		LikesCount: 1,
+	}
+	subscription = &subpb.SubscriptionResponse{
+		Id:       "s1",
+		UserId:   "u1",
+		PlanType: "PRO",
+		Status:   "ACTIVE",
+		StartsAt: "2024-01-01T00:00:00Z",
+		EndsAt:   "2024-02-01T00:00:00Z",
+	}
+	payment = &paypb.PaymentResponse{Id: "t1", Status: "SUCCESS", CreatedAt: "2024-01-01T00:00:00Z"}
+	booking = &conpb.BookingDetail{
+		Id:          "1",
+		UserId:      "u1",
+		ExpertId:    "e1",
+		Status:      "PENDING",
+		ScheduledAt: "2030-01-01T10:00:00Z",
+		MeetingLink: "https://meet.example.com/1",
+		CreatedAt:   "2024-01-01T00:00:00Z",
+		ExpertName:  "Bob",
+	}
+	sent = &notificationpb.NotificationResponse{Success: true, Message: "sent"}
+)
+
+type userStub struct{ userpb.UserServiceClient }
+
+func (userStub) Register(context.Context, *userpb.RegisterRequest, ...grpc.CallOption) (*userpb.RegisterResponse, error) {
+	return &userpb.RegisterResponse{UserId: user.UserId, Username: user.Username, Email: user.Email, Role: user.Role, Token: "token", RefreshToken: "refresh", ExpiresIn: 900}, nil
+}
+
+func (userStub) Login(context.Context, *userpb.LoginRequest, ...grpc.CallOption) (*userpb.LoginResponse, error) {
+	return &userpb.LoginResponse{UserId: user.UserId, Token: "token", RefreshToken: "refresh", ExpiresIn: 900}, nil
+}
+
+func (userStub) RefreshToken(context.Context, *userpb.RefreshTokenRequest, ...grpc.CallOption) (*userpb.LoginResponse, error) {
+	return &userpb.LoginResponse{UserId: user.UserId, Token: "token", RefreshToken: "refresh", ExpiresIn: 900}, nil
+}
+
+func (userStub) Logout(context.Context, *userpb.LogoutRequest, ...grpc.CallOption) (*userpb.LogoutResponse, error) {
+	return &userpb.LogoutResponse{Success: true, Message: "Logged out", SessionsRevoked: 1}, nil
+}
+
+func (userStub) LogoutAllSessions(context.Context, *userpb.Empty, ...grpc.CallOption) (*userpb.LogoutResponse, error) {
+	return &userpb.LogoutResponse{Success: true, Message: "Logged out of all sessions", SessionsRevoked: 2}, nil
+}
+
+func (userStub) RequestPasswordReset(context.Context, *userpb.PasswordResetRequest, ...grpc.CallOption) (*userpb.PasswordResponse, error) {
+	return &userpb.PasswordResponse{Success: true, Message: "If the address belongs to an account, a reset link has been sent to it"}, nil
+}
+
+func (userStub) ResetPassword(context.Context, *userpb.ResetPasswordRequest, ...grpc.CallOption) (*userpb.PasswordResponse, error) {
+	return &userpb.PasswordResponse{Success: true, Message: "Password reset, please log in again", SessionsRevoked: 2}, nil
+}
+
+func (userStub) ChangePassword(context.Context, *userpb.ChangePasswordRequest, ...grpc.CallOption) (*userpb.PasswordResponse, error) {
+	return &userpb.PasswordResponse{Success: true, Message: "Password changed, please log in again", SessionsRevoked: 2}, nil
+}
+
+func (userStub) ListSessions(context.Context, *userpb.Empty, ...grpc.CallOption) (*userpb.SessionsResponse, error) {
+	return &userpb.SessionsResponse{Sessions: []*userpb.Session{{
+		Id:         "s1",
+		Device:     "Mozilla/5.0",
+		Ip:         "203.0.113.7",
+		CreatedAt:  "2024-01-01T00:00:00Z",
+		LastSeenAt: "2024-01-02T00:00:00Z",
+		ExpiresAt:  "2024-02-01T00:00:00Z",
+		Current:    true,
+	}}}, nil
+}
+
+func (userStub) GetCurrentUser(context.Context, *userpb.Empty, ...grpc.CallOption) (*userpb.UserResponse, error) {
+	return user, nil
+}
+
+func (userStub) GetUser(context.Context, *userpb.GetUserRequest, ...grpc.CallOption) (*userpb.UserResponse, error) {
+	return user, nil
+}
+
+func (userStub) GetUsersByIDs(context.Context, *userpb.GetUsersByIDsRequest, ...grpc.CallOption) (*userpb.UsersListResponse, error) {
+	return &userpb.UsersListResponse{Users: []*userpb.UserResponse{user}}, nil
+}
+
+func (userStub) UpdateProfile(context.Context, *userpb.UpdateProfileRequest, ...grpc.CallOption) (*userpb.UserResponse, error) {
+	return user, nil
+}
+
+func (userStub) ListUsers(context.Context, *userpb.ListUsersRequest, ...grpc.CallOption) (*userpb.UsersListResponse, error) {
+	return &userpb.UsersListResponse{Users: []*userpb.UserResponse{user}, Total: 1, Page: 1, TotalPages: 1}, nil
+}
+
+func (userStub) PromoteToModerator(context.Context, *userpb.RoleChangeRequest, ...grpc.CallOption) (*userpb.RoleChangeResponse, error) {
+	return &userpb.RoleChangeResponse{Success: true, Message: "role changed"}, nil
+}
+
+func (userStub) GetJWKS(context.Context, *userpb.Empty, ...grpc.CallOption) (*userpb.JWKSResponse, error) {
+	key, err := jwks.NewKey(tokenKeyID, tokenKey.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &userpb.JWKSResponse{Keys: []*userpb.JWK{{Kty: key.Kty, Kid: key.Kid, Alg: key.Alg, Use: key.Use, Crv: key.Crv, X: key.X}}}, nil
+}
+
+func (userStub) BanUser(context.Context, *userpb.BanUserRequest, ...grpc.CallOption) (*userpb.BanUserResponse, error) {
+	return &userpb.BanUserResponse{Success: true, Message: "user banned"}, nil
+}
+
+func (userStub) UnbanUser(context.Context, *userpb.UnbanUserRequest, ...grpc.CallOption) (*userpb.BanUserResponse, error) {
+	return &userpb.BanUserResponse{Success: true, Message: "user unbanned"}, nil
+}
+
+func (userStub) DeleteAccount(context.Context, *userpb.UserID, ...grpc.CallOption) (*userpb.DeleteResponse, error) {
+	return &userpb.DeleteResponse{Success: true, Message: "account deleted"}, nil
+}
+
+func (userStub) GetUserStats(context.Context, *userpb.Empty, ...grpc.CallOption) (*userpb.UserStatsResponse, error) {
+	return &userpb.UserStatsResponse{Total: 2, Banned: 1, ByRole: map[string]int32{"admin": 1, "user": 1}, ByStatus: map[string]int32{"active": 1, "banned": 1}}, nil
+}
+
+type contentStub struct{ contentpb.ContentServiceClient }
+
+func (contentStub) CreatePost(context.Context, *contentpb.CreatePostRequest, ...grpc.CallOption) (*contentpb.PostResponse, error) {
+	return &contentpb.PostResponse{Post: post}, nil
+}
+
+func (contentStub) GetPost(context.Context, *contentpb.PostIdRequest, ...grpc.CallOption) (*contentpb.PostResponse, error) {
+	return &contentpb.PostResponse{Post: post}, nil
+}
+
+func (contentStub) DeletePost(context.Context, *contentpb.PostIdRequest, ...grpc.CallOption) (*contentpb.DeleteResponse, error) {
+	return &contentpb.DeleteResponse{Success: true}, nil
+}
+
+func (contentStub) ListPosts(context.Context, *contentpb.ListPostsRequest, ...grpc.CallOption) (*contentpb.ListPostsResponse, error) {
+	return &contentpb.ListPostsResponse{Posts: []*contentpb.Post{post}}, nil
+}
+
+func (contentStub) CreateComment(context.Context, *contentpb.CreateCommentRequest, ...grpc.CallOption) (*contentpb.CommentResponse, error) {
+	return &contentpb.CommentResponse{Comment: comment}, nil
+}
+
+func (contentStub) DeleteComment(context.Context, *contentpb.CommentIdRequest, ...grpc.CallOption) (*contentpb.DeleteResponse, error) {
+	return &contentpb.DeleteResponse{Success: true}, nil
+}
+
+func (contentStub) ListComments(context.Context, *contentpb.ListCommentsRequest, ...grpc.CallOption) (*contentpb.ListCommentsResponse, error) {
+	return &contentpb.ListCommentsResponse{Comments: []*contentpb.Comment{comment}}, nil
+}
+
+func (contentStub) LikePost(context.Context, *contentpb.LikePostRequest, ...grpc.CallOption) (*contentpb.LikePostResponse, error) {
+	return &contentpb.LikePostResponse{LikesCount: 2}, nil
+}
+
+func (contentStub) UnlikePost(context.Context, *contentpb.UnlikePostRequest, ...grpc.CallOption) (*contentpb.UnlikePostResponse, error) {
+	return &contentpb.UnlikePostResponse{LikesCount: 1}, nil
+}
+
+func (contentStub) LikeComment(context.Context, *contentpb.LikeCommentRequest, ...grpc.CallOption) (*contentpb.LikeCommentResponse, error) {
+	return &contentpb.LikeCommentResponse{LikesCount: 2}, nil
+}
+
+func (contentStub) UnlikeComment(context.Context, *contentpb.UnlikeCommentRequest, ...grpc.CallOption) (*contentpb.UnlikeCommentResponse, error) {
+	return &contentpb.UnlikeCommentResponse{LikesCount: 1}, nil
+}
+
+func (contentStub) VotePoll(context.Context, *contentpb.VotePollRequest, ...grpc.CallOption) (*contentpb.VotePollResponse, error) {
+	return &contentpb.VotePollResponse{Poll: poll}, nil
+}
+
+func (contentStub) CreateMedia(_ context.Context, req *contentpb.CreateMediaRequest, _ ...grpc.CallOption) (*contentpb.MediaResponse, error) {
+	return &contentpb.MediaResponse{Media: &contentpb.Media{
+		Id:           req.GetId(),
+		OwnerId:      req.GetOwnerId(),
+		Kind:         req.GetKind(),
+		ContentType:  req.GetContentType(),
+		Size:         req.GetSize(),
+		Filename:     req.GetFilename(),
+		HasThumbnail: req.GetHasThumbnail(),
+		CreatedAt:    "2024-01-01T00:00:00Z",
+	}}, nil
+}
+
+func (contentStub) GetContentStats(context.Context, *contentpb.ContentStatsRequest, ...grpc.CallOption) (*contentpb.ContentStatsResponse, error) {
+	return &contentpb.ContentStatsResponse{Posts: 2, PublishedPosts: 1, Comments: 3, Likes: 4, Media: 1}, nil
+}
+
+func (contentStub) GetMedia(context.Context, *contentpb.MediaIdRequest, ...grpc.CallOption) (*contentpb.MediaResponse, error) {
+	return &contentpb.MediaResponse{Media: media}, nil
+}
+
+type notificationStub struct {
+	notificationpb.NotificationServiceClient
+}
+
+func (notificationStub) SendWelcomeEmail(context.Context, *notificationpb.EmailRequest, ...grpc.CallOption) (*notificationpb.NotificationResponse, error) {
+	return sent, nil
+}
+
+func (notificationStub) NotifySystemMessage(context.Context, *notificationpb.SystemMessageRequest, ...grpc.CallOption) (*notificationpb.NotificationResponse, error) {
+	return sent, nil
+}
+
+func (notificationStub) SendContactRequest(context.Context, *notificationpb.ContactRequest, ...grpc.CallOption) (*notificationpb.NotificationResponse, error) {
+	return sent, nil
+}
+
+func (notificationStub) VerifyCode(context.Context, *notificationpb.VerifyCodeRequest, ...grpc.CallOption) (*notificationpb.NotificationResponse, error) {
+	return sent, nil
+}
+
+func (notificationStub) ResendCode(context.Context, *notificationpb.ResendCodeRequest, ...grpc.CallOption) (*notificationpb.NotificationResponse, error) {
+	return sent, nil
+}
+
+func (notificationStub) GetNotifications(context.Context, *notificationpb.GetNotificationsRequest, ...grpc.CallOption) (*notificationpb.GetNotificationsResponse, error) {
+	return &notificationpb.GetNotificationsResponse{
+		Notifications: []*notificationpb.Notification{{
+			Id:        "n1",
+			UserId:    "u1",
+			Type:      "COMMENT",
+			Message:   "New comment",
+			CreatedAt: "2024-01-01T00:00:00Z",
+			PostId:    "1",
+			CommentId: "c1",
+			Data:      map[string]string{"k": "v"},
+		}},
+		Total: 1, Page: 1, TotalPages: 1,
+	}, nil
+}
+
+type subscriptionStub struct {
+	subpb.SubscriptionServiceClient
+}
+
+func (subscriptionStub) GetSubscription(context.Context, *subpb.GetSubscriptionRequest, ...grpc.CallOption) (*subpb.SubscriptionResponse, error) {
+	return subscription, nil
+}
+
+func (subscriptionStub) UpdateSubscription(context.Context, *subpb.UpdateSubscriptionRequest, ...grpc.CallOption) (*subpb.SubscriptionResponse, error) {
+	return subscription, nil
+}
+
+func (subscriptionStub) CancelSubscription(context.Context, *subpb.CancelSubscriptionRequest, ...grpc.CallOption) (*subpb.SubscriptionResponse, error) {
+	return subscription, nil
+}
+
+func (subscriptionStub) GetSubscriptionHistory(context.Context, *subpb.GetSubscriptionRequest, ...grpc.CallOption) (*subpb.ListSubscriptionsResponse, error) {
+	return &subpb.ListSubscriptionsResponse{Subscriptions: []*subpb.SubscriptionResponse{subscription}}, nil
+}
+
+func (subscriptionStub) GetSubscriptionStats(context.Context, *subpb.Empty, ...grpc.CallOption) (*subpb.SubscriptionStatsResponse, error) {
+	return &subpb.SubscriptionStatsResponse{ByStatus: map[string]int32{"ACTIVE": 1}, ActiveByPlan: map[string]int32{"PRO": 1}}, nil
+}
+
+type paymentStub struct{ paypb.PaymentServiceClient }
+
+func (paymentStub) ProcessPayment(context.Context, *paypb.ProcessPaymentRequest, ...grpc.CallOption) (*paypb.PaymentResponse, error) {
+	return payment, nil
+}
+
+func (paymentStub) GetTransactionHistory(context.Context, *paypb.GetHistoryRequest, ...grpc.CallOption) (*paypb.HistoryResponse, error) {
+	return &paypb.HistoryResponse{Transactions: []*paypb.PaymentResponse{payment}}, nil
+}
+
+func (paymentStub) GetPaymentStats(context.Context, *paypb.PaymentStatsRequest, ...grpc.CallOption) (*paypb.PaymentStatsResponse, error) {
+	return &paypb.PaymentStatsResponse{ByStatus: map[string]int32{"SUCCESS": 1}, RevenueByCurrency: map[string]float64{"KZT": 10}}, nil
+}
+
+type consultationStub struct {
+	conpb.ConsultationServiceClient
+}
+
+func (consultationStub) ListAvailableExperts(context.Context, *conpb.Filter, ...grpc.CallOption) (*conpb.ExpertList, error) {
+	return &conpb.ExpertList{Experts: []*conpb.ExpertProfile{expert()}}, nil
+}
+
+func (consultationStub) RegisterExpert(context.Context, *conpb.ExpertData, ...grpc.CallOption) (*conpb.ExpertProfile, error) {
+	return expert(), nil
+}
+
+func (consultationStub) CreateBooking(context.Context, *conpb.BookingData, ...grpc.CallOption) (*conpb.BookingResponse, error) {
+	return &conpb.BookingResponse{Id: booking.Id, Status: booking.Status, MeetingLink: booking.MeetingLink}, nil
+}
+
+func (consultationStub) CancelBooking(context.Context, *conpb.CancelBookingRequest, ...grpc.CallOption) (*conpb.BookingResponse, error) {
+	return &conpb.BookingResponse{Id: booking.Id, Status: "CANCELLED"}, nil
+}
+
+func (consultationStub) GetUserBookings(context.Context, *conpb.GetUserBookingsRequest, ...grpc.CallOption) (*conpb.BookingList, error) {
+	return &conpb.BookingList{Bookings: []*conpb.BookingDetail{booking}}, nil
+}
+
+func (consultationStub) ConfirmBookingPayment(context.Context, *conpb.ConfirmPaymentRequest, ...grpc.CallOption) (*conpb.Empty, error) {
+	return &conpb.Empty{}, nil
+}
+
+func expert() *conpb.ExpertProfile {
+	return &conpb.ExpertProfile{Id: "e1", UserId: "u1", Specialization: "tax", PricePerSession: 50, IsAvailable: true}
+}
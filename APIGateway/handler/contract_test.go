@@ -0,0 +1,292 @@
+package handler_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/handler"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/blob"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/openapi"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/stream"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/upload"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/auth"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/denylist"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/jwks"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	secret = "contract-test"
+	// tokenKeyID names tokenKey in the key set the UserService stub publishes.
+	tokenKeyID = "contract-test"
+	// callerID is the admin the check authenticates as. Path parameters are all
+	// set to it, so routes restricted to the caller's own resources pass too.
+	callerID = "u1"
+)
+
+// tokenKey signs the test's access token; userStub publishes its public half.
+var tokenKey = newTokenKey()
+
+// undocumented routes serve non-JSON bodies, the document itself, or GraphQL,
+// whose contract is its own schema.
+var undocumented = map[string]bool{
+	"GET /metrics":           true,
+	"GET /openapi.json":      true,
+	"POST /graphql":          true,
+	"GET /api/v1/events":     true,
+	"GET /api/v1/blobs/*key": true,
+}
+
+// uploadPNG is a 1x1 PNG sent to multipart upload operations.
+var uploadPNG, _ = base64.StdEncoding.DecodeString("iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR4nGP4z8DwHwAFAAH/iZk9HQAAAABJRU5ErkJggg==")
+
+// bodies are the request bodies sent to operations that take one, keyed without
+// the version prefix so legacy aliases share them. Operations not listed here get
+// an empty object.
+var bodies = map[string]string{
+	"POST /auth/register":                `{"username":"alice","email":"alice@example.com","password":"secret123"}`,
+	"POST /auth/login":                   `{"email":"alice@example.com","password":"secret123"}`,
+	"POST /auth/refresh":                 `{"refreshToken":"refresh"}`,
+	"POST /auth/verify-email":            `{"code":"123456"}`,
+	"POST /auth/password/forgot":         `{"email":"alice@example.com"}`,
+	"POST /auth/password/reset":          `{"token":"reset","newPassword":"secret456"}`,
+	"POST /auth/password/change":         `{"currentPassword":"secret123","newPassword":"secret456"}`,
+	"PUT /users/{id}":                    `{"username":"alice","bio":"hi"}`,
+	"POST /content/posts":                `{"title":"Hello","content":"World"}`,
+	"POST /posts":                        `{"title":"Hello","content":"World"}`,
+	"POST /content/posts/{id}/comments":  `{"content":"Nice"}`,
+	"POST /content/posts/{id}/poll/vote": `{"optionId":"o1"}`,
+	"POST /notify/welcome":               `{"email":"alice@example.com"}`,
+	"POST /notify/system-message":        `{"message":"maintenance"}`,
+	"POST /notify/contact":               `{"name":"Alice","email":"alice@example.com","subject":"Hi","message":"Hello"}`,
+	"POST /subscriptions/subscribe":      `{"planType":"PRO","durationMonths":1}`,
+	"POST /subscriptions/cancel":         `{"id":"s1"}`,
+	"POST /payments/process":             `{"amount":10,"currency":"KZT","referenceType":"SUBSCRIPTION","referenceId":"s1"}`,
+	"POST /consultations/experts":        `{"specialization":"tax","pricePerSession":50}`,
+	"POST /consultations/book":           `{"expertId":"e1","expertName":"Bob","scheduledAt":"2030-01-01T10:00:00Z"}`,
+	"POST /consultations/cancel":         `{"bookingId":"b1"}`,
+	"PUT /admin/users/{id}/role":         `{"role":"moderator"}`,
+	"POST /admin/users/{id}/ban":         `{"reason":"spam","details":"link farm","expiresAt":"2030-01-01T10:00:00Z"}`,
+	"POST /admin/users/{id}/unban":       `{"reason":"appeal_accepted"}`,
+}
+
+// query adds the parameters an operation needs to succeed.
+var query = map[string]string{
+	"GET /notifications": "userId=" + callerID,
+}
+
+// TestOpenAPIContract verifies that the handlers still produce what the OpenAPI
+// document promises. It mounts the real routes on top of canned gRPC clients,
+// calls every documented operation as an admin and validates each response body
+// against its schema. It also fails when a route is registered but undocumented
+// or documented but not registered.
+func TestOpenAPIContract(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+
+	spec := handler.Spec()
+	router := newRouter(t)
+
+	for _, p := range checkCoverage(spec, router) {
+		t.Error(p)
+	}
+	for _, p := range checkResponses(spec, router) {
+		t.Error(p)
+	}
+}
+
+// newRouter mounts the production routes the way cmd/gateway does, minus the
+// network-bound middleware (tracing, rate limiting).
+func newRouter(t *testing.T) *gin.Engine {
+	router := gin.New()
+
+	content := contentStub{}
+	authz := policy.Default()
+	handler.RegisterOwnerChecks(authz, content)
+	tokenKeys := jwks.NewCache(auth.JWKSFetcher(userStub{}), jwks.DefaultMaxAge)
+	router.Use(
+		middleware.RequestID(),
+		handler.LegacyDeprecation(time.Now(), time.Now().AddDate(0, 6, 0)),
+		middleware.AuthMiddleware(tokenKeys, denylist.NewMemory()),
+		middleware.PolicyMiddleware(authz),
+		middleware.ResponseCache(middleware.NewMemoryResponseCacheStore(), middleware.DefaultCacheRules),
+		middleware.Idempotency(middleware.NewMemoryIdempotencyStore(), middleware.DefaultIdempotencyRules),
+	)
+
+	clients := handler.Clients{
+		User:         userStub{},
+		Content:      content,
+		Notification: notificationStub{},
+		Subscription: subscriptionStub{},
+		Payment:      paymentStub{},
+		Consultation: consultationStub{},
+	}
+	handler.Mount(router, handler.V1, clients)
+	handler.MountLegacy(router, clients)
+	handler.RegisterGraphQLRoute(router, clients)
+	handler.RegisterEventRoutes(router.Group(handler.V1.Prefix), stream.NewHub(stream.DefaultBacklog), stream.NewMemoryTicketStore())
+	blobs, err := blob.NewLocalStore(t.TempDir(), handler.V1.Prefix+"/blobs", []byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler.RegisterMediaRoutes(router.Group(handler.V1.Prefix), content, handler.MediaConfig{Store: blobs, Limits: upload.DefaultLimits})
+	handler.RegisterBlobRoute(router.Group(handler.V1.Prefix), blobs)
+	handler.RegisterHealthRoutes(router, nil)
+	router.GET("/metrics", func(c *gin.Context) {})
+	handler.RegisterOpenAPIRoute(router)
+	handler.RegisterJWKSRoute(router, tokenKeys)
+	return router
+}
+
+func checkCoverage(spec *openapi.Document, router *gin.Engine) []string {
+	var problems []string
+	registered := map[string]bool{}
+	for _, r := range router.Routes() {
+		key := r.Method + " " + r.Path
+		registered[key] = true
+		if undocumented[key] {
+			continue
+		}
+		if spec.Operation(r.Method, r.Path) == nil {
+			problems = append(problems, fmt.Sprintf("%s: registered but not in the OpenAPI document", key))
+		}
+	}
+	for _, rt := range handler.Routes() {
+		key := rt.Method + " " + rt.Path
+		if !registered[key] {
+			problems = append(problems, fmt.Sprintf("%s: documented but not registered", key))
+		}
+	}
+	return problems
+}
+
+func checkResponses(spec *openapi.Document, router *gin.Engine) []string {
+	token := adminToken()
+
+	paths := make([]string, 0, len(spec.Paths))
+	for p := range spec.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var problems []string
+	for _, path := range paths {
+		methods := make([]string, 0, len(spec.Paths[path]))
+		for m := range spec.Paths[path] {
+			methods = append(methods, m)
+		}
+		sort.Strings(methods)
+
+		for _, m := range methods {
+			op := spec.Paths[path][m]
+			method := strings.ToUpper(m)
+			key := method + " " + path
+			problems = append(problems, checkOperation(spec, router, op, method, path, key, token)...)
+		}
+	}
+	return problems
+}
+
+func checkOperation(spec *openapi.Document, router *gin.Engine, op *openapi.Operation, method, path, key, token string) []string {
+	target := path
+	for _, p := range op.Parameters {
+		if p.In == "path" {
+			target = strings.ReplaceAll(target, "{"+p.Name+"}", callerID)
+		}
+	}
+	unversioned := method + " " + strings.TrimPrefix(path, handler.V1.Prefix)
+	if q, ok := query[unversioned]; ok {
+		target += "?" + q
+	}
+
+	body, contentType := requestBody(op, unversioned)
+	req := httptest.NewRequest(method, target, body)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	var want string
+	for code := range op.Responses {
+		if code != "default" {
+			want = code
+		}
+	}
+	if strconv.Itoa(rec.Code) != want {
+		return []string{fmt.Sprintf("%s: status %d, want %s: %s", key, rec.Code, want, strings.TrimSpace(rec.Body.String()))}
+	}
+
+	var problems []string
+	if deprecated := rec.Header().Get("Deprecation") != ""; deprecated != op.Deprecated {
+		problems = append(problems, fmt.Sprintf("%s: Deprecation header present=%t, documented deprecated=%t", key, deprecated, op.Deprecated))
+	}
+
+	media, ok := op.Responses[want].Content["application/json"]
+	if !ok {
+		return problems
+	}
+	for _, p := range spec.Validate(media.Schema, rec.Body.Bytes()) {
+		problems = append(problems, fmt.Sprintf("%s: %s", key, p))
+	}
+	return problems
+}
+
+// requestBody builds the body for op: a multipart upload of uploadPNG, or JSON.
+func requestBody(op *openapi.Operation, unversioned string) (*bytes.Reader, string) {
+	if op.RequestBody == nil {
+		return bytes.NewReader(nil), "application/json"
+	}
+	if form, ok := op.RequestBody.Content["multipart/form-data"]; ok {
+		var buf bytes.Buffer
+		w := multipart.NewWriter(&buf)
+		for field := range form.Schema.Properties {
+			part, err := w.CreateFormFile(field, "pixel.png")
+			if err != nil {
+				panic(err)
+			}
+			part.Write(uploadPNG)
+		}
+		w.Close()
+		return bytes.NewReader(buf.Bytes()), w.FormDataContentType()
+	}
+	b, ok := bodies[unversioned]
+	if !ok {
+		b = "{}"
+	}
+	return bytes.NewReader([]byte(b)), "application/json"
+}
+
+func newTokenKey() ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func adminToken() string {
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+		"user_id":        callerID,
+		"role":           string(policy.RoleAdmin),
+		"email_verified": true,
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = tokenKeyID
+	signed, err := token.SignedString(tokenKey)
+	if err != nil {
+		panic(err)
+	}
+	return signed
+}
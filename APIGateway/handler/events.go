@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/dto"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/stream"
+	"github.com/gin-gonic/gin"
+)
+
+// heartbeatInterval keeps idle streams open through proxies that cut silent
+// connections.
+const heartbeatInterval = 25 * time.Second
+
+// RegisterEventRoutes serves GET /events, a Server-Sent Events stream of the
+// caller's new notifications and public feed updates (like counts, comments,
+// poll tallies). Clients resume by sending the last event id they saw as
+// Last-Event-ID, which EventSource does on its own, or as ?cursor=.
+//
+// EventSource can't send an Authorization header, so browsers first get a
+// ticket from POST /events/ticket and open /events?ticket=<ticket>. A ticket
+// opens one stream; reconnecting takes a new one.
+func RegisterEventRoutes(r gin.IRouter, hub *stream.Hub, tickets stream.TicketStore) {
+	r.POST("/events/ticket", middleware.RequireAuth(), func(c *gin.Context) {
+		ticket, err := tickets.Issue(c.Request.Context(), middleware.UserID(c), stream.TicketTTL)
+		if err != nil {
+			apierror.Respond(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, dto.EventTicket{
+			Ticket:    ticket,
+			ExpiresAt: time.Now().Add(stream.TicketTTL).UTC().Format(time.RFC3339),
+		})
+	})
+
+	r.GET("/events", func(c *gin.Context) {
+		userID := middleware.UserID(c)
+		if ticket := c.Query("ticket"); userID == "" && ticket != "" {
+			var err error
+			userID, err = tickets.Redeem(c.Request.Context(), ticket)
+			if errors.Is(err, stream.ErrInvalidTicket) {
+				apierror.Abort(c, http.StatusUnauthorized, err.Error())
+				return
+			}
+			if err != nil {
+				apierror.Respond(c, err)
+				return
+			}
+		}
+		if userID == "" {
+			apierror.Abort(c, http.StatusUnauthorized, "authorization required")
+			return
+		}
+
+		cursor := c.GetHeader("Last-Event-ID")
+		if cursor == "" {
+			cursor = c.Query("cursor")
+		}
+
+		sub, backlog := hub.Subscribe(userID, cursor)
+		defer sub.Close()
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+
+		for _, e := range backlog {
+			writeEvent(c.Writer, e)
+		}
+		c.Writer.Flush()
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+		for {
+			select {
+			case <-c.Request.Context().Done():
+				return
+			case e, ok := <-sub.Events():
+				if !ok {
+					return
+				}
+				writeEvent(c.Writer, e)
+			case <-heartbeat.C:
+				io.WriteString(c.Writer, ": heartbeat\n\n")
+			}
+			c.Writer.Flush()
+		}
+	})
+}
+
+func writeEvent(w io.Writer, e stream.Event) {
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", e.ID, e.Type, e.Data)
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/gql"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterGraphQLRoute serves the GraphQL schema at POST /graphql. Callers
+// authenticate with the same Bearer token as the REST routes; query errors are
+// reported in the response's errors array with a 200, as GraphQL clients expect.
+func RegisterGraphQLRoute(r *gin.Engine, clients Clients) {
+	server := gql.New(gql.Clients{
+		User:         clients.User,
+		Content:      clients.Content,
+		Notification: clients.Notification,
+		Subscription: clients.Subscription,
+		Payment:      clients.Payment,
+		Consultation: clients.Consultation,
+	})
+
+	r.POST("/graphql", func(c *gin.Context) {
+		var req gql.Request
+		if err := c.ShouldBindJSON(&req); err != nil {
+			apierror.BadRequest(c, err)
+			return
+		}
+
+		id := gql.Identity{UserID: middleware.UserID(c), Admin: middleware.IsAdmin(c)}
+		c.JSON(http.StatusOK, server.Exec(middleware.OutgoingContext(c), id, req))
+	})
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/dto"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const healthCheckTimeout = 2 * time.Second
+
+// HealthTarget is a downstream service probed through the gRPC health protocol.
+type HealthTarget struct {
+	Name string
+	Conn grpc.ClientConnInterface
+}
+
+// RegisterHealthRoutes adds the aggregated probes.
+//
+// /healthz is the gateway's liveness probe: it always answers 200 while the gateway
+// serves HTTP and lists which downstream services answer health checks at all.
+// /readyz answers 503 unless every downstream service reports SERVING, i.e. its
+// Postgres, Redis and NATS dependencies are reachable.
+func RegisterHealthRoutes(r *gin.Engine, targets []HealthTarget) {
+	r.GET("/healthz", func(c *gin.Context) {
+		services := map[string]string{}
+		for name, status := range checkAll(c.Request.Context(), targets) {
+			if status == healthpb.HealthCheckResponse_UNKNOWN {
+				services[name] = "down"
+			} else {
+				services[name] = "up"
+			}
+		}
+		c.JSON(http.StatusOK, dto.Health{Status: "ok", Services: services})
+	})
+
+	r.GET("/readyz", func(c *gin.Context) {
+		ready := true
+		services := map[string]string{}
+		for name, status := range checkAll(c.Request.Context(), targets) {
+			services[name] = status.String()
+			if status != healthpb.HealthCheckResponse_SERVING {
+				ready = false
+			}
+		}
+		if !ready {
+			c.JSON(http.StatusServiceUnavailable, dto.Health{Status: "unavailable", Services: services})
+			return
+		}
+		c.JSON(http.StatusOK, dto.Health{Status: "ready", Services: services})
+	})
+}
+
+// checkAll queries every target in parallel. Targets that can't be reached report
+// UNKNOWN.
+func checkAll(ctx context.Context, targets []HealthTarget) map[string]healthpb.HealthCheckResponse_ServingStatus {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]healthpb.HealthCheckResponse_ServingStatus, len(targets))
+	)
+	for _, t := range targets {
+		wg.Add(1)
+		go func(t HealthTarget) {
+			defer wg.Done()
+			status := healthpb.HealthCheckResponse_UNKNOWN
+			resp, err := healthpb.NewHealthClient(t.Conn).Check(ctx, &healthpb.HealthCheckRequest{})
+			if err == nil {
+				status = resp.GetStatus()
+			}
+			mu.Lock()
+			results[t.Name] = status
+			mu.Unlock()
+		}(t)
+	}
+	wg.Wait()
+	return results
+}
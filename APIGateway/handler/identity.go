@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// targetUserID resolves which user a request acts on. An empty or matching
+// requested id means the caller; any other id is only honoured for admins.
+// On refusal it writes a 403 and returns false.
+func targetUserID(c *gin.Context, requested string) (string, bool) {
+	callerID := middleware.UserID(c)
+	if requested == "" || requested == "me" || requested == callerID {
+		return callerID, true
+	}
+	if middleware.IsAdmin(c) {
+		return requested, true
+	}
+	apierror.Abort(c, http.StatusForbidden, "you can only access your own resources")
+	return "", false
+}
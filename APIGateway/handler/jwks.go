@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/jwks"
+	"github.com/gin-gonic/gin"
+)
+
+// jwksCacheControl lets clients keep the key set for five minutes. Verifiers
+// should refetch sooner when a token names a key they don't have, as
+// jwks.Cache does.
+const jwksCacheControl = "public, max-age=300"
+
+// RegisterJWKSRoute publishes UserService's token verification keys at
+// /.well-known/jwks.json, for services that verify access tokens themselves.
+func RegisterJWKSRoute(r *gin.Engine, keys *jwks.Cache) {
+	r.GET("/.well-known/jwks.json", func(c *gin.Context) {
+		set, err := keys.Set(c.Request.Context())
+		if err != nil {
+			apierror.Abort(c, http.StatusServiceUnavailable, "token keys unavailable")
+			return
+		}
+		c.Header("Cache-Control", jwksCacheControl)
+		c.JSON(http.StatusOK, set)
+	})
+}
@@ -0,0 +1,288 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/blob"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/dto"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/upload"
+	contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// mediaPath is where RegisterMediaRoutes mounts, relative to the version prefix.
+	mediaPath = "/media"
+	// blobPath is where RegisterBlobRoute serves the local store.
+	blobPath = "/blobs"
+
+	// mediaFormField is the multipart field holding the upload.
+	mediaFormField = "file"
+	// multipartOverhead allows for the multipart headers and boundaries around
+	// the largest accepted file.
+	multipartOverhead = 1 << 20
+	maxFilenameLength = 255
+
+	// DefaultMediaURLTTL is how long signed download URLs stay valid.
+	DefaultMediaURLTTL = 15 * time.Minute
+)
+
+// MediaConfig is what the media routes need besides the content service.
+type MediaConfig struct {
+	Store  blob.Store
+	Limits upload.Limits
+	URLTTL time.Duration
+}
+
+// RegisterMediaRoutes serves uploads and downloads of post media. The bytes go
+// to the blob store; ContentService records the owner so posts can only attach
+// their author's uploads. Downloads are never proxied: GET /media/:id returns
+// signed URLs, and /content and /thumbnail redirect to one, which gives posts
+// stable links to embed.
+func RegisterMediaRoutes(r gin.IRouter, contentClient contentpb.ContentServiceClient, cfg MediaConfig) {
+	if cfg.URLTTL <= 0 {
+		cfg.URLTTL = DefaultMediaURLTTL
+	}
+	media := r.Group(mediaPath)
+
+	media.POST("", middleware.RequireAuth(), uploadMediaHandler(contentClient, cfg))
+
+	media.GET("/:id", func(c *gin.Context) {
+		m, ok := viewableMedia(c, contentClient)
+		if !ok {
+			return
+		}
+		out, err := signedMedia(c.Request.Context(), cfg, m)
+		if err != nil {
+			slog.ErrorContext(c.Request.Context(), "signing media failed", "media_id", m.GetId(), logging.Err(err))
+			apierror.Abort(c, http.StatusInternalServerError, "could not sign media URL")
+			return
+		}
+		c.JSON(http.StatusOK, out)
+	})
+
+	media.GET("/:id/content", func(c *gin.Context) {
+		redirectToMedia(c, contentClient, cfg, false)
+	})
+	media.GET("/:id/thumbnail", func(c *gin.Context) {
+		redirectToMedia(c, contentClient, cfg, true)
+	})
+}
+
+// RegisterBlobRoute serves a local blob store at /blobs for the URLs it signs.
+// Stores that sign their own URLs, like S3, don't need it.
+func RegisterBlobRoute(r gin.IRouter, store *blob.LocalStore) {
+	r.GET(blobPath+"/*key", func(c *gin.Context) {
+		c.Request.URL.Path = c.Param("key")
+		store.ServeHTTP(c.Writer, c.Request)
+	})
+}
+
+func uploadMediaHandler(client contentpb.ContentServiceClient, cfg MediaConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, cfg.Limits.Max()+multipartOverhead)
+		header, err := c.FormFile(mediaFormField)
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				apierror.Abort(c, http.StatusRequestEntityTooLarge, "file too large")
+				return
+			}
+			apierror.Abort(c, http.StatusBadRequest, `multipart field "file" is required`)
+			return
+		}
+		file, err := header.Open()
+		if err != nil {
+			apierror.Abort(c, http.StatusBadRequest, "could not read upload")
+			return
+		}
+		defer file.Close()
+
+		detected, err := upload.Inspect(file, header.Size, cfg.Limits)
+		if err != nil {
+			abortUpload(c, err)
+			return
+		}
+		kind := contentpb.MediaKind_MEDIA_FILE
+		var thumbnail []byte
+		if detected.Kind == upload.KindImage {
+			kind = contentpb.MediaKind_MEDIA_IMAGE
+			if thumbnail, err = upload.Thumbnail(file, cfg.Limits); err != nil {
+				abortUpload(c, err)
+				return
+			}
+		}
+
+		ctx := c.Request.Context()
+		id := uuid.NewString()
+		if err := cfg.Store.Put(ctx, originalKey(id), file, header.Size, detected.ContentType); err != nil {
+			slog.ErrorContext(ctx, "storing media failed", "media_id", id, logging.Err(err))
+			apierror.Abort(c, http.StatusServiceUnavailable, "could not store upload")
+			return
+		}
+		if thumbnail != nil {
+			if err := cfg.Store.Put(ctx, thumbnailKey(id), bytes.NewReader(thumbnail), int64(len(thumbnail)), "image/jpeg"); err != nil {
+				slog.ErrorContext(ctx, "storing thumbnail failed", "media_id", id, logging.Err(err))
+				deleteMediaBlobs(cfg.Store, id)
+				apierror.Abort(c, http.StatusServiceUnavailable, "could not store upload")
+				return
+			}
+		}
+
+		resp, err := client.CreateMedia(middleware.OutgoingContext(c), &contentpb.CreateMediaRequest{
+			Id:           id,
+			OwnerId:      middleware.UserID(c),
+			Kind:         kind,
+			ContentType:  detected.ContentType,
+			Size:         header.Size,
+			Filename:     cleanFilename(header.Filename),
+			HasThumbnail: thumbnail != nil,
+		})
+		if err != nil {
+			deleteMediaBlobs(cfg.Store, id)
+			apierror.Respond(c, err)
+			return
+		}
+
+		out, err := signedMedia(ctx, cfg, resp.GetMedia())
+		if err != nil {
+			slog.ErrorContext(ctx, "signing media failed", "media_id", id, logging.Err(err))
+			apierror.Abort(c, http.StatusInternalServerError, "could not sign media URL")
+			return
+		}
+		c.JSON(http.StatusCreated, out)
+	}
+}
+
+func redirectToMedia(c *gin.Context, client contentpb.ContentServiceClient, cfg MediaConfig, thumbnail bool) {
+	m, ok := viewableMedia(c, client)
+	if !ok {
+		return
+	}
+	key, opts := originalKey(m.GetId()), downloadOptions(m)
+	if thumbnail {
+		if !m.GetHasThumbnail() {
+			apierror.Abort(c, http.StatusNotFound, "media has no thumbnail")
+			return
+		}
+		key, opts = thumbnailKey(m.GetId()), blob.URLOptions{ContentType: "image/jpeg"}
+	}
+
+	url, err := cfg.Store.SignedURL(c.Request.Context(), key, cfg.URLTTL, opts)
+	if err != nil {
+		slog.ErrorContext(c.Request.Context(), "signing media failed", "media_id", m.GetId(), logging.Err(err))
+		apierror.Abort(c, http.StatusInternalServerError, "could not sign media URL")
+		return
+	}
+	// Let clients reuse the redirect while the URL it points to is still valid
+	c.Header("Cache-Control", "private, max-age="+strconv.Itoa(int(cfg.URLTTL/2/time.Second)))
+	c.Redirect(http.StatusFound, url)
+}
+
+// viewableMedia loads the media addressed by :id. Media attached to a post is
+// as visible as posts are; an upload not yet attached is only visible to its
+// owner and admins. On failure it writes the error and returns false.
+func viewableMedia(c *gin.Context, client contentpb.ContentServiceClient) (*contentpb.Media, bool) {
+	resp, err := client.GetMedia(middleware.OutgoingContext(c), &contentpb.MediaIdRequest{Id: c.Param("id")})
+	if err != nil {
+		apierror.Respond(c, err)
+		return nil, false
+	}
+	m := resp.GetMedia()
+	if m.GetPostId() == "" && m.GetOwnerId() != middleware.UserID(c) && !middleware.IsAdmin(c) {
+		apierror.Abort(c, http.StatusNotFound, "media not found")
+		return nil, false
+	}
+	return m, true
+}
+
+// signedMedia describes m with download URLs valid for cfg.URLTTL.
+func signedMedia(ctx context.Context, cfg MediaConfig, m *contentpb.Media) (dto.Media, error) {
+	expiresAt := time.Now().Add(cfg.URLTTL)
+	url, err := cfg.Store.SignedURL(ctx, originalKey(m.GetId()), cfg.URLTTL, downloadOptions(m))
+	if err != nil {
+		return dto.Media{}, err
+	}
+	thumbnail := ""
+	if m.GetHasThumbnail() {
+		thumbnail, err = cfg.Store.SignedURL(ctx, thumbnailKey(m.GetId()), cfg.URLTTL, blob.URLOptions{ContentType: "image/jpeg"})
+		if err != nil {
+			return dto.Media{}, err
+		}
+	}
+	return dto.NewMedia(m, url, thumbnail, expiresAt.UTC().Format(time.RFC3339)), nil
+}
+
+// downloadOptions serves images inline and everything else as an attachment,
+// so an uploaded document can't render as a page on the blob store's origin.
+func downloadOptions(m *contentpb.Media) blob.URLOptions {
+	opts := blob.URLOptions{ContentType: m.GetContentType()}
+	if m.GetKind() != contentpb.MediaKind_MEDIA_IMAGE {
+		opts.Filename = m.GetFilename()
+		if opts.Filename == "" {
+			opts.Filename = m.GetId()
+		}
+	}
+	return opts
+}
+
+func abortUpload(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, upload.ErrTooLarge):
+		apierror.Abort(c, http.StatusRequestEntityTooLarge, err.Error())
+	case errors.Is(err, upload.ErrUnsupportedType):
+		apierror.Abort(c, http.StatusUnsupportedMediaType, err.Error())
+	case errors.Is(err, upload.ErrInvalidImage):
+		apierror.Abort(c, http.StatusBadRequest, err.Error())
+	default:
+		slog.WarnContext(c.Request.Context(), "inspecting upload failed", logging.Err(err))
+		apierror.Abort(c, http.StatusBadRequest, "could not read upload")
+	}
+}
+
+// deleteMediaBlobs cleans up after an upload that could not be recorded. It
+// outlives the request, which may have been cancelled.
+func deleteMediaBlobs(store blob.Store, id string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for _, key := range []string{originalKey(id), thumbnailKey(id)} {
+		if err := store.Delete(ctx, key); err != nil {
+			slog.Error("deleting orphaned blob failed", "key", key, logging.Err(err))
+		}
+	}
+}
+
+func originalKey(id string) string  { return "media/" + id + "/original" }
+func thumbnailKey(id string) string { return "media/" + id + "/thumbnail.jpg" }
+
+// cleanFilename keeps the base name of the client's file name without control
+// characters, for the download's Content-Disposition.
+func cleanFilename(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name)
+	if name == "." || name == "/" {
+		return ""
+	}
+	if len(name) > maxFilenameLength {
+		name = name[:maxFilenameLength]
+	}
+	return strings.ToValidUTF8(name, "")
+}
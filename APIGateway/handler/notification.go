@@ -1,27 +1,26 @@
 package handler
 
 import (
-	"context"
-	"encoding/json"
 	"net/http"
 
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
 	notificationpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/notification"
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterNotificationRoutes(r *gin.Engine, client notificationpb.NotificationServiceClient) {
+func RegisterNotificationRoutes(r gin.IRouter, client notificationpb.NotificationServiceClient) {
 	// existing routes
 	notify := r.Group("/notify")
 
 	notify.POST("/welcome", func(c *gin.Context) {
 		var req notificationpb.EmailRequest
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if !bindJSON(c, &req) {
 			return
 		}
-		resp, err := client.SendWelcomeEmail(context.Background(), &req)
+		resp, err := client.SendWelcomeEmail(middleware.OutgoingContext(c), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
@@ -29,43 +28,25 @@ func RegisterNotificationRoutes(r *gin.Engine, client notificationpb.Notificatio
 
 	notify.POST("/system-message", func(c *gin.Context) {
 		var req notificationpb.SystemMessageRequest
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if !bindJSON(c, &req) {
 			return
 		}
-		resp, err := client.NotifySystemMessage(context.Background(), &req)
+		resp, err := client.NotifySystemMessage(middleware.OutgoingContext(c), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
 	})
 
 	notify.POST("/contact", func(c *gin.Context) {
-		var req struct {
-			Name    string `json:"name"`
-			Email   string `json:"email"`
-			Subject string `json:"subject"`
-			Message string `json:"message"`
-		}
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		var req notificationpb.ContactRequest
+		if !bindJSON(c, &req) {
 			return
 		}
-
-		payload, _ := json.Marshal(map[string]interface{}{
-			"type":    "CONTACT_REQUEST",
-			"name":    req.Name,
-			"email":   req.Email,
-			"subject": req.Subject,
-			"content": req.Message,
-		})
-
-		resp, err := client.NotifySystemMessage(context.Background(), &notificationpb.SystemMessageRequest{
-			Message: string(payload),
-		})
+		resp, err := client.SendContactRequest(middleware.OutgoingContext(c), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
@@ -75,13 +56,12 @@ func RegisterNotificationRoutes(r *gin.Engine, client notificationpb.Notificatio
 	auth := r.Group("/auth")
 	auth.POST("/verify-email", func(c *gin.Context) {
 		var req notificationpb.VerifyCodeRequest
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if !bindJSON(c, &req) {
 			return
 		}
-		resp, err := client.VerifyCode(context.Background(), &req)
+		resp, err := client.VerifyCode(middleware.OutgoingContext(c), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		if !resp.Success {
@@ -92,35 +72,42 @@ func RegisterNotificationRoutes(r *gin.Engine, client notificationpb.Notificatio
 	})
 
 	auth.POST("/resend-code", func(c *gin.Context) {
-		var req notificationpb.ResendCodeRequest
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-			return
-		}
-		resp, err := client.ResendCode(context.Background(), &req)
+		resp, err := client.ResendCode(middleware.OutgoingContext(c), &notificationpb.ResendCodeRequest{})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
 	})
 
-	// TEMP: make frontend /notifications stop failing (no 404)
+	// GET /notifications - the caller's notifications, newest first. Query: userId
+	// (admins only, defaults to the caller), page (default 1), limit (default 20).
+	// New ones are pushed on GET /events.
 	n := r.Group("/notifications")
-	n.GET("", func(c *gin.Context) {
-		userID := c.Query("userId")
-		if userID == "" {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "userId is required"})
+	n.GET("", middleware.RequireAuth(), func(c *gin.Context) {
+		userID, ok := targetUserID(c, c.Query("userId"))
+		if !ok {
 			return
 		}
+		page, _ := parseIntDefault(c.Query("page"), 1)
+		if page < 1 {
+			page = 1
+		}
+		limit, _ := parseIntDefault(c.Query("limit"), 20)
+		if limit <= 0 {
+			limit = 20
+		}
+		if limit > 100 {
+			limit = 100
+		}
 
-		resp, err := client.GetNotifications(context.Background(), &notificationpb.GetNotificationsRequest{
+		resp, err := client.GetNotifications(middleware.OutgoingContext(c), &notificationpb.GetNotificationsRequest{
 			UserId: userID,
-			Page:   1,
-			Limit:  20,
+			Page:   int32(page),
+			Limit:  int32(limit),
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp.GetNotifications())
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/dto"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/openapi"
+	contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/jwks"
+	notificationpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/notification"
+	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
+	"github.com/gin-gonic/gin"
+
+	conpb "github.com/KaminurOrynbek/BiznesAsh/ConsultationService/proto"
+	paypb "github.com/KaminurOrynbek/BiznesAsh/PaymentService/proto"
+	subpb "github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/proto"
+)
+
+// APIVersion is the version published in the OpenAPI document.
+const APIVersion = "1.0.0"
+
+// Routes documents every JSON route the gateway serves: the V1 routes, their
+// deprecated legacy aliases and the operational endpoints at the root.
+func Routes() []openapi.Route {
+	var routes []openapi.Route
+	for _, rt := range v1Routes() {
+		if isLegacyPath(rt.Path) {
+			legacy := rt
+			legacy.Deprecated = true
+			routes = append(routes, legacy)
+		}
+		if rt.Method == http.MethodPost && rt.Path == "/content/posts" {
+			alias := rt
+			alias.Path = legacyPostsPath
+			alias.Deprecated = true
+			routes = append(routes, alias)
+		}
+		rt.Path = V1.Prefix + rt.Path
+		routes = append(routes, rt)
+	}
+	return append(routes, opsRoutes...)
+}
+
+// idempotent documents the header accepted by the routes in
+// middleware.DefaultIdempotencyRules.
+var idempotent = []string{middleware.HeaderIdempotencyKey}
+
+// v1Routes documents the V1 routes relative to the version prefix. Request and
+// Response are the exact types the handlers bind and the V1 mappers return;
+// handlers that still pass a proto message through are documented with that
+// message. Keep this table in step with the Register*Routes functions:
+// TestOpenAPIContract fails on any drift.
+func v1Routes() []openapi.Route {
+	return []openapi.Route{
+		// Auth and users
+		{Method: http.MethodGet, Path: "/auth/ping", Tag: "auth", Summary: "Liveness check for the auth routes", Response: dto.Status{}},
+		{Method: http.MethodPost, Path: "/auth/register", Tag: "auth", Summary: "Register a new account", Request: userpb.RegisterRequest{}, Response: dto.AuthResponse{}},
+		{Method: http.MethodPost, Path: "/auth/login", Tag: "auth", Summary: "Log in with email and password", Request: userpb.LoginRequest{}, Response: dto.AuthResponse{}},
+		{Method: http.MethodPost, Path: "/auth/refresh", Tag: "auth", Summary: "Exchange a refresh token for a new token pair", Request: userpb.RefreshTokenRequest{}, Response: dto.AuthResponse{}},
+		{Method: http.MethodPost, Path: "/auth/logout", Tag: "auth", Summary: "End the current session", Auth: true, Response: dto.Logout{}},
+		{Method: http.MethodPost, Path: "/auth/logout-all", Tag: "auth", Summary: "End every session of the caller", Auth: true, Response: dto.Logout{}},
+		{Method: http.MethodGet, Path: "/auth/sessions", Tag: "auth", Summary: "List the caller's active sessions", Auth: true, Response: dto.SessionList{}},
+		{Method: http.MethodDelete, Path: "/auth/sessions/:id", Tag: "auth", Summary: "End one of the caller's sessions", Auth: true, Response: dto.Logout{}},
+		{Method: http.MethodPost, Path: "/auth/password/forgot", Tag: "auth", Summary: "Email a password reset link", Request: userpb.PasswordResetRequest{}, Response: dto.Message{}},
+		{Method: http.MethodPost, Path: "/auth/password/reset", Tag: "auth", Summary: "Set a new password with a reset token", Request: userpb.ResetPasswordRequest{}, Response: dto.Logout{}},
+		{Method: http.MethodPost, Path: "/auth/password/change", Tag: "auth", Summary: "Change the caller's password", Auth: true, Request: userpb.ChangePasswordRequest{}, Response: dto.Logout{}},
+		{Method: http.MethodGet, Path: "/auth/me", Tag: "auth", Summary: "Current user", Auth: true, Response: dto.User{}},
+		{Method: http.MethodPost, Path: "/auth/verify-email", Tag: "auth", Summary: "Verify the code emailed to the caller", Auth: true, Request: notificationpb.VerifyCodeRequest{}, Response: notificationpb.NotificationResponse{}},
+		{Method: http.MethodPost, Path: "/auth/resend-code", Tag: "auth", Summary: "Email the caller a new verification code", Auth: true, Response: notificationpb.NotificationResponse{}},
+		{Method: http.MethodGet, Path: "/users/me", Tag: "users", Summary: "Current user", Auth: true, Response: dto.User{}},
+		{Method: http.MethodGet, Path: "/users/:id", Tag: "users", Summary: "Get a user", Response: dto.User{}},
+		{Method: http.MethodPut, Path: "/users/:id", Tag: "users", Summary: "Update a profile", Auth: true, Request: userpb.UpdateProfileRequest{}, Response: dto.User{}},
+
+		// Content
+		{Method: http.MethodGet, Path: "/content/posts", Tag: "content", Summary: "List posts", Query: []string{"skip", "limit"}, Response: []dto.Post{}},
+		{Method: http.MethodPost, Path: "/content/posts", Tag: "content", Summary: "Create a post", Auth: true, Request: contentpb.CreatePostRequest{}, Response: dto.Post{}},
+		{Method: http.MethodGet, Path: "/content/posts/:id", Tag: "content", Summary: "Get a post", Response: dto.Post{}},
+		{Method: http.MethodDelete, Path: "/content/posts/:id", Tag: "content", Summary: "Delete a post", Auth: true, Response: dto.Message{}},
+		{Method: http.MethodPost, Path: "/content/posts/:id/like", Tag: "content", Summary: "Like a post", Auth: true, Response: dto.LikesCount{}},
+		{Method: http.MethodDelete, Path: "/content/posts/:id/like", Tag: "content", Summary: "Remove a post like", Auth: true, Response: dto.LikesCount{}},
+		{Method: http.MethodPost, Path: "/content/posts/:id/poll/vote", Tag: "content", Summary: "Vote in a post's poll", Auth: true, Request: dto.VotePollRequest{}, Response: dto.Poll{}},
+		{Method: http.MethodGet, Path: "/content/posts/:id/comments", Tag: "content", Summary: "List a post's comments", Response: []dto.Comment{}},
+		{Method: http.MethodPost, Path: "/content/posts/:id/comments", Tag: "content", Summary: "Comment on a post", Auth: true, Request: contentpb.CreateCommentRequest{}, Response: dto.Comment{}},
+		{Method: http.MethodDelete, Path: "/content/comments/:id", Tag: "content", Summary: "Delete a comment", Auth: true, Response: dto.Message{}},
+		{Method: http.MethodPost, Path: "/content/comments/:id/like", Tag: "content", Summary: "Like a comment", Auth: true, Response: dto.LikesCount{}},
+		{Method: http.MethodDelete, Path: "/content/comments/:id/like", Tag: "content", Summary: "Remove a comment like", Auth: true, Response: dto.LikesCount{}},
+
+		// Media
+		{Method: http.MethodPost, Path: "/media", Tag: "media", Summary: "Upload an image or file for a post", Auth: true, Upload: mediaFormField, Status: http.StatusCreated, Response: dto.Media{}},
+		{Method: http.MethodGet, Path: "/media/:id", Tag: "media", Summary: "Media metadata with signed download URLs", Response: dto.Media{}},
+		{Method: http.MethodGet, Path: "/media/:id/content", Tag: "media", Summary: "Redirect to a signed download URL", Status: http.StatusFound},
+		{Method: http.MethodGet, Path: "/media/:id/thumbnail", Tag: "media", Summary: "Redirect to a signed thumbnail URL", Status: http.StatusFound},
+
+		// Notifications
+		{Method: http.MethodPost, Path: "/notify/welcome", Tag: "notifications", Summary: "Send a welcome email", Auth: true, Request: notificationpb.EmailRequest{}, Response: notificationpb.NotificationResponse{}},
+		{Method: http.MethodPost, Path: "/notify/system-message", Tag: "notifications", Summary: "Broadcast a system message", Auth: true, Request: notificationpb.SystemMessageRequest{}, Response: notificationpb.NotificationResponse{}},
+		{Method: http.MethodPost, Path: "/notify/contact", Tag: "notifications", Summary: "Send the contact form", Request: notificationpb.ContactRequest{}, Response: notificationpb.NotificationResponse{}},
+		{Method: http.MethodGet, Path: "/notifications", Tag: "notifications", Summary: "List the caller's notifications", Auth: true, Query: []string{"userId", "page", "limit"}, Response: []notificationpb.Notification{}},
+		{Method: http.MethodPost, Path: "/events/ticket", Tag: "notifications", Summary: "Get a single-use ticket that opens GET /events", Auth: true, Response: dto.EventTicket{}},
+
+		// Subscriptions
+		{Method: http.MethodGet, Path: "/subscriptions/me", Tag: "subscriptions", Summary: "Current subscription", Auth: true, Response: subpb.SubscriptionResponse{}},
+		{Method: http.MethodGet, Path: "/subscriptions/:userId", Tag: "subscriptions", Summary: "A user's subscription", Auth: true, Response: subpb.SubscriptionResponse{}},
+		{Method: http.MethodPost, Path: "/subscriptions/subscribe", Tag: "subscriptions", Summary: "Subscribe to a plan", Auth: true, Headers: idempotent, Request: dto.SubscribeRequest{}, Response: subpb.SubscriptionResponse{}},
+		{Method: http.MethodGet, Path: "/subscriptions/me/history", Tag: "subscriptions", Summary: "Own subscription history", Auth: true, Response: []subpb.SubscriptionResponse{}},
+		{Method: http.MethodGet, Path: "/subscriptions/history/:userId", Tag: "subscriptions", Summary: "A user's subscription history", Auth: true, Response: []subpb.SubscriptionResponse{}},
+		{Method: http.MethodPost, Path: "/subscriptions/cancel", Tag: "subscriptions", Summary: "Cancel a subscription", Auth: true, Request: dto.CancelSubscriptionRequest{}, Response: subpb.SubscriptionResponse{}},
+
+		// Payments
+		{Method: http.MethodPost, Path: "/payments/process", Tag: "payments", Summary: "Process a payment", Auth: true, Headers: idempotent, Request: dto.ProcessPaymentRequest{}, Response: paypb.PaymentResponse{}},
+		{Method: http.MethodGet, Path: "/payments/me/history", Tag: "payments", Summary: "Own transaction history", Auth: true, Response: paypb.HistoryResponse{}},
+		{Method: http.MethodGet, Path: "/payments/history/:userId", Tag: "payments", Summary: "A user's transaction history", Auth: true, Response: paypb.HistoryResponse{}},
+
+		// Consultations
+		{Method: http.MethodGet, Path: "/consultations/experts", Tag: "consultations", Summary: "List available experts", Response: conpb.ExpertList{}},
+		{Method: http.MethodPost, Path: "/consultations/experts", Tag: "consultations", Summary: "Register an expert", Auth: true, Request: dto.RegisterExpertRequest{}, Response: conpb.ExpertProfile{}},
+		{Method: http.MethodPost, Path: "/consultations/book", Tag: "consultations", Summary: "Book a consultation", Auth: true, Headers: idempotent, Request: dto.BookConsultationRequest{}, Response: conpb.BookingResponse{}},
+		{Method: http.MethodPost, Path: "/consultations/confirm/:bookingId", Tag: "consultations", Summary: "Confirm a booking's payment", Auth: true, Response: dto.Status{}},
+		{Method: http.MethodGet, Path: "/consultations/me", Tag: "consultations", Summary: "Own bookings", Auth: true, Response: []conpb.BookingDetail{}},
+		{Method: http.MethodGet, Path: "/consultations/user/:userId", Tag: "consultations", Summary: "A user's bookings", Auth: true, Response: []conpb.BookingDetail{}},
+		{Method: http.MethodPost, Path: "/consultations/cancel", Tag: "consultations", Summary: "Cancel a booking", Auth: true, Request: dto.CancelBookingRequest{}, Response: conpb.BookingResponse{}},
+
+		// Admin
+		{Method: http.MethodGet, Path: "/admin/users", Tag: "admin", Summary: "List and filter users", Auth: true, Query: []string{"search", "role", "status", "page", "limit"}, Response: dto.UserPage{}},
+		{Method: http.MethodPut, Path: "/admin/users/:id/role", Tag: "admin", Summary: "Change a user's role", Auth: true, Request: dto.RoleChangeRequest{}, Response: dto.Message{}},
+		{Method: http.MethodPost, Path: "/admin/users/:id/ban", Tag: "admin", Summary: "Ban or suspend a user", Auth: true, Request: dto.BanRequest{}, Response: dto.Message{}},
+		{Method: http.MethodPost, Path: "/admin/users/:id/unban", Tag: "admin", Summary: "Lift a user's ban or suspension", Auth: true, Request: dto.UnbanRequest{}, Response: dto.Message{}},
+		{Method: http.MethodDelete, Path: "/admin/users/:id", Tag: "admin", Summary: "Delete a user's account", Auth: true, Response: dto.Message{}},
+		{Method: http.MethodGet, Path: "/admin/dashboard", Tag: "admin", Summary: "Counts from every service", Auth: true, Response: dto.AdminDashboard{}},
+	}
+}
+
+// opsRoutes are served at the root, outside any API version.
+var opsRoutes = []openapi.Route{
+	{Method: http.MethodGet, Path: "/healthz", Tag: "health", Summary: "Gateway liveness and downstream reachability", Response: dto.Health{}},
+	{Method: http.MethodGet, Path: "/readyz", Tag: "health", Summary: "Readiness: every downstream service is serving", Response: dto.Health{}},
+	{Method: http.MethodGet, Path: "/.well-known/jwks.json", Tag: "auth", Summary: "Public keys access tokens are verified with", Response: jwks.Set{}},
+}
+
+// Spec builds the gateway's OpenAPI document from Routes.
+func Spec() *openapi.Document {
+	return openapi.Build("BiznesAsh API Gateway", APIVersion, Routes(), apierror.Problem{})
+}
+
+// RegisterOpenAPIRoute serves the document at /openapi.json. It is built once at
+// startup; the types it describes can't change at runtime.
+func RegisterOpenAPIRoute(r *gin.Engine) {
+	spec := Spec()
+	r.GET("/openapi.json", func(c *gin.Context) {
+		c.JSON(http.StatusOK, spec)
+	})
+}
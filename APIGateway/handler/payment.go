@@ -1,50 +1,56 @@
 package handler
 
 import (
-	"context"
 	"net/http"
 
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/dto"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
 	pb "github.com/KaminurOrynbek/BiznesAsh/PaymentService/proto"
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterPaymentRoutes(r *gin.Engine, client pb.PaymentServiceClient) {
-	api := r.Group("/api/v1/payments")
+func RegisterPaymentRoutes(r gin.IRouter, client pb.PaymentServiceClient) {
+	api := r.Group("/payments", middleware.RequireAuth())
 
 	api.POST("/process", func(c *gin.Context) {
-		var req struct {
-			UserID        string  `json:"userId"`
-			Amount        float64 `json:"amount"`
-			Currency      string  `json:"currency"`
-			ReferenceType string  `json:"referenceType"` // SUBSCRIPTION, CONSULTATION
-			ReferenceID   string  `json:"referenceId"`
+		var req dto.ProcessPaymentRequest
+		if !bindJSON(c, &req) {
+			return
 		}
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		userID, ok := targetUserID(c, req.UserID)
+		if !ok {
 			return
 		}
 
-		resp, err := client.ProcessPayment(context.Background(), &pb.ProcessPaymentRequest{
-			UserId:        req.UserID,
-			Amount:        req.Amount,
-			Currency:      req.Currency,
-			ReferenceType: req.ReferenceType,
-			ReferenceId:   req.ReferenceID,
+		resp, err := client.ProcessPayment(middleware.OutgoingContext(c), &pb.ProcessPaymentRequest{
+			UserId:         userID,
+			Amount:         req.Amount,
+			Currency:       req.Currency,
+			ReferenceType:  req.ReferenceType,
+			ReferenceId:    req.ReferenceID,
+			IdempotencyKey: middleware.IdempotencyKey(c),
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
 	})
 
-	api.GET("/history/:userId", func(c *gin.Context) {
-		userId := c.Param("userId")
-		resp, err := client.GetTransactionHistory(context.Background(), &pb.GetHistoryRequest{UserId: userId})
+	history := func(c *gin.Context) {
+		userID, ok := targetUserID(c, c.Param("userId"))
+		if !ok {
+			return
+		}
+		resp, err := client.GetTransactionHistory(middleware.OutgoingContext(c), &pb.GetHistoryRequest{UserId: userID})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
-	})
+	}
+	api.GET("/me/history", history)
+	api.GET("/history/:userId", history) // own id, or any id for admins
 }
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
+	contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterOwnerChecks binds the ownership checks referenced by the REST rules.
+func RegisterOwnerChecks(p *policy.Policy, contentClient contentpb.ContentServiceClient) {
+	p.RegisterOwner(policy.OwnerSelf, func(_ context.Context, id policy.Identity, resource interface{}) (bool, error) {
+		c, ok := resource.(*gin.Context)
+		if !ok {
+			return false, nil
+		}
+		target := c.Param("userId")
+		if target == "" {
+			target = c.Param("id")
+		}
+		return target == "me" || target == id.UserID, nil
+	})
+
+	p.RegisterOwner(policy.OwnerPostAuthor, func(_ context.Context, id policy.Identity, resource interface{}) (bool, error) {
+		c, ok := resource.(*gin.Context)
+		if !ok {
+			return false, nil
+		}
+		resp, err := contentClient.GetPost(middleware.OutgoingContext(c), &contentpb.PostIdRequest{Id: c.Param("id")})
+		if status.Code(err) == codes.NotFound {
+			return false, policy.ErrNotFound
+		}
+		if err != nil {
+			return false, err
+		}
+		return resp.GetPost().GetAuthorId() == id.UserID, nil
+	})
+}
@@ -1,73 +1,108 @@
 package handler
 
 import (
-	"context"
 	"net/http"
 
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/dto"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
 	pb "github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/proto"
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterSubscriptionRoutes(r *gin.Engine, client pb.SubscriptionServiceClient) {
-	subs := r.Group("/api/v1/subscriptions")
+func RegisterSubscriptionRoutes(r gin.IRouter, client pb.SubscriptionServiceClient) {
+	subs := r.Group("/subscriptions", middleware.RequireAuth())
 
-	subs.GET("/:userId", func(c *gin.Context) {
-		userId := c.Param("userId")
-		resp, err := client.GetSubscription(context.Background(), &pb.GetSubscriptionRequest{UserId: userId})
+	current := func(c *gin.Context) {
+		userID, ok := targetUserID(c, c.Param("userId"))
+		if !ok {
+			return
+		}
+		resp, err := client.GetSubscription(middleware.OutgoingContext(c), &pb.GetSubscriptionRequest{UserId: userID})
 		if err != nil {
-			c.JSON(http.StatusNotFound, gin.H{"error": "subscription not found"})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
-	})
+	}
+	subs.GET("/me", current)
+	subs.GET("/:userId", current) // own id, or any id for admins
 
 	subs.POST("/subscribe", func(c *gin.Context) {
-		var req struct {
-			UserID         string `json:"userId"`
-			PlanType       string `json:"planType"`
-			DurationMonths int    `json:"durationMonths"`
+		var req dto.SubscribeRequest
+		if !bindJSON(c, &req) {
+			return
 		}
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+
+		userID, ok := targetUserID(c, req.UserID)
+		if !ok {
 			return
 		}
 
-		resp, err := client.UpdateSubscription(context.Background(), &pb.UpdateSubscriptionRequest{
-			UserId:         req.UserID,
+		resp, err := client.UpdateSubscription(middleware.OutgoingContext(c), &pb.UpdateSubscriptionRequest{
+			UserId:         userID,
 			PlanType:       req.PlanType,
 			DurationMonths: int32(req.DurationMonths),
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
 	})
 
-	subs.GET("/history/:userId", func(c *gin.Context) {
-		userId := c.Param("userId")
-		resp, err := client.GetSubscriptionHistory(context.Background(), &pb.GetSubscriptionRequest{UserId: userId})
+	history := func(c *gin.Context) {
+		userID, ok := targetUserID(c, c.Param("userId"))
+		if !ok {
+			return
+		}
+		resp, err := client.GetSubscriptionHistory(middleware.OutgoingContext(c), &pb.GetSubscriptionRequest{UserId: userID})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp.Subscriptions)
-	})
+	}
+	subs.GET("/me/history", history)
+	subs.GET("/history/:userId", history)
 
 	subs.POST("/cancel", func(c *gin.Context) {
-		var req struct {
-			ID string `json:"id"`
-		}
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		var req dto.CancelSubscriptionRequest
+		if !bindJSON(c, &req) {
 			return
 		}
 
-		resp, err := client.CancelSubscription(context.Background(), &pb.CancelSubscriptionRequest{Id: req.ID})
+		if !middleware.IsAdmin(c) {
+			owned, err := ownsSubscription(c, client, req.ID)
+			if err != nil {
+				apierror.Respond(c, err)
+				return
+			}
+			if !owned {
+				apierror.Abort(c, http.StatusForbidden, "you can only cancel your own subscriptions")
+				return
+			}
+		}
+
+		resp, err := client.CancelSubscription(middleware.OutgoingContext(c), &pb.CancelSubscriptionRequest{Id: req.ID})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, resp)
 	})
 }
+
+// ownsSubscription reports whether the subscription belongs to the caller.
+func ownsSubscription(c *gin.Context, client pb.SubscriptionServiceClient, id string) (bool, error) {
+	resp, err := client.GetSubscriptionHistory(middleware.OutgoingContext(c), &pb.GetSubscriptionRequest{UserId: middleware.UserID(c)})
+	if err != nil {
+		return false, err
+	}
+	for _, s := range resp.GetSubscriptions() {
+		if s.GetId() == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
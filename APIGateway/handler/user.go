@@ -1,161 +1,238 @@
 package handler
 
 import (
-	"context"
 	"net/http"
 	"strings"
 
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/dto"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
 	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc/metadata"
 )
 
-func RegisterUserRoutes(r *gin.Engine, client userpb.UserServiceClient) {
+func RegisterUserRoutes(r gin.IRouter, m Mappers, client userpb.UserServiceClient) {
 	auth := r.Group("/auth")
 
 	auth.GET("/ping", func(c *gin.Context) {
-		c.JSON(200, gin.H{"ok": true, "src": "TECHENTR_HANDLER"})
+		c.JSON(http.StatusOK, dto.Status{Status: "ok"})
 	})
 
 	auth.POST("/register", func(c *gin.Context) {
 		var req userpb.RegisterRequest
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if !bindJSON(c, &req) {
 			return
 		}
 
-		resp, err := client.Register(context.Background(), &req)
+		resp, err := client.Register(middleware.OutgoingContext(c), &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, resp)
+		login := &userpb.LoginResponse{
+			UserId:       resp.GetUserId(),
+			Token:        resp.GetToken(),
+			RefreshToken: resp.GetRefreshToken(),
+			ExpiresIn:    resp.GetExpiresIn(),
+		}
+		c.JSON(http.StatusOK, m.Auth(login, &userpb.UserResponse{
+			UserId:   resp.GetUserId(),
+			Username: resp.GetUsername(),
+			Email:    resp.GetEmail(),
+			Role:     resp.GetRole(),
+		}))
 	})
 
 	// POST /auth/login
 	auth.POST("/login", func(c *gin.Context) {
 		var req userpb.LoginRequest
-		if err := c.ShouldBindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if !bindJSON(c, &req) {
 			return
 		}
 
-		resp, err := client.Login(context.Background(), &req)
+		resp, err := client.Login(middleware.OutgoingContext(c), &req)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 
 		// Return token and user so frontend does not need a separate GET /auth/me
 		authHeader := "Bearer " + resp.GetToken()
-		ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", authHeader))
-		userResp, err := client.GetCurrentUser(ctx, &userpb.Empty{})
+		ctx := metadata.NewOutgoingContext(c.Request.Context(), metadata.Pairs("authorization", authHeader))
+		user, _ := client.GetCurrentUser(ctx, &userpb.Empty{}) // nil on error; the token is valid regardless
+		c.JSON(http.StatusOK, m.Auth(resp, user))
+	})
+
+	// POST /auth/refresh - trade a refresh token for a new token pair
+	auth.POST("/refresh", func(c *gin.Context) {
+		var req userpb.RefreshTokenRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+
+		resp, err := client.RefreshToken(middleware.OutgoingContext(c), &req)
+		if err != nil {
+			apierror.Respond(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, m.Auth(resp, nil))
+	})
+
+	// POST /auth/logout - end the current session
+	auth.POST("/logout", func(c *gin.Context) {
+		logout(c, client, &userpb.LogoutRequest{})
+	})
+
+	// POST /auth/logout-all - end every session of the caller, this one included
+	auth.POST("/logout-all", func(c *gin.Context) {
+		resp, err := client.LogoutAllSessions(middleware.OutgoingContext(c), &userpb.Empty{})
+		if err != nil {
+			apierror.Respond(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.Logout{Message: resp.GetMessage(), SessionsRevoked: resp.GetSessionsRevoked()})
+	})
+
+	// GET /auth/sessions - the devices signed in to the caller's account
+	auth.GET("/sessions", func(c *gin.Context) {
+		resp, err := client.ListSessions(middleware.OutgoingContext(c), &userpb.Empty{})
+		if err != nil {
+			apierror.Respond(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, m.Sessions(resp))
+	})
+
+	// DELETE /auth/sessions/:id - sign one device out
+	auth.DELETE("/sessions/:id", func(c *gin.Context) {
+		logout(c, client, &userpb.LogoutRequest{SessionId: c.Param("id")})
+	})
+
+	// POST /auth/password/forgot - email a password reset link
+	auth.POST("/password/forgot", func(c *gin.Context) {
+		var req userpb.PasswordResetRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+
+		resp, err := client.RequestPasswordReset(middleware.OutgoingContext(c), &req)
+		if err != nil {
+			apierror.Respond(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.Message{Message: resp.GetMessage()})
+	})
+
+	// POST /auth/password/reset - set a new password with the emailed token
+	auth.POST("/password/reset", func(c *gin.Context) {
+		var req userpb.ResetPasswordRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+
+		resp, err := client.ResetPassword(middleware.OutgoingContext(c), &req)
+		if err != nil {
+			apierror.Respond(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.Logout{Message: resp.GetMessage(), SessionsRevoked: resp.GetSessionsRevoked()})
+	})
+
+	// POST /auth/password/change - replace the caller's password; ends every session
+	auth.POST("/password/change", func(c *gin.Context) {
+		var req userpb.ChangePasswordRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+
+		resp, err := client.ChangePassword(middleware.OutgoingContext(c), &req)
 		if err != nil {
-			c.JSON(http.StatusOK, gin.H{"userId": resp.GetUserId(), "token": resp.GetToken()})
-			return
-		}
-		c.JSON(http.StatusOK, gin.H{
-			"token": resp.GetToken(),
-			"user": gin.H{
-				"id":        userResp.GetUserId(),
-				"username":  userResp.GetUsername(),
-				"email":     userResp.GetEmail(),
-				"role":      userResp.GetRole(),
-				"bio":       userResp.GetBio(),
-				"createdAt": "",
-				"updatedAt": "",
-			},
-		})
+			apierror.Respond(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.Logout{Message: resp.GetMessage(), SessionsRevoked: resp.GetSessionsRevoked()})
 	})
 
 	// GET /auth/me - current user (requires Bearer token)
 	auth.GET("/me", func(c *gin.Context) {
-		handleGetCurrentUser(c, client)
+		handleGetCurrentUser(c, m, client)
 	})
 
 	// GET /users/me - current user (requires Bearer token), same as /auth/me
 	users := r.Group("/users")
 	users.GET("/me", func(c *gin.Context) {
-		handleGetCurrentUser(c, client)
+		handleGetCurrentUser(c, m, client)
 	})
 	users.GET("/:id", func(c *gin.Context) {
 		id := c.Param("id")
-		resp, err := client.GetUser(context.Background(), &userpb.GetUserRequest{UserId: id})
+		resp, err := client.GetUser(middleware.OutgoingContext(c), &userpb.GetUserRequest{UserId: id})
 		if err != nil {
-			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			apierror.Respond(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{
-			"id":        resp.GetUserId(),
-			"username":  resp.GetUsername(),
-			"email":     resp.GetEmail(),
-			"role":      resp.GetRole(),
-			"bio":       resp.GetBio(),
-			"createdAt": "",
-			"updatedAt": "",
-		})
+		c.JSON(http.StatusOK, m.User(resp))
 	})
-	users.PUT("/:id", updateProfileHandler(client))
+	users.PUT("/:id", updateProfileHandler(m, client))
+}
+
+func logout(c *gin.Context, client userpb.UserServiceClient, req *userpb.LogoutRequest) {
+	resp, err := client.Logout(middleware.OutgoingContext(c), req)
+	if err != nil {
+		apierror.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.Logout{Message: resp.GetMessage(), SessionsRevoked: resp.GetSessionsRevoked()})
 }
 
 // handleGetCurrentUser forwards Authorization header to UserService and returns current user.
-func handleGetCurrentUser(c *gin.Context, client userpb.UserServiceClient) {
+func handleGetCurrentUser(c *gin.Context, m Mappers, client userpb.UserServiceClient) {
 	authHeader := c.GetHeader("Authorization")
 	if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing or invalid authorization header"})
+		apierror.Abort(c, http.StatusUnauthorized, "missing or invalid authorization header")
 		return
 	}
 
 	ctx := metadata.NewOutgoingContext(c.Request.Context(), metadata.Pairs("authorization", authHeader))
 	resp, err := client.GetCurrentUser(ctx, &userpb.Empty{})
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		apierror.Respond(c, err)
 		return
 	}
 
 	// Map proto UserResponse to JSON shape expected by frontend (id, username, email, createdAt, updatedAt)
-	c.JSON(http.StatusOK, gin.H{
-		"id":        resp.GetUserId(),
-		"username":  resp.GetUsername(),
-		"email":     resp.GetEmail(),
-		"role":      resp.GetRole(),
-		"bio":       resp.GetBio(),
-		"createdAt": "",
-		"updatedAt": "",
-	})
+	c.JSON(http.StatusOK, m.User(resp))
 }
 
-func updateProfileHandler(client userpb.UserServiceClient) gin.HandlerFunc {
+func updateProfileHandler(m Mappers, client userpb.UserServiceClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "missing or invalid authorization header"})
+			apierror.Abort(c, http.StatusUnauthorized, "missing or invalid authorization header")
 			return
 		}
 
 		var req userpb.UpdateProfileRequest
-		if err := c.BindJSON(&req); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if !bindJSON(c, &req) {
 			return
 		}
 
 		ctx := metadata.NewOutgoingContext(c.Request.Context(), metadata.Pairs("authorization", authHeader))
 		resp, err := client.UpdateProfile(ctx, &req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			apierror.Respond(c, err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{
-			"id":        resp.GetUserId(),
-			"username":  resp.GetUsername(),
-			"email":     resp.GetEmail(),
-			"role":      resp.GetRole(),
-			"bio":       resp.GetBio(),
-			"createdAt": "",
-			"updatedAt": "",
-		})
+		c.JSON(http.StatusOK, m.User(resp))
 	}
 }
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"strings"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/dto"
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/middleware"
+	contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	notificationpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/notification"
+	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
+	"github.com/gin-gonic/gin"
+
+	conpb "github.com/KaminurOrynbek/BiznesAsh/ConsultationService/proto"
+	paypb "github.com/KaminurOrynbek/BiznesAsh/PaymentService/proto"
+	subpb "github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/proto"
+)
+
+// Clients are the backend services behind the REST routes.
+type Clients struct {
+	User         userpb.UserServiceClient
+	Content      contentpb.ContentServiceClient
+	Notification notificationpb.NotificationServiceClient
+	Subscription subpb.SubscriptionServiceClient
+	Payment      paypb.PaymentServiceClient
+	Consultation conpb.ConsultationServiceClient
+}
+
+// Mappers shape the response bodies of one API version. Handlers are shared by
+// every version and only ever write what a mapper returns, so a new version can
+// change the wire format without copying handlers.
+type Mappers struct {
+	User func(u *userpb.UserResponse) interface{}
+	// UserPage maps one page of the admin user list.
+	UserPage func(p *userpb.UsersListResponse) interface{}
+	// Auth maps a login, registration or token refresh. user is nil on refresh
+	// and when the profile couldn't be loaded.
+	Auth     func(login *userpb.LoginResponse, user *userpb.UserResponse) interface{}
+	Sessions func(s *userpb.SessionsResponse) interface{}
+	Post     func(p *contentpb.Post, authorUsername string) interface{}
+	Comment  func(c *contentpb.Comment, authorUsername string) interface{}
+	Poll     func(p *contentpb.Poll) interface{}
+}
+
+// Version is one mounted revision of the REST API.
+type Version struct {
+	Prefix  string
+	Mappers Mappers
+}
+
+// v1Prefix is V1's prefix, for mappers that build links into the API.
+const v1Prefix = "/api/v1"
+
+// V1 is the current API. A v2 is mounted next to it by declaring another Version
+// with its own prefix and mappers, passing it to Mount, and documenting it with
+// its own route table.
+var V1 = Version{
+	Prefix: v1Prefix,
+	Mappers: Mappers{
+		User:     func(u *userpb.UserResponse) interface{} { return dto.NewUser(u) },
+		UserPage: func(p *userpb.UsersListResponse) interface{} { return dto.NewUserPage(p) },
+		Auth: func(login *userpb.LoginResponse, u *userpb.UserResponse) interface{} {
+			return dto.NewAuthResponse(login, u)
+		},
+		Sessions: func(s *userpb.SessionsResponse) interface{} { return dto.NewSessionList(s) },
+		Post:     func(p *contentpb.Post, author string) interface{} { return dto.NewPost(p, author, v1Prefix+mediaPath) },
+		Comment:  func(c *contentpb.Comment, author string) interface{} { return dto.NewComment(c, author) },
+		Poll:     func(p *contentpb.Poll) interface{} { return dto.NewPoll(p) },
+	},
+}
+
+// Mount registers every route group under v.Prefix.
+func Mount(r *gin.Engine, v Version, clients Clients) {
+	api := r.Group(v.Prefix)
+	RegisterUserRoutes(api, v.Mappers, clients.User)
+	RegisterContentRoutes(api, v.Mappers, clients.Content, clients.User)
+	RegisterNotificationRoutes(api, clients.Notification)
+	RegisterSubscriptionRoutes(api, clients.Subscription)
+	RegisterPaymentRoutes(api, clients.Payment)
+	RegisterConsultationRoutes(api, clients.Consultation)
+	RegisterAdminRoutes(api, v.Mappers, clients)
+}
+
+// legacyGroups are the route groups that were served outside /api/v1 before the
+// API was versioned. Payments, subscriptions and consultations always lived
+// under /api/v1 and have no legacy alias.
+var legacyGroups = []string{"/auth", "/users", "/content", "/notify", "/notifications"}
+
+// legacyPostsPath was a duplicate of /content/posts for post creation.
+const legacyPostsPath = "/posts"
+
+// MountLegacy keeps the unversioned paths working as aliases of V1. Install
+// LegacyDeprecation on the router to announce their removal.
+func MountLegacy(r *gin.Engine, clients Clients) {
+	legacy := r.Group("")
+	RegisterUserRoutes(legacy, V1.Mappers, clients.User)
+	RegisterContentRoutes(legacy, V1.Mappers, clients.Content, clients.User)
+	RegisterNotificationRoutes(legacy, clients.Notification)
+	legacy.POST(legacyPostsPath, createPostHandler(clients.Content, clients.User, V1.Mappers))
+}
+
+// LegacyDeprecation gives every response of a MountLegacy route Deprecation
+// and Sunset headers and a Link to the /api/v1 successor, and counts the call
+// so the aliases can be removed once unused. It is router-wide middleware,
+// ahead of the response cache, so cached responses carry the headers too.
+func LegacyDeprecation(since, sunset time.Time) gin.HandlerFunc {
+	return middleware.Deprecated(middleware.Deprecation{
+		Since:     since,
+		Sunset:    sunset,
+		Successor: legacySuccessor,
+		Routes:    isLegacyPath,
+	})
+}
+
+// legacySuccessor maps a legacy request path to its /api/v1 equivalent.
+func legacySuccessor(path string) string {
+	if path == legacyPostsPath {
+		return V1.Prefix + "/content/posts"
+	}
+	return V1.Prefix + path
+}
+
+// isLegacyPath reports whether an unprefixed route is served by MountLegacy.
+func isLegacyPath(path string) bool {
+	if path == legacyPostsPath {
+		return true
+	}
+	for _, group := range legacyGroups {
+		if path == group || strings.HasPrefix(path, group+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+// Package apierror writes every gateway error as the same JSON problem body and
+// translates gRPC statuses from the backing services into HTTP statuses.
+package apierror
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/validate"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HeaderRequestID carries the request id; the RequestID middleware sets it on every response.
+const HeaderRequestID = "X-Request-ID"
+
+// Stable, machine-readable error codes. Clients should branch on these rather
+// than on the human-readable message.
+const (
+	CodeInvalidArgument    = "INVALID_ARGUMENT"
+	CodeUnauthenticated    = "UNAUTHENTICATED"
+	CodePermissionDenied   = "PERMISSION_DENIED"
+	CodeNotFound           = "NOT_FOUND"
+	CodeAlreadyExists      = "ALREADY_EXISTS"
+	CodeFailedPrecondition = "FAILED_PRECONDITION"
+	CodePayloadTooLarge    = "PAYLOAD_TOO_LARGE"
+	CodeUnsupportedMedia   = "UNSUPPORTED_MEDIA_TYPE"
+	CodeRateLimited        = "RATE_LIMITED"
+	CodeCanceled           = "CANCELED"
+	CodeInternal           = "INTERNAL"
+	CodeNotImplemented     = "NOT_IMPLEMENTED"
+	CodeUnavailable        = "UNAVAILABLE"
+	CodeTimeout            = "TIMEOUT"
+	// An Idempotency-Key was sent again with a different request.
+	CodeIdempotencyKeyReused = "IDEMPOTENCY_KEY_REUSED"
+	// The first request with this Idempotency-Key hasn't finished yet.
+	CodeRequestInProgress = "REQUEST_IN_PROGRESS"
+)
+
+// statusClientClosedRequest is the de facto status for a request the client abandoned.
+const statusClientClosedRequest = 499
+
+// Problem is the JSON body of every error response. Error keeps the field name
+// the frontend already reads.
+type Problem struct {
+	Error     string `json:"error"`
+	Code      string `json:"code"`
+	Status    int    `json:"status"`
+	RequestID string `json:"requestId,omitempty"`
+	// Fields lists each offending field when a request fails validation.
+	Fields []validate.Violation `json:"fields,omitempty"`
+}
+
+var grpcToHTTP = map[codes.Code]struct {
+	status int
+	code   string
+}{
+	codes.InvalidArgument:    {http.StatusBadRequest, CodeInvalidArgument},
+	codes.OutOfRange:         {http.StatusBadRequest, CodeInvalidArgument},
+	codes.FailedPrecondition: {http.StatusBadRequest, CodeFailedPrecondition},
+	codes.Unauthenticated:    {http.StatusUnauthorized, CodeUnauthenticated},
+	codes.PermissionDenied:   {http.StatusForbidden, CodePermissionDenied},
+	codes.NotFound:           {http.StatusNotFound, CodeNotFound},
+	codes.AlreadyExists:      {http.StatusConflict, CodeAlreadyExists},
+	codes.Aborted:            {http.StatusConflict, CodeAlreadyExists},
+	codes.ResourceExhausted:  {http.StatusTooManyRequests, CodeRateLimited},
+	codes.Canceled:           {statusClientClosedRequest, CodeCanceled},
+	codes.Unimplemented:      {http.StatusNotImplemented, CodeNotImplemented},
+	codes.Unavailable:        {http.StatusServiceUnavailable, CodeUnavailable},
+	codes.DeadlineExceeded:   {http.StatusGatewayTimeout, CodeTimeout},
+}
+
+var statusToCode = map[int]string{
+	http.StatusBadRequest:            CodeInvalidArgument,
+	http.StatusUnauthorized:          CodeUnauthenticated,
+	http.StatusForbidden:             CodePermissionDenied,
+	http.StatusNotFound:              CodeNotFound,
+	http.StatusConflict:              CodeAlreadyExists,
+	http.StatusRequestEntityTooLarge: CodePayloadTooLarge,
+	http.StatusUnsupportedMediaType:  CodeUnsupportedMedia,
+	http.StatusTooManyRequests:       CodeRateLimited,
+	http.StatusNotImplemented:        CodeNotImplemented,
+	http.StatusServiceUnavailable:    CodeUnavailable,
+	http.StatusGatewayTimeout:        CodeTimeout,
+	http.StatusInternalServerError:   CodeInternal,
+}
+
+// FromGRPC maps err to an HTTP status, a stable code and a client-safe message.
+// Server-side failures (5xx) get a generic message so internal details don't leak.
+func FromGRPC(err error) (int, string, string) {
+	st, _ := status.FromError(err)
+	m, ok := grpcToHTTP[st.Code()]
+	if !ok {
+		m.status, m.code = http.StatusInternalServerError, CodeInternal
+	}
+	if m.status >= http.StatusInternalServerError {
+		return m.status, m.code, strings.ToLower(http.StatusText(m.status))
+	}
+	return m.status, m.code, st.Message()
+}
+
+// Respond aborts the request with the problem body for an error returned by a
+// gRPC client or by validate. Field violations are listed in Fields.
+func Respond(c *gin.Context, err error) {
+	httpStatus, code, message := FromGRPC(err)
+	if httpStatus >= http.StatusInternalServerError {
+		slog.ErrorContext(c.Request.Context(), "request failed", "method", c.Request.Method, "route", c.FullPath(), logging.Err(err))
+	}
+	write(c, httpStatus, code, message, validate.Violations(err)...)
+}
+
+// Abort aborts the request with status and message; the code is derived from status.
+func Abort(c *gin.Context, httpStatus int, message string) {
+	code, ok := statusToCode[httpStatus]
+	if !ok {
+		code = CodeInternal
+	}
+	write(c, httpStatus, code, message)
+}
+
+// AbortCode aborts with an explicit code, for a status that several codes share.
+func AbortCode(c *gin.Context, httpStatus int, code, message string) {
+	write(c, httpStatus, code, message)
+}
+
+// BadRequest aborts with 400 for a request the gateway itself rejected, e.g. malformed JSON.
+func BadRequest(c *gin.Context, err error) {
+	Abort(c, http.StatusBadRequest, err.Error())
+}
+
+func write(c *gin.Context, httpStatus int, code, message string, fields ...validate.Violation) {
+	c.AbortWithStatusJSON(httpStatus, Problem{
+		Error:     message,
+		Code:      code,
+		Status:    httpStatus,
+		RequestID: requestID(c),
+		Fields:    fields,
+	})
+}
+
+func requestID(c *gin.Context) string {
+	return c.Writer.Header().Get(HeaderRequestID)
+}
@@ -0,0 +1,34 @@
+// Package blob stores uploaded media. The gateway never proxies downloads
+// through its handlers: clients fetch blobs from short-lived signed URLs issued
+// by the store, whether that is the local filesystem store served by the
+// gateway itself or an S3-compatible bucket.
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// ErrNotFound is returned for keys that were never stored or were deleted.
+var ErrNotFound = errors.New("blob not found")
+
+// URLOptions shape the response to a signed download URL.
+type URLOptions struct {
+	// ContentType is sent as the response Content-Type.
+	ContentType string
+	// Filename, when set, makes the response an attachment with this name.
+	Filename string
+}
+
+// Store is a flat key/value store for blobs. Keys are slash-separated paths
+// chosen by the gateway.
+type Store interface {
+	// Put stores size bytes from r under key, replacing any existing blob.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Delete removes key; deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+	// SignedURL returns a URL that downloads key until ttl has passed.
+	SignedURL(ctx context.Context, key string, ttl time.Duration, opts URLOptions) (string, error)
+}
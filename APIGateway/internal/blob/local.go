@@ -0,0 +1,189 @@
+package blob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalStore keeps blobs on the local filesystem and serves them itself. Its
+// URLs point at the gateway route the store is mounted on and carry an HMAC
+// over the key, expiry and response headers, so they can't be altered or
+// reused after they expire.
+type LocalStore struct {
+	root    string
+	baseURL string
+	secret  []byte
+	now     func() time.Time
+}
+
+// NewLocalStore stores blobs under root. baseURL is the absolute or
+// root-relative URL the store's handler is mounted at, e.g. "/api/v1/blobs".
+func NewLocalStore(root, baseURL string, secret []byte) (*LocalStore, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("blob: local store needs a signing secret")
+	}
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("blob: create %s: %w", root, err)
+	}
+	return &LocalStore{
+		root:    root,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		secret:  secret,
+		now:     time.Now,
+	}, nil
+}
+
+func (s *LocalStore) Put(_ context.Context, key string, r io.Reader, _ int64, _ string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never see a partial blob
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *LocalStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStore) SignedURL(_ context.Context, key string, ttl time.Duration, opts URLOptions) (string, error) {
+	if _, err := s.path(key); err != nil {
+		return "", err
+	}
+	expires := strconv.FormatInt(s.now().Add(ttl).Unix(), 10)
+
+	q := url.Values{}
+	q.Set("expires", expires)
+	if opts.ContentType != "" {
+		q.Set("type", opts.ContentType)
+	}
+	if opts.Filename != "" {
+		q.Set("filename", opts.Filename)
+	}
+	q.Set("sig", s.sign(key, expires, opts))
+	return s.baseURL + "/" + escapeKey(key) + "?" + q.Encode(), nil
+}
+
+// ServeHTTP serves a blob for a URL issued by SignedURL. The request path is
+// the key relative to the mount point, so mount it with http.StripPrefix or an
+// equivalent.
+func (s *LocalStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	q := r.URL.Query()
+	opts := URLOptions{ContentType: q.Get("type"), Filename: q.Get("filename")}
+	expires := q.Get("expires")
+
+	sig, err := base64.RawURLEncoding.DecodeString(q.Get("sig"))
+	if err != nil || !hmac.Equal(sig, s.mac(key, expires, opts)) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || s.now().Unix() > unix {
+		http.Error(w, "link expired", http.StatusForbidden)
+		return
+	}
+
+	path, err := s.path(key)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil || info.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+
+	if opts.ContentType != "" {
+		w.Header().Set("Content-Type", opts.ContentType)
+	}
+	if opts.Filename != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": opts.Filename}))
+	}
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, max-age="+strconv.FormatInt(max(unix-s.now().Unix(), 0), 10))
+	http.ServeContent(w, r, "", info.ModTime(), f)
+}
+
+// path maps key into root, refusing keys that would escape it.
+func (s *LocalStore) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("blob: invalid key %q", key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return "", fmt.Errorf("blob: invalid key %q", key)
+		}
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+func (s *LocalStore) sign(key, expires string, opts URLOptions) string {
+	return base64.RawURLEncoding.EncodeToString(s.mac(key, expires, opts))
+}
+
+func (s *LocalStore) mac(key, expires string, opts URLOptions) []byte {
+	m := hmac.New(sha256.New, s.secret)
+	for _, part := range []string{key, expires, opts.ContentType, opts.Filename} {
+		io.WriteString(m, part)
+		m.Write([]byte{0})
+	}
+	return m.Sum(nil)
+}
+
+// escapeKey escapes each path segment of key for use in a URL path.
+func escapeKey(key string) string {
+	parts := strings.Split(key, "/")
+	for i, p := range parts {
+		parts[i] = url.PathEscape(p)
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,130 @@
+package dto
+
+import (
+	contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
+
+	paypb "github.com/KaminurOrynbek/BiznesAsh/PaymentService/proto"
+	subpb "github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/proto"
+)
+
+// UserPage is one page of the admin user list.
+type UserPage struct {
+	Users      []AdminUser `json:"users"`
+	Total      int32       `json:"total"`
+	Page       int32       `json:"page"`
+	TotalPages int32       `json:"totalPages"`
+}
+
+// AdminUser is a user as the admin panel sees them, with the staff note on
+// their ban.
+type AdminUser struct {
+	User
+	BanDetails string `json:"banDetails,omitempty"`
+}
+
+func NewUserPage(p *userpb.UsersListResponse) UserPage {
+	users := make([]AdminUser, 0, len(p.GetUsers()))
+	for _, u := range p.GetUsers() {
+		users = append(users, AdminUser{User: NewUser(u), BanDetails: u.GetBanDetails()})
+	}
+	return UserPage{Users: users, Total: p.GetTotal(), Page: p.GetPage(), TotalPages: p.GetTotalPages()}
+}
+
+// RoleChangeRequest sets a user's role from the admin panel.
+type RoleChangeRequest struct {
+	Role string `json:"role"` // user, moderator, expert or admin
+}
+
+// BanRequest bans a user from the admin panel. Reason is one of
+// validate.BanReasons and defaults to other; ExpiresAt (RFC 3339) makes the
+// ban a suspension.
+type BanRequest struct {
+	Reason    string `json:"reason"`
+	Details   string `json:"details"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// UnbanRequest lifts a ban. Reason is one of validate.UnbanReasons and
+// defaults to other.
+type UnbanRequest struct {
+	Reason  string `json:"reason"`
+	Details string `json:"details"`
+}
+
+// AdminDashboard summarises every service for the admin panel. A section is
+// null when its service didn't answer, and the service is listed in
+// Unavailable.
+type AdminDashboard struct {
+	Users         *UserStats         `json:"users"`
+	Content       *ContentStats      `json:"content"`
+	Subscriptions *SubscriptionStats `json:"subscriptions"`
+	Payments      *PaymentStats      `json:"payments"`
+	Unavailable   []string           `json:"unavailable,omitempty"`
+	GeneratedAt   string             `json:"generatedAt"`
+}
+
+// UserStats counts users. Banned includes users currently suspended.
+type UserStats struct {
+	Total    int32            `json:"total"`
+	Banned   int32            `json:"banned"`
+	ByRole   map[string]int32 `json:"byRole"`
+	ByStatus map[string]int32 `json:"byStatus"`
+}
+
+type ContentStats struct {
+	Posts          int64 `json:"posts"`
+	PublishedPosts int64 `json:"publishedPosts"`
+	Comments       int64 `json:"comments"`
+	Likes          int64 `json:"likes"`
+	Media          int64 `json:"media"`
+}
+
+type SubscriptionStats struct {
+	ByStatus     map[string]int32 `json:"byStatus"`
+	ActiveByPlan map[string]int32 `json:"activeByPlan"`
+}
+
+type PaymentStats struct {
+	ByStatus          map[string]int32   `json:"byStatus"`
+	RevenueByCurrency map[string]float64 `json:"revenueByCurrency"`
+}
+
+func NewUserStats(s *userpb.UserStatsResponse) *UserStats {
+	return &UserStats{
+		Total:    s.GetTotal(),
+		Banned:   s.GetBanned(),
+		ByRole:   counts(s.GetByRole()),
+		ByStatus: counts(s.GetByStatus()),
+	}
+}
+
+func NewContentStats(s *contentpb.ContentStatsResponse) *ContentStats {
+	return &ContentStats{
+		Posts:          s.GetPosts(),
+		PublishedPosts: s.GetPublishedPosts(),
+		Comments:       s.GetComments(),
+		Likes:          s.GetLikes(),
+		Media:          s.GetMedia(),
+	}
+}
+
+func NewSubscriptionStats(s *subpb.SubscriptionStatsResponse) *SubscriptionStats {
+	return &SubscriptionStats{ByStatus: counts(s.GetByStatus()), ActiveByPlan: counts(s.GetActiveByPlan())}
+}
+
+func NewPaymentStats(s *paypb.PaymentStatsResponse) *PaymentStats {
+	revenue := s.GetRevenueByCurrency()
+	if revenue == nil {
+		revenue = map[string]float64{}
+	}
+	return &PaymentStats{ByStatus: counts(s.GetByStatus()), RevenueByCurrency: revenue}
+}
+
+// counts returns m, or an empty map so the field encodes as {} rather than null.
+func counts(m map[string]int32) map[string]int32 {
+	if m == nil {
+		return map[string]int32{}
+	}
+	return m
+}
@@ -0,0 +1,18 @@
+package dto
+
+type RegisterExpertRequest struct {
+	UserID          string  `json:"userId,omitempty"` // honoured for admins only
+	Specialization  string  `json:"specialization"`
+	PricePerSession float64 `json:"pricePerSession"`
+}
+
+type BookConsultationRequest struct {
+	UserID      string `json:"userId,omitempty"` // honoured for admins only
+	ExpertID    string `json:"expertId"`
+	ExpertName  string `json:"expertName"`
+	ScheduledAt string `json:"scheduledAt"` // RFC 3339
+}
+
+type CancelBookingRequest struct {
+	BookingID string `json:"bookingId"`
+}
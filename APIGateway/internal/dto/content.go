@@ -0,0 +1,121 @@
+package dto
+
+import contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+
+type Post struct {
+	ID             string   `json:"id"`
+	Content        string   `json:"content"`
+	AuthorID       string   `json:"authorId"`
+	AuthorUsername string   `json:"authorUsername"`
+	LikesCount     int32    `json:"likesCount"`
+	CommentsCount  int32    `json:"commentsCount"`
+	CreatedAt      string   `json:"createdAt"`
+	UpdatedAt      string   `json:"updatedAt"`
+	Liked          bool     `json:"liked"`
+	Images         []string `json:"images"`
+	Files          []string `json:"files"`
+	Poll           *Poll    `json:"poll,omitempty"`
+	Media          []Media  `json:"media"`
+}
+
+type Comment struct {
+	ID             string `json:"id"`
+	PostID         string `json:"postId"`
+	AuthorID       string `json:"authorId"`
+	AuthorUsername string `json:"authorUsername"`
+	Content        string `json:"content"`
+	CreatedAt      string `json:"createdAt"`
+	UpdatedAt      string `json:"updatedAt"`
+	Liked          bool   `json:"liked"`
+	LikesCount     int32  `json:"likesCount"`
+}
+
+type Poll struct {
+	ID                string       `json:"id"`
+	Question          string       `json:"question"`
+	Options           []PollOption `json:"options"`
+	Expired           bool         `json:"expired"`
+	TotalVotes        int32        `json:"totalVotes"`
+	ExpiresAt         string       `json:"expiresAt"`
+	UserVotedOptionID string       `json:"userVotedOptionId,omitempty"`
+}
+
+type PollOption struct {
+	ID         string `json:"id"`
+	Text       string `json:"text"`
+	VotesCount int32  `json:"votesCount"`
+}
+
+// LikesCount is returned by the like and unlike endpoints.
+type LikesCount struct {
+	LikesCount int32 `json:"likesCount"`
+}
+
+// NewPost links media through mediaPath, the gateway's /media route, whose
+// stable URLs redirect to fresh signed ones. Signed URLs expire, so they must
+// not end up in cached post responses.
+func NewPost(p *contentpb.Post, authorUsername, mediaPath string) Post {
+	media := make([]Media, 0, len(p.GetMedia()))
+	for _, m := range p.GetMedia() {
+		base := mediaPath + "/" + m.GetId()
+		thumbnail := ""
+		if m.GetHasThumbnail() {
+			thumbnail = base + "/thumbnail"
+		}
+		media = append(media, NewMedia(m, base+"/content", thumbnail, ""))
+	}
+
+	return Post{
+		ID:             p.GetId(),
+		Content:        p.GetContent(),
+		AuthorID:       p.GetAuthorId(),
+		AuthorUsername: authorUsername,
+		LikesCount:     p.GetLikesCount(),
+		CommentsCount:  p.GetCommentsCount(),
+		CreatedAt:      p.GetCreatedAt(),
+		UpdatedAt:      p.GetUpdatedAt(),
+		Liked:          p.GetLiked(),
+		Images:         nonNil(p.GetImages()),
+		Files:          nonNil(p.GetFiles()),
+		Poll:           NewPoll(p.GetPoll()),
+		Media:          media,
+	}
+}
+
+func NewComment(c *contentpb.Comment, authorUsername string) Comment {
+	return Comment{
+		ID:             c.GetId(),
+		PostID:         c.GetPostId(),
+		AuthorID:       c.GetAuthorId(),
+		AuthorUsername: authorUsername,
+		Content:        c.GetContent(),
+		CreatedAt:      timestamp(c.GetCreatedAt()),
+		UpdatedAt:      timestamp(c.GetUpdatedAt()),
+		Liked:          c.GetLiked(),
+		LikesCount:     c.GetLikesCount(),
+	}
+}
+
+// NewPoll returns nil for posts without a poll.
+func NewPoll(p *contentpb.Poll) *Poll {
+	if p == nil {
+		return nil
+	}
+	options := make([]PollOption, 0, len(p.GetOptions()))
+	for _, o := range p.GetOptions() {
+		options = append(options, PollOption{ID: o.GetId(), Text: o.GetText(), VotesCount: o.GetVotesCount()})
+	}
+	return &Poll{
+		ID:                p.GetId(),
+		Question:          p.GetQuestion(),
+		Options:           options,
+		Expired:           p.GetExpired(),
+		TotalVotes:        p.GetTotalVotes(),
+		ExpiresAt:         p.GetExpiresAt(),
+		UserVotedOptionID: p.GetUserVotedOptionId(),
+	}
+}
+
+type VotePollRequest struct {
+	OptionID string `json:"optionId"`
+}
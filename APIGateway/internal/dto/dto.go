@@ -0,0 +1,43 @@
+// Package dto defines the JSON bodies the gateway returns. Handlers map gRPC
+// responses into these types instead of building maps by hand, and the OpenAPI
+// document is generated from them, so the two can't drift apart silently.
+package dto
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Message acknowledges an operation that has nothing else to return.
+type Message struct {
+	Message string `json:"message"`
+}
+
+// Status reports the new state of a resource after an operation.
+type Status struct {
+	Status string `json:"status"`
+}
+
+// Health is the body of /healthz and /readyz. Services maps each downstream
+// service to its state.
+type Health struct {
+	Status   string            `json:"status"`
+	Services map[string]string `json:"services"`
+}
+
+// timestamp formats ts as RFC 3339, or "" when it is unset.
+func timestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339)
+}
+
+// nonNil returns s, or an empty slice so the field encodes as [] rather than null.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
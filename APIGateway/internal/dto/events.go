@@ -0,0 +1,7 @@
+package dto
+
+// EventTicket opens one GET /events stream for the caller who requested it.
+type EventTicket struct {
+	Ticket    string `json:"ticket"`
+	ExpiresAt string `json:"expiresAt"`
+}
@@ -0,0 +1,41 @@
+package dto
+
+import contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+
+// Media kinds as they appear on the wire.
+const (
+	MediaKindImage = "image"
+	MediaKindFile  = "file"
+)
+
+type Media struct {
+	ID          string `json:"id"`
+	Kind        string `json:"kind"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+	Filename    string `json:"filename"`
+	URL         string `json:"url"`
+	// ThumbnailURL is set for images.
+	ThumbnailURL string `json:"thumbnailUrl,omitempty"`
+	// ExpiresAt is when signed URLs stop working; unset for stable links.
+	ExpiresAt string `json:"expiresAt,omitempty"`
+	CreatedAt string `json:"createdAt"`
+}
+
+func NewMedia(m *contentpb.Media, url, thumbnailURL, expiresAt string) Media {
+	kind := MediaKindFile
+	if m.GetKind() == contentpb.MediaKind_MEDIA_IMAGE {
+		kind = MediaKindImage
+	}
+	return Media{
+		ID:           m.GetId(),
+		Kind:         kind,
+		ContentType:  m.GetContentType(),
+		Size:         m.GetSize(),
+		Filename:     m.GetFilename(),
+		URL:          url,
+		ThumbnailURL: thumbnailURL,
+		ExpiresAt:    expiresAt,
+		CreatedAt:    m.GetCreatedAt(),
+	}
+}
@@ -0,0 +1,9 @@
+package dto
+
+type ProcessPaymentRequest struct {
+	UserID        string  `json:"userId,omitempty"` // honoured for admins only
+	Amount        float64 `json:"amount"`
+	Currency      string  `json:"currency"`
+	ReferenceType string  `json:"referenceType"` // SUBSCRIPTION, CONSULTATION
+	ReferenceID   string  `json:"referenceId"`
+}
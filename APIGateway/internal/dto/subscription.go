@@ -0,0 +1,11 @@
+package dto
+
+type SubscribeRequest struct {
+	UserID         string `json:"userId,omitempty"` // honoured for admins only
+	PlanType       string `json:"planType"`
+	DurationMonths int    `json:"durationMonths"`
+}
+
+type CancelSubscriptionRequest struct {
+	ID string `json:"id"`
+}
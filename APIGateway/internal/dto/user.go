@@ -0,0 +1,103 @@
+package dto
+
+import userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
+
+// User is a user's profile. Status is active, unverified, suspended or
+// banned; Banned is true for the last two, which also carry the ban reason
+// and, for a suspension, when it ends.
+type User struct {
+	ID            string `json:"id"`
+	Username      string `json:"username"`
+	Email         string `json:"email"`
+	Role          string `json:"role"`
+	Bio           string `json:"bio"`
+	Status        string `json:"status"`
+	EmailVerified bool   `json:"emailVerified"`
+	Banned        bool   `json:"banned"`
+	BanReason     string `json:"banReason,omitempty"`
+	BannedUntil   string `json:"bannedUntil,omitempty"`
+	CreatedAt     string `json:"createdAt"`
+	UpdatedAt     string `json:"updatedAt"`
+}
+
+// AuthResponse is returned by register, login and refresh. Token is an access
+// token valid for ExpiresIn seconds; RefreshToken obtains the next pair and
+// works once. User is omitted on refresh and when the profile couldn't be
+// loaded; the tokens are valid regardless.
+type AuthResponse struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refreshToken"`
+	ExpiresIn    int32  `json:"expiresIn"`
+	UserID       string `json:"userId"`
+	User         *User  `json:"user,omitempty"`
+}
+
+// Session is one device signed in to the caller's account.
+type Session struct {
+	ID         string `json:"id"`
+	Device     string `json:"device"`
+	IP         string `json:"ip"`
+	CreatedAt  string `json:"createdAt"`
+	LastSeenAt string `json:"lastSeenAt"`
+	ExpiresAt  string `json:"expiresAt"`
+	Current    bool   `json:"current"`
+}
+
+// SessionList is the caller's active sessions, most recently used first.
+type SessionList struct {
+	Sessions []Session `json:"sessions"`
+}
+
+// Logout reports how many sessions a logout, password reset or password
+// change ended.
+type Logout struct {
+	Message         string `json:"message"`
+	SessionsRevoked int32  `json:"sessionsRevoked"`
+}
+
+func NewAuthResponse(login *userpb.LoginResponse, u *userpb.UserResponse) AuthResponse {
+	out := AuthResponse{
+		Token:        login.GetToken(),
+		RefreshToken: login.GetRefreshToken(),
+		ExpiresIn:    login.GetExpiresIn(),
+		UserID:       login.GetUserId(),
+	}
+	if u != nil {
+		user := NewUser(u)
+		out.User = &user
+	}
+	return out
+}
+
+func NewSessionList(resp *userpb.SessionsResponse) SessionList {
+	out := SessionList{Sessions: make([]Session, 0, len(resp.GetSessions()))}
+	for _, s := range resp.GetSessions() {
+		out.Sessions = append(out.Sessions, Session{
+			ID:         s.GetId(),
+			Device:     s.GetDevice(),
+			IP:         s.GetIp(),
+			CreatedAt:  s.GetCreatedAt(),
+			LastSeenAt: s.GetLastSeenAt(),
+			ExpiresAt:  s.GetExpiresAt(),
+			Current:    s.GetCurrent(),
+		})
+	}
+	return out
+}
+
+func NewUser(u *userpb.UserResponse) User {
+	return User{
+		ID:            u.GetUserId(),
+		Username:      u.GetUsername(),
+		Email:         u.GetEmail(),
+		Role:          u.GetRole(),
+		Bio:           u.GetBio(),
+		Status:        u.GetStatus(),
+		EmailVerified: u.GetEmailVerifiedAt() != "",
+		Banned:        u.GetBanned(),
+		BanReason:     u.GetBanReason(),
+		BannedUntil:   u.GetBannedUntil(),
+		CreatedAt:     u.GetCreatedAt(),
+		UpdatedAt:     u.GetUpdatedAt(),
+	}
+}
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"reflect"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/validate"
+)
+
+var id = []validate.Check{validate.Required, validate.MaxLen(64)}
+
+// requestRules declares the checks for the gateway's own request bodies, by
+// JSON name, so violations name the field the client sent. They mirror the
+// service rules in validate.Messages; bodies bound straight into protobuf
+// requests are checked against those when the gateway calls the service.
+var requestRules = map[reflect.Type][]validate.Field{
+	reflect.TypeOf(VotePollRequest{}): {
+		validate.F("optionId", id...),
+	},
+	reflect.TypeOf(SubscribeRequest{}): {
+		validate.F("userId", validate.MaxLen(64)),
+		validate.F("planType", validate.Required, validate.OneOf(validate.PlanTypes...)),
+		validate.F("durationMonths", validate.Required, validate.Range(1, 36)),
+	},
+	reflect.TypeOf(CancelSubscriptionRequest{}): {
+		validate.F("id", id...),
+	},
+	reflect.TypeOf(ProcessPaymentRequest{}): {
+		validate.F("userId", validate.MaxLen(64)),
+		validate.F("amount", validate.Required, validate.Range(0.01, 1e9)),
+		validate.F("currency", validate.Required, validate.OneOf(validate.Currencies...)),
+		validate.F("referenceType", validate.Required, validate.OneOf(validate.ReferenceTypes...)),
+		validate.F("referenceId", id...),
+	},
+	reflect.TypeOf(RegisterExpertRequest{}): {
+		validate.F("userId", validate.MaxLen(64)),
+		validate.F("specialization", validate.Required, validate.MaxLen(100)),
+		validate.F("pricePerSession", validate.Required, validate.Range(1, 1e7)),
+	},
+	reflect.TypeOf(BookConsultationRequest{}): {
+		validate.F("userId", validate.MaxLen(64)),
+		validate.F("expertId", id...),
+		validate.F("expertName", validate.MaxLen(100)),
+		validate.F("scheduledAt", validate.Required, validate.Future),
+	},
+	reflect.TypeOf(RoleChangeRequest{}): {
+		validate.F("role", validate.Required, validate.OneOf("user", "moderator", "expert", "admin")),
+	},
+	reflect.TypeOf(BanRequest{}): {
+		validate.F("reason", validate.OneOf(validate.BanReasons...)),
+		validate.F("details", validate.MaxLen(500)),
+		validate.F("expiresAt", validate.Future),
+	},
+	reflect.TypeOf(UnbanRequest{}): {
+		validate.F("reason", validate.OneOf(validate.UnbanReasons...)),
+		validate.F("details", validate.MaxLen(500)),
+	},
+	reflect.TypeOf(CancelBookingRequest{}): {
+		validate.F("bookingId", id...),
+	},
+}
+
+// Validate checks req, a pointer to a request body, against its rules. Types
+// without rules, including protobuf requests, pass.
+func Validate(req interface{}) error {
+	t := reflect.TypeOf(req)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	rules, ok := requestRules[t]
+	if !ok {
+		return nil
+	}
+	return validate.Struct(req, rules)
+}
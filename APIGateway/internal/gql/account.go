@@ -0,0 +1,179 @@
+package gql
+
+import (
+	"context"
+
+	notificationpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/notification"
+	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
+	graphql "github.com/graph-gophers/graphql-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	conpb "github.com/KaminurOrynbek/BiznesAsh/ConsultationService/proto"
+	paypb "github.com/KaminurOrynbek/BiznesAsh/PaymentService/proto"
+	subpb "github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/proto"
+)
+
+const maxNotificationsLimit = 100
+
+type userResolver struct {
+	root *resolver
+	u    *userpb.UserResponse
+}
+
+func (r *userResolver) ID() graphql.ID    { return graphql.ID(r.u.GetUserId()) }
+func (r *userResolver) Username() string  { return r.u.GetUsername() }
+func (r *userResolver) Email() string     { return r.u.GetEmail() }
+func (r *userResolver) Role() string      { return r.u.GetRole() }
+func (r *userResolver) Bio() string       { return r.u.GetBio() }
+func (r *userResolver) CreatedAt() string { return r.u.GetCreatedAt() }
+func (r *userResolver) UpdatedAt() string { return r.u.GetUpdatedAt() }
+
+func (r *userResolver) Notifications(ctx context.Context, args struct {
+	Page  int32
+	Limit int32
+}) ([]*notificationResolver, error) {
+	if err := authorizeUser(ctx, r.u.GetUserId()); err != nil {
+		return nil, err
+	}
+	page, limit := args.Page, args.Limit
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > maxNotificationsLimit {
+		limit = maxNotificationsLimit
+	}
+
+	resp, err := r.root.clients.Notification.GetNotifications(ctx, &notificationpb.GetNotificationsRequest{
+		UserId: r.u.GetUserId(),
+		Page:   page,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, fromGRPC(err)
+	}
+	out := make([]*notificationResolver, 0, len(resp.GetNotifications()))
+	for _, n := range resp.GetNotifications() {
+		out = append(out, &notificationResolver{n: n})
+	}
+	return out, nil
+}
+
+// Subscription is null when the user has never subscribed.
+func (r *userResolver) Subscription(ctx context.Context) (*subscriptionResolver, error) {
+	if err := authorizeUser(ctx, r.u.GetUserId()); err != nil {
+		return nil, err
+	}
+	resp, err := r.root.clients.Subscription.GetSubscription(ctx, &subpb.GetSubscriptionRequest{UserId: r.u.GetUserId()})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fromGRPC(err)
+	}
+	return &subscriptionResolver{s: resp}, nil
+}
+
+func (r *userResolver) SubscriptionHistory(ctx context.Context) ([]*subscriptionResolver, error) {
+	if err := authorizeUser(ctx, r.u.GetUserId()); err != nil {
+		return nil, err
+	}
+	resp, err := r.root.clients.Subscription.GetSubscriptionHistory(ctx, &subpb.GetSubscriptionRequest{UserId: r.u.GetUserId()})
+	if err != nil {
+		return nil, fromGRPC(err)
+	}
+	out := make([]*subscriptionResolver, 0, len(resp.GetSubscriptions()))
+	for _, s := range resp.GetSubscriptions() {
+		out = append(out, &subscriptionResolver{s: s})
+	}
+	return out, nil
+}
+
+func (r *userResolver) Bookings(ctx context.Context) ([]*bookingResolver, error) {
+	if err := authorizeUser(ctx, r.u.GetUserId()); err != nil {
+		return nil, err
+	}
+	resp, err := r.root.clients.Consultation.GetUserBookings(ctx, &conpb.GetUserBookingsRequest{UserId: r.u.GetUserId()})
+	if err != nil {
+		return nil, fromGRPC(err)
+	}
+	out := make([]*bookingResolver, 0, len(resp.GetBookings()))
+	for _, b := range resp.GetBookings() {
+		out = append(out, &bookingResolver{b: b})
+	}
+	return out, nil
+}
+
+func (r *userResolver) Transactions(ctx context.Context) ([]*transactionResolver, error) {
+	if err := authorizeUser(ctx, r.u.GetUserId()); err != nil {
+		return nil, err
+	}
+	resp, err := r.root.clients.Payment.GetTransactionHistory(ctx, &paypb.GetHistoryRequest{UserId: r.u.GetUserId()})
+	if err != nil {
+		return nil, fromGRPC(err)
+	}
+	out := make([]*transactionResolver, 0, len(resp.GetTransactions()))
+	for _, t := range resp.GetTransactions() {
+		out = append(out, &transactionResolver{t: t})
+	}
+	return out, nil
+}
+
+type notificationResolver struct {
+	n *notificationpb.Notification
+}
+
+func (r *notificationResolver) ID() graphql.ID         { return graphql.ID(r.n.GetId()) }
+func (r *notificationResolver) Type() string           { return r.n.GetType() }
+func (r *notificationResolver) Message() string        { return r.n.GetMessage() }
+func (r *notificationResolver) IsRead() bool           { return r.n.GetIsRead() }
+func (r *notificationResolver) PostID() *graphql.ID    { return optionalID(r.n.GetPostId()) }
+func (r *notificationResolver) CommentID() *graphql.ID { return optionalID(r.n.GetCommentId()) }
+func (r *notificationResolver) CreatedAt() string      { return r.n.GetCreatedAt() }
+
+type subscriptionResolver struct {
+	s *subpb.SubscriptionResponse
+}
+
+func (r *subscriptionResolver) ID() graphql.ID   { return graphql.ID(r.s.GetId()) }
+func (r *subscriptionResolver) PlanType() string { return r.s.GetPlanType() }
+func (r *subscriptionResolver) Status() string   { return r.s.GetStatus() }
+func (r *subscriptionResolver) StartsAt() string { return r.s.GetStartsAt() }
+func (r *subscriptionResolver) EndsAt() string   { return r.s.GetEndsAt() }
+
+type transactionResolver struct {
+	t *paypb.PaymentResponse
+}
+
+func (r *transactionResolver) ID() graphql.ID    { return graphql.ID(r.t.GetId()) }
+func (r *transactionResolver) Status() string    { return r.t.GetStatus() }
+func (r *transactionResolver) CreatedAt() string { return r.t.GetCreatedAt() }
+
+type expertResolver struct {
+	root *resolver
+	e    *conpb.ExpertProfile
+}
+
+func (r *expertResolver) ID() graphql.ID           { return graphql.ID(r.e.GetId()) }
+func (r *expertResolver) Specialization() string   { return r.e.GetSpecialization() }
+func (r *expertResolver) PricePerSession() float64 { return r.e.GetPricePerSession() }
+func (r *expertResolver) IsAvailable() bool        { return r.e.GetIsAvailable() }
+
+func (r *expertResolver) User(ctx context.Context) (*userResolver, error) {
+	return r.root.optionalUser(ctx, r.e.GetUserId())
+}
+
+type bookingResolver struct {
+	b *conpb.BookingDetail
+}
+
+func (r *bookingResolver) ID() graphql.ID       { return graphql.ID(r.b.GetId()) }
+func (r *bookingResolver) ExpertID() graphql.ID { return graphql.ID(r.b.GetExpertId()) }
+func (r *bookingResolver) ExpertName() string   { return r.b.GetExpertName() }
+func (r *bookingResolver) Status() string       { return r.b.GetStatus() }
+func (r *bookingResolver) ScheduledAt() string  { return r.b.GetScheduledAt() }
+func (r *bookingResolver) MeetingLink() string  { return r.b.GetMeetingLink() }
+func (r *bookingResolver) CreatedAt() string    { return r.b.GetCreatedAt() }
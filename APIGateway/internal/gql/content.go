@@ -0,0 +1,104 @@
+package gql
+
+import (
+	"context"
+	"time"
+
+	contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	graphql "github.com/graph-gophers/graphql-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type postResolver struct {
+	root *resolver
+	p    *contentpb.Post
+}
+
+func (r *postResolver) ID() graphql.ID       { return graphql.ID(r.p.GetId()) }
+func (r *postResolver) Title() string        { return r.p.GetTitle() }
+func (r *postResolver) Content() string      { return r.p.GetContent() }
+func (r *postResolver) Type() string         { return r.p.GetType().String() }
+func (r *postResolver) Published() bool      { return r.p.GetPublished() }
+func (r *postResolver) LikesCount() int32    { return r.p.GetLikesCount() }
+func (r *postResolver) DislikesCount() int32 { return r.p.GetDislikesCount() }
+func (r *postResolver) CommentsCount() int32 { return r.p.GetCommentsCount() }
+func (r *postResolver) Liked() bool          { return r.p.GetLiked() }
+func (r *postResolver) Images() []string     { return nonNil(r.p.GetImages()) }
+func (r *postResolver) Files() []string      { return nonNil(r.p.GetFiles()) }
+func (r *postResolver) CreatedAt() string    { return r.p.GetCreatedAt() }
+func (r *postResolver) UpdatedAt() string    { return r.p.GetUpdatedAt() }
+
+func (r *postResolver) Author(ctx context.Context) (*userResolver, error) {
+	return r.root.optionalUser(ctx, r.p.GetAuthorId())
+}
+
+func (r *postResolver) Poll() *pollResolver {
+	if r.p.GetPoll() == nil {
+		return nil
+	}
+	return &pollResolver{p: r.p.GetPoll()}
+}
+
+func (r *postResolver) Comments(ctx context.Context) ([]*commentResolver, error) {
+	comments, err := loadersFrom(ctx).comments.Load(ctx, r.p.GetId())()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*commentResolver, 0, len(comments))
+	for _, c := range comments {
+		out = append(out, &commentResolver{root: r.root, c: c})
+	}
+	return out, nil
+}
+
+type commentResolver struct {
+	root *resolver
+	c    *contentpb.Comment
+}
+
+func (r *commentResolver) ID() graphql.ID     { return graphql.ID(r.c.GetId()) }
+func (r *commentResolver) PostID() graphql.ID { return graphql.ID(r.c.GetPostId()) }
+func (r *commentResolver) Content() string    { return r.c.GetContent() }
+func (r *commentResolver) LikesCount() int32  { return r.c.GetLikesCount() }
+func (r *commentResolver) Liked() bool        { return r.c.GetLiked() }
+func (r *commentResolver) CreatedAt() string  { return timestamp(r.c.GetCreatedAt()) }
+func (r *commentResolver) UpdatedAt() string  { return timestamp(r.c.GetUpdatedAt()) }
+
+func (r *commentResolver) Author(ctx context.Context) (*userResolver, error) {
+	return r.root.optionalUser(ctx, r.c.GetAuthorId())
+}
+
+type pollResolver struct {
+	p *contentpb.Poll
+}
+
+func (r *pollResolver) ID() graphql.ID                 { return graphql.ID(r.p.GetId()) }
+func (r *pollResolver) Question() string               { return r.p.GetQuestion() }
+func (r *pollResolver) TotalVotes() int32              { return r.p.GetTotalVotes() }
+func (r *pollResolver) Expired() bool                  { return r.p.GetExpired() }
+func (r *pollResolver) ExpiresAt() string              { return r.p.GetExpiresAt() }
+func (r *pollResolver) UserVotedOptionID() *graphql.ID { return optionalID(r.p.GetUserVotedOptionId()) }
+
+func (r *pollResolver) Options() []*pollOptionResolver {
+	out := make([]*pollOptionResolver, 0, len(r.p.GetOptions()))
+	for _, o := range r.p.GetOptions() {
+		out = append(out, &pollOptionResolver{o: o})
+	}
+	return out
+}
+
+type pollOptionResolver struct {
+	o *contentpb.PollOption
+}
+
+func (r *pollOptionResolver) ID() graphql.ID    { return graphql.ID(r.o.GetId()) }
+func (r *pollOptionResolver) Text() string      { return r.o.GetText() }
+func (r *pollOptionResolver) VotesCount() int32 { return r.o.GetVotesCount() }
+
+// timestamp formats ts as RFC 3339, or "" when unset.
+func timestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,131 @@
+// Package gql serves a GraphQL schema over the backend gRPC services, so a
+// client can fetch a post with its author, comments and poll in one request.
+// Lookups that fan out per item (authors, comments) go through per-request
+// dataloaders that batch and deduplicate them.
+package gql
+
+import (
+	"context"
+	_ "embed"
+	"log/slog"
+	"net/http"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	notificationpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/notification"
+	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/validate"
+	graphql "github.com/graph-gophers/graphql-go"
+
+	conpb "github.com/KaminurOrynbek/BiznesAsh/ConsultationService/proto"
+	paypb "github.com/KaminurOrynbek/BiznesAsh/PaymentService/proto"
+	subpb "github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/proto"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+const (
+	maxDepth       = 8
+	maxParallelism = 16
+)
+
+// Clients are the backend services the resolvers call.
+type Clients struct {
+	User         userpb.UserServiceClient
+	Content      contentpb.ContentServiceClient
+	Notification notificationpb.NotificationServiceClient
+	Subscription subpb.SubscriptionServiceClient
+	Payment      paypb.PaymentServiceClient
+	Consultation conpb.ConsultationServiceClient
+}
+
+// Identity is the authenticated caller; the zero value is anonymous.
+type Identity struct {
+	UserID string
+	Admin  bool
+}
+
+// Request is a GraphQL-over-HTTP request body.
+type Request struct {
+	Query         string                 `json:"query" binding:"required"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type Server struct {
+	schema  *graphql.Schema
+	clients Clients
+}
+
+// New parses the schema and binds it to the resolvers. It panics if they
+// disagree, which is a programming error caught at startup.
+func New(clients Clients) *Server {
+	schema := graphql.MustParseSchema(schemaSDL, &resolver{clients: clients},
+		graphql.MaxDepth(maxDepth),
+		graphql.MaxParallelism(maxParallelism),
+	)
+	return &Server{schema: schema, clients: clients}
+}
+
+// Exec runs req for id. ctx must carry the outgoing gRPC metadata for the
+// caller; resolvers pass it on to every backend call.
+func (s *Server) Exec(ctx context.Context, id Identity, req Request) *graphql.Response {
+	ctx = context.WithValue(ctx, identityKey{}, id)
+	ctx = context.WithValue(ctx, loadersKey{}, newLoaders(s.clients, id))
+	return s.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+}
+
+type identityKey struct{}
+
+func identityFrom(ctx context.Context) Identity {
+	id, _ := ctx.Value(identityKey{}).(Identity)
+	return id
+}
+
+// Error is a resolver error carrying the same stable code as the REST problem
+// body, exposed as extensions.code, and its field violations as
+// extensions.fields.
+type Error struct {
+	Code    string
+	Message string
+	Fields  []validate.Violation
+}
+
+func (e *Error) Error() string { return e.Message }
+
+func (e *Error) Extensions() map[string]interface{} {
+	ext := map[string]interface{}{"code": e.Code}
+	if len(e.Fields) > 0 {
+		ext["fields"] = e.Fields
+	}
+	return ext
+}
+
+var (
+	errUnauthenticated = &Error{Code: apierror.CodeUnauthenticated, Message: "authorization required"}
+	errForbidden       = &Error{Code: apierror.CodePermissionDenied, Message: "you can only access your own resources"}
+)
+
+// fromGRPC converts a backend error the way the REST handlers do.
+func fromGRPC(err error) error {
+	status, code, message := apierror.FromGRPC(err)
+	if status >= http.StatusInternalServerError {
+		slog.Error("graphql resolver failed", logging.Err(err))
+	}
+	return &Error{Code: code, Message: message, Fields: validate.Violations(err)}
+}
+
+// authorizeUser allows the user themselves and admins to see userID's private data.
+func authorizeUser(ctx context.Context, userID string) error {
+	id := identityFrom(ctx)
+	switch {
+	case id.UserID == "":
+		return errUnauthenticated
+	case id.UserID == userID || id.Admin:
+		return nil
+	default:
+		return errForbidden
+	}
+}
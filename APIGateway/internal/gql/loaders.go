@@ -0,0 +1,94 @@
+package gql
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+// batchWait is how long a loader collects keys before calling the backend. It
+// only has to cover resolvers of one level starting in parallel.
+const batchWait = 2 * time.Millisecond
+
+// loaders live for one request, so their caches never serve another caller's
+// view (like state, visibility) or stale data.
+type loaders struct {
+	users    *dataloader.Loader[string, *userpb.UserResponse]
+	comments *dataloader.Loader[string, []*contentpb.Comment]
+}
+
+type loadersKey struct{}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+func newLoaders(clients Clients, id Identity) *loaders {
+	return &loaders{
+		users: dataloader.NewBatchedLoader(batchUsers(clients.User),
+			dataloader.WithWait[string, *userpb.UserResponse](batchWait)),
+		comments: dataloader.NewBatchedLoader(batchComments(clients.Content, id.UserID),
+			dataloader.WithWait[string, []*contentpb.Comment](batchWait)),
+	}
+}
+
+// batchUsers resolves every requested user with a single GetUsersByIDs call.
+func batchUsers(client userpb.UserServiceClient) dataloader.BatchFunc[string, *userpb.UserResponse] {
+	return func(ctx context.Context, ids []string) []*dataloader.Result[*userpb.UserResponse] {
+		results := make([]*dataloader.Result[*userpb.UserResponse], len(ids))
+
+		resp, err := client.GetUsersByIDs(ctx, &userpb.GetUsersByIDsRequest{UserIds: ids})
+		if err != nil {
+			err = fromGRPC(err)
+			for i := range results {
+				results[i] = &dataloader.Result[*userpb.UserResponse]{Error: err}
+			}
+			return results
+		}
+
+		byID := make(map[string]*userpb.UserResponse, len(resp.GetUsers()))
+		for _, u := range resp.GetUsers() {
+			byID[u.GetUserId()] = u
+		}
+		for i, id := range ids {
+			if u, ok := byID[id]; ok {
+				results[i] = &dataloader.Result[*userpb.UserResponse]{Data: u}
+			} else {
+				results[i] = &dataloader.Result[*userpb.UserResponse]{
+					Error: &Error{Code: apierror.CodeNotFound, Message: "user not found"},
+				}
+			}
+		}
+		return results
+	}
+}
+
+// batchComments lists the comments of every requested post. ContentService has
+// no multi-post call, so the batch fans out in parallel; the loader still
+// deduplicates posts requested more than once.
+func batchComments(client contentpb.ContentServiceClient, callerID string) dataloader.BatchFunc[string, []*contentpb.Comment] {
+	return func(ctx context.Context, postIDs []string) []*dataloader.Result[[]*contentpb.Comment] {
+		results := make([]*dataloader.Result[[]*contentpb.Comment], len(postIDs))
+
+		var wg sync.WaitGroup
+		for i, postID := range postIDs {
+			wg.Add(1)
+			go func(i int, postID string) {
+				defer wg.Done()
+				resp, err := client.ListComments(ctx, &contentpb.ListCommentsRequest{PostId: postID, UserId: callerID})
+				if err != nil {
+					results[i] = &dataloader.Result[[]*contentpb.Comment]{Error: fromGRPC(err)}
+					return
+				}
+				results[i] = &dataloader.Result[[]*contentpb.Comment]{Data: resp.GetComments()}
+			}(i, postID)
+		}
+		wg.Wait()
+		return results
+	}
+}
@@ -0,0 +1,120 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	contentpb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	graphql "github.com/graph-gophers/graphql-go"
+
+	conpb "github.com/KaminurOrynbek/BiznesAsh/ConsultationService/proto"
+)
+
+const maxPostsLimit = 100
+
+// resolver is the root Query type.
+type resolver struct {
+	clients Clients
+}
+
+func (r *resolver) Me(ctx context.Context) (*userResolver, error) {
+	id := identityFrom(ctx)
+	if id.UserID == "" {
+		return nil, errUnauthenticated
+	}
+	return r.user(ctx, id.UserID)
+}
+
+func (r *resolver) User(ctx context.Context, args struct{ ID graphql.ID }) (*userResolver, error) {
+	return r.user(ctx, string(args.ID))
+}
+
+func (r *resolver) user(ctx context.Context, id string) (*userResolver, error) {
+	u, err := loadersFrom(ctx).users.Load(ctx, id)()
+	if err != nil {
+		return nil, err
+	}
+	return &userResolver{root: r, u: u}, nil
+}
+
+func (r *resolver) Post(ctx context.Context, args struct{ ID graphql.ID }) (*postResolver, error) {
+	resp, err := r.clients.Content.GetPost(ctx, &contentpb.PostIdRequest{
+		Id:     string(args.ID),
+		UserId: identityFrom(ctx).UserID,
+	})
+	if err != nil {
+		return nil, fromGRPC(err)
+	}
+	return &postResolver{root: r, p: resp.GetPost()}, nil
+}
+
+// Posts pages like GET /content/posts: skip is rounded down to a whole page.
+func (r *resolver) Posts(ctx context.Context, args struct {
+	Skip  int32
+	Limit int32
+}) ([]*postResolver, error) {
+	skip, limit := args.Skip, args.Limit
+	if skip < 0 {
+		skip = 0
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > maxPostsLimit {
+		limit = maxPostsLimit
+	}
+
+	resp, err := r.clients.Content.ListPosts(ctx, &contentpb.ListPostsRequest{
+		Page:   skip/limit + 1,
+		Limit:  limit,
+		UserId: identityFrom(ctx).UserID,
+	})
+	if err != nil {
+		return nil, fromGRPC(err)
+	}
+	out := make([]*postResolver, 0, len(resp.GetPosts()))
+	for _, p := range resp.GetPosts() {
+		out = append(out, &postResolver{root: r, p: p})
+	}
+	return out, nil
+}
+
+func (r *resolver) Experts(ctx context.Context) ([]*expertResolver, error) {
+	resp, err := r.clients.Consultation.ListAvailableExperts(ctx, &conpb.Filter{})
+	if err != nil {
+		return nil, fromGRPC(err)
+	}
+	out := make([]*expertResolver, 0, len(resp.GetExperts()))
+	for _, e := range resp.GetExperts() {
+		out = append(out, &expertResolver{root: r, e: e})
+	}
+	return out, nil
+}
+
+// optionalUser resolves a reference to a user that may since have been deleted;
+// a missing user is null rather than an error.
+func (r *resolver) optionalUser(ctx context.Context, id string) (*userResolver, error) {
+	if id == "" {
+		return nil, nil
+	}
+	u, err := r.user(ctx, id)
+	if e, ok := err.(*Error); ok && e.Code == apierror.CodeNotFound {
+		return nil, nil
+	}
+	return u, err
+}
+
+func optionalID(s string) *graphql.ID {
+	if s == "" {
+		return nil
+	}
+	id := graphql.ID(s)
+	return &id
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
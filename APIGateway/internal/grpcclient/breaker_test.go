@@ -0,0 +1,160 @@
+package grpcclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// step is one call through the breaker: the code the downstream answers with
+// (codes.OK for success), whether openTimeout has passed since the breaker
+// opened, and what the breaker should do.
+type step struct {
+	code       codes.Code
+	elapse     bool
+	wantCalled bool
+	wantState  breakerState
+}
+
+func TestBreakerTransitions(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "opens after threshold consecutive failures",
+			steps: []step{
+				{code: codes.Unavailable, wantCalled: true, wantState: stateClosed},
+				{code: codes.Unavailable, wantCalled: true, wantState: stateOpen},
+				{code: codes.OK, wantCalled: false, wantState: stateOpen},
+			},
+		},
+		{
+			name: "success resets the failure count",
+			steps: []step{
+				{code: codes.Unavailable, wantCalled: true, wantState: stateClosed},
+				{code: codes.OK, wantCalled: true, wantState: stateClosed},
+				{code: codes.Unavailable, wantCalled: true, wantState: stateClosed},
+			},
+		},
+		{
+			name: "application errors don't count",
+			steps: []step{
+				{code: codes.NotFound, wantCalled: true, wantState: stateClosed},
+				{code: codes.InvalidArgument, wantCalled: true, wantState: stateClosed},
+				{code: codes.PermissionDenied, wantCalled: true, wantState: stateClosed},
+			},
+		},
+		{
+			name: "successful probe closes the breaker",
+			steps: []step{
+				{code: codes.DeadlineExceeded, wantCalled: true, wantState: stateClosed},
+				{code: codes.Internal, wantCalled: true, wantState: stateOpen},
+				{code: codes.OK, elapse: true, wantCalled: true, wantState: stateClosed},
+				{code: codes.Unavailable, wantCalled: true, wantState: stateClosed},
+			},
+		},
+		{
+			name: "failed probe opens the breaker again",
+			steps: []step{
+				{code: codes.Unavailable, wantCalled: true, wantState: stateClosed},
+				{code: codes.Unavailable, wantCalled: true, wantState: stateOpen},
+				{code: codes.Unavailable, elapse: true, wantCalled: true, wantState: stateOpen},
+				{code: codes.OK, wantCalled: false, wantState: stateOpen},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBreaker("test", 2, time.Minute)
+			intercept := b.unaryInterceptor()
+
+			for i, s := range tt.steps {
+				if s.elapse {
+					b.openedAt = b.openedAt.Add(-b.openTimeout)
+				}
+				called := false
+				invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+					called = true
+					return status.Error(s.code, s.code.String())
+				}
+
+				err := intercept(context.Background(), "/test.Service/Call", nil, nil, nil, invoker)
+				if called != s.wantCalled {
+					t.Fatalf("step %d: downstream called = %t, want %t", i, called, s.wantCalled)
+				}
+				if !called && status.Code(err) != codes.Unavailable {
+					t.Errorf("step %d: rejected call returned %v, want Unavailable", i, err)
+				}
+				if b.state != s.wantState {
+					t.Fatalf("step %d: state = %s, want %s", i, b.state, s.wantState)
+				}
+			}
+		})
+	}
+}
+
+func TestBreakerSingleProbe(t *testing.T) {
+	b := newBreaker("test", 1, time.Minute)
+	b.record(true)
+	b.openedAt = b.openedAt.Add(-b.openTimeout)
+
+	if !b.allow() {
+		t.Fatal("first call after the timeout was rejected")
+	}
+	if b.allow() {
+		t.Fatal("second call was let through while the probe is in flight")
+	}
+	b.record(false)
+	if !b.allow() {
+		t.Error("call after a successful probe was rejected")
+	}
+}
+
+func TestBreakerDisabled(t *testing.T) {
+	b := newBreaker("test", 0, time.Minute)
+	intercept := b.unaryInterceptor()
+	invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+		return status.Error(codes.Unavailable, "down")
+	}
+
+	for i := 0; i < 3; i++ {
+		intercept(context.Background(), "/test.Service/Call", nil, nil, nil, invoker)
+	}
+	if b.state != stateClosed {
+		t.Errorf("state = %s with a zero threshold, want closed", b.state)
+	}
+}
+
+func TestIsFailure(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		{name: "success", ctx: context.Background(), want: false},
+		{name: "unavailable", ctx: context.Background(), err: status.Error(codes.Unavailable, ""), want: true},
+		{name: "deadline exceeded", ctx: context.Background(), err: status.Error(codes.DeadlineExceeded, ""), want: true},
+		{name: "internal", ctx: context.Background(), err: status.Error(codes.Internal, ""), want: true},
+		{name: "unknown", ctx: context.Background(), err: status.Error(codes.Unknown, ""), want: true},
+		{name: "not found", ctx: context.Background(), err: status.Error(codes.NotFound, ""), want: false},
+		{name: "caller canceled", ctx: canceled, err: status.Error(codes.Unavailable, ""), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isFailure(tt.ctx, tt.err); got != tt.want {
+				t.Errorf("isFailure = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Package grpcclient builds the gateway's connections to the backing services with
+// request validation, per-service deadlines, retries for idempotent RPCs, a
+// circuit breaker and tracing.
+package grpcclient
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/validate"
+)
+
+// Config describes one downstream service.
+type Config struct {
+	// Name identifies the service in logs and errors, e.g. "ContentService".
+	Name string
+	// Addr is the dial target, e.g. "localhost:8082".
+	Addr string
+	// Timeout bounds every call; a shorter deadline already on the context wins.
+	Timeout time.Duration
+	// MaxRetries is how many times an idempotent call is retried after a transient failure.
+	MaxRetries int
+	// Idempotent lists the full method names that are safe to retry.
+	Idempotent []string
+	// FailureThreshold is the number of consecutive failures that opens the breaker.
+	FailureThreshold int
+	// OpenTimeout is how long the breaker stays open before letting a probe call through.
+	OpenTimeout time.Duration
+}
+
+// Default values applied by LoadConfig when the environment doesn't override them.
+const (
+	DefaultTimeout          = 5 * time.Second
+	DefaultMaxRetries       = 2
+	DefaultFailureThreshold = 5
+	DefaultOpenTimeout      = 30 * time.Second
+)
+
+// LoadConfig returns the config for the service at addr with the tuning read
+// from the environment using prefix, e.g. CONTENT_SERVICE_TIMEOUT=3s,
+// CONTENT_SERVICE_RETRIES=1, CONTENT_SERVICE_BREAKER_THRESHOLD=5 and
+// CONTENT_SERVICE_BREAKER_TIMEOUT=30s.
+func LoadConfig(name, prefix, addr string, idempotent ...string) Config {
+	return Config{
+		Name:             name,
+		Addr:             addr,
+		Timeout:          getDuration(prefix+"_TIMEOUT", DefaultTimeout),
+		MaxRetries:       getInt(prefix+"_RETRIES", DefaultMaxRetries),
+		Idempotent:       idempotent,
+		FailureThreshold: getInt(prefix+"_BREAKER_THRESHOLD", DefaultFailureThreshold),
+		OpenTimeout:      getDuration(prefix+"_BREAKER_TIMEOUT", DefaultOpenTimeout),
+	}
+}
+
+// Dial creates a client connection for cfg. Interceptors run in the order
+// validation -> deadline -> circuit breaker -> retry, so invalid requests never
+// leave the gateway and the breaker sees one outcome per call.
+func Dial(cfg Config) (*grpc.ClientConn, error) {
+	breaker := newBreaker(cfg.Name, cfg.FailureThreshold, cfg.OpenTimeout)
+
+	return grpc.NewClient(cfg.Addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		tracing.DialOption(),
+		grpc.WithChainUnaryInterceptor(
+			validate.UnaryClientInterceptor(),
+			deadlineInterceptor(cfg.Timeout),
+			breaker.unaryInterceptor(),
+			retryInterceptor(cfg.MaxRetries, cfg.Idempotent),
+		),
+	)
+}
+
+// MustDial is Dial for startup code: it exits if the target is malformed.
+func MustDial(cfg Config) *grpc.ClientConn {
+	conn, err := Dial(cfg)
+	if err != nil {
+		logging.Fatal("failed to connect", "target", cfg.Name, logging.Err(err))
+	}
+	return conn
+}
+
+// deadlineInterceptor applies timeout unless the caller's context already has an earlier deadline.
+func deadlineInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if timeout > 0 {
+			if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > timeout {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func getDuration(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		slog.Warn("ignoring invalid duration", "env", key, "value", value, logging.Err(err))
+		return fallback
+	}
+	return d
+}
+
+func getInt(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		slog.Warn("ignoring invalid setting, expected a non-negative integer", "env", key, "value", value)
+		return fallback
+	}
+	return n
+}
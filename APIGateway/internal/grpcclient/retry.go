@@ -0,0 +1,60 @@
+package grpcclient
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	retryBaseDelay = 50 * time.Millisecond
+	retryMaxDelay  = time.Second
+)
+
+// retryInterceptor retries idempotent methods on transient failures with jittered
+// exponential backoff, giving up early when the context is done.
+func retryInterceptor(maxRetries int, idempotent []string) grpc.UnaryClientInterceptor {
+	retryable := make(map[string]bool, len(idempotent))
+	for _, m := range idempotent {
+		retryable[m] = true
+	}
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if !retryable[method] {
+			return err
+		}
+
+		for attempt := 0; attempt < maxRetries && isTransient(err); attempt++ {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(backoff(attempt)):
+			}
+			err = invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return err
+	}
+}
+
+// isTransient reports whether a failed call may succeed if repeated.
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+func backoff(attempt int) time.Duration {
+	d := retryBaseDelay << attempt
+	if d > retryMaxDelay {
+		d = retryMaxDelay
+	}
+	// Full jitter keeps retries from several gateway replicas from lining up.
+	return time.Duration(rand.Int63n(int64(d)) + 1)
+}
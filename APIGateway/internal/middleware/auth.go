@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/auth"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/denylist"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/jwks"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/metadata"
+)
+
+// Gin context keys holding the authenticated identity. They mirror the
+// context keys UserService sets in its AuthInterceptor.
+const (
+	ContextUserID        = "userId"
+	ContextRole          = "role"
+	ContextEmailVerified = "emailVerified"
+)
+
+// gRPC metadata keys used to forward the caller downstream. Services verify
+// the access token themselves and take the identity from it.
+const (
+	MetadataAuthorization = auth.MetadataAuthorization
+	// The end client, recorded by UserService for the sessions it starts.
+	MetadataClientIP  = "x-client-ip"
+	MetadataUserAgent = "x-client-user-agent"
+)
+
+// AuthMiddleware verifies the Bearer JWT once per request and stores user_id,
+// role and email_verified in the gin context. Tokens are signed by UserService (RS256 or
+// EdDSA) and checked against the public key named by their kid, from keys.
+// Requests without a valid token pass through anonymously; use RequireAuth to
+// reject them.
+//
+// Tokens UserService revoked on logout, ban or deletion are looked up in
+// revoked and count as invalid. With a nil revoked, or when it can't be
+// reached, tokens are accepted until they expire.
+func AuthMiddleware(keys *jwks.Cache, revoked denylist.Denylist) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if !strings.HasPrefix(authHeader, "Bearer ") {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		claims, err := auth.ParseToken(ctx, keys, strings.TrimPrefix(authHeader, "Bearer "))
+		if err == nil && !auth.Revoked(ctx, revoked, claims.ID) {
+			c.Set(ContextUserID, claims.UserID)
+			c.Set(ContextRole, string(claims.Role))
+			c.Set(ContextEmailVerified, claims.EmailVerified)
+		}
+		c.Next()
+	}
+}
+
+// RequireAuth aborts with 401 unless AuthMiddleware authenticated the caller.
+func RequireAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if UserID(c) == "" {
+			apierror.Abort(c, http.StatusUnauthorized, "authorization required")
+			return
+		}
+		c.Next()
+	}
+}
+
+// UserID returns the authenticated user's ID, or an empty string for anonymous callers.
+func UserID(c *gin.Context) string {
+	return c.GetString(ContextUserID)
+}
+
+// Role returns the authenticated user's role, or an empty string for anonymous callers.
+func Role(c *gin.Context) string {
+	return c.GetString(ContextRole)
+}
+
+// EmailVerified reports whether the authenticated caller's token says their
+// email address is verified. Tokens issued before verification don't, until
+// the client refreshes them.
+func EmailVerified(c *gin.Context) bool {
+	return c.GetBool(ContextEmailVerified)
+}
+
+// IsAdmin reports whether the authenticated caller holds the admin role.
+func IsAdmin(c *gin.Context) bool {
+	return policy.Role(Role(c)).IsAdmin()
+}
+
+// RequireRole aborts with 403 unless the authenticated caller holds one of the given roles.
+func RequireRole(roles ...policy.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := policy.Role(Role(c))
+		for _, r := range roles {
+			if role == r {
+				c.Next()
+				return
+			}
+		}
+		apierror.Abort(c, http.StatusForbidden, "insufficient permissions")
+	}
+}
+
+// OutgoingContext derives a gRPC call context from the HTTP request, carrying the
+// client's address and User-Agent and, for authenticated callers, the original
+// Authorization header as metadata.
+func OutgoingContext(c *gin.Context) context.Context {
+	md := metadata.Pairs(
+		MetadataClientIP, c.ClientIP(),
+		MetadataUserAgent, c.Request.UserAgent(),
+	)
+
+	if UserID(c) != "" {
+		md.Append(MetadataAuthorization, c.GetHeader("Authorization"))
+	}
+	return metadata.NewOutgoingContext(c.Request.Context(), md)
+}
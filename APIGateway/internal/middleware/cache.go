@@ -0,0 +1,216 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var cacheRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "gateway_cache_requests_total",
+		Help: "Requests to cacheable routes, by route and result (hit, miss, bypass, error).",
+	},
+	[]string{"route", "result"},
+)
+
+func init() {
+	prometheus.MustRegister(cacheRequests)
+}
+
+// Cache tags. Feed entries are dropped whenever any post changes; a post's own
+// entries carry CacheTagPost suffixed with its id.
+const (
+	CacheTagFeed = "feed"
+	CacheTagPost = "post"
+)
+
+// CacheRule caches a GET route's 200 responses to anonymous callers for TTL.
+// Entries are tagged with Tag, suffixed with the value of the path parameter
+// Param when it is set.
+type CacheRule struct {
+	TTL   time.Duration
+	Tag   string
+	Param string
+}
+
+// DefaultCacheRules cover the public content reads, keyed like DefaultRateLimits.
+// TTLs stay short because poll tallies only invalidate the post's own entry,
+// not the feed.
+var DefaultCacheRules = map[string]CacheRule{
+	policy.Route(http.MethodGet, "/content/posts"):     {TTL: 30 * time.Second, Tag: CacheTagFeed},
+	policy.Route(http.MethodGet, "/content/posts/:id"): {TTL: time.Minute, Tag: CacheTagPost, Param: "id"},
+}
+
+// ResponseCache serves the routes in rules from store for anonymous callers.
+// Responses on those routes carry an ETag, and a matching If-None-Match gets a
+// 304. Authenticated callers bypass the store because their responses include
+// per-user state such as liked. It must run after AuthMiddleware.
+func ResponseCache(store ResponseCacheStore, rules map[string]CacheRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rule, ok := rules[routeKey(c)]
+		if !ok {
+			c.Next()
+			return
+		}
+		route := c.FullPath()
+		c.Header("Vary", "Authorization")
+
+		if UserID(c) != "" {
+			c.Header("Cache-Control", "private, no-cache")
+			cacheRequests.WithLabelValues(route, "bypass").Inc()
+			if resp := bufferResponse(c); resp != nil {
+				writeCachedResponse(c, resp)
+			}
+			return
+		}
+		c.Header("Cache-Control", "public, no-cache")
+
+		ctx := c.Request.Context()
+		tag := rule.Tag
+		if rule.Param != "" {
+			tag += ":" + c.Param(rule.Param)
+		}
+		gen, err := store.Generation(ctx, tag)
+		if err != nil {
+			// Fail open: serve uncached rather than fail the read.
+			slog.WarnContext(ctx, "response cache unavailable", "route", route, logging.Err(err))
+			cacheRequests.WithLabelValues(route, "error").Inc()
+			c.Next()
+			return
+		}
+		key := cacheEntryKey(tag, gen, c.Request.URL.Path+"?"+c.Request.URL.Query().Encode())
+
+		cached, err := store.Get(ctx, key)
+		if err != nil {
+			slog.WarnContext(ctx, "response cache read failed", "route", route, logging.Err(err))
+		}
+		if cached != nil {
+			cacheRequests.WithLabelValues(route, "hit").Inc()
+			c.Abort()
+			c.Header("X-Cache", "HIT")
+			writeCachedResponse(c, cached)
+			return
+		}
+
+		cacheRequests.WithLabelValues(route, "miss").Inc()
+		resp := bufferResponse(c)
+		if resp == nil {
+			return
+		}
+		if err := store.Set(ctx, key, resp, rule.TTL); err != nil {
+			slog.WarnContext(ctx, "response cache write failed", "route", route, logging.Err(err))
+		}
+		c.Header("X-Cache", "MISS")
+		writeCachedResponse(c, resp)
+	}
+}
+
+// InvalidateCacheOnEvents subscribes store to the ContentService events that
+// change cached responses.
+func InvalidateCacheOnEvents(q queue.MessageQueue, store ResponseCacheStore) error {
+	feedAndPost := func(postID string) []string { return []string{CacheTagFeed, CacheTagPost + ":" + postID} }
+	postOnly := func(postID string) []string { return []string{CacheTagPost + ":" + postID} }
+
+	subjects := map[string]func(postID string) []string{
+		"post.created":    func(string) []string { return []string{CacheTagFeed} },
+		"post.updated":    feedAndPost,
+		"post.deleted":    feedAndPost,
+		"comment.created": feedAndPost,
+		"comment.deleted": feedAndPost,
+		"post.liked":      feedAndPost,
+		"post.unliked":    feedAndPost,
+		"poll.voted":      postOnly,
+	}
+	for subject, tags := range subjects {
+		err := tracing.Subscribe(q, subject, func(ctx context.Context, data []byte) {
+			var payload struct {
+				PostID string `json:"post_id"`
+			}
+			if err := json.Unmarshal(data, &payload); err != nil {
+				slog.ErrorContext(ctx, "response cache: failed to parse event", "subject", subject, logging.Err(err))
+				return
+			}
+			if err := store.Invalidate(ctx, tags(payload.PostID)...); err != nil {
+				slog.WarnContext(ctx, "response cache: failed to invalidate", "subject", subject, logging.Err(err))
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bufferedWriter holds a response back so it can be hashed and stored before
+// it is sent.
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int)              { w.status = code }
+func (w *bufferedWriter) WriteHeaderNow()                   {}
+func (w *bufferedWriter) Write(b []byte) (int, error)       { return w.body.Write(b) }
+func (w *bufferedWriter) WriteString(s string) (int, error) { return w.body.WriteString(s) }
+func (w *bufferedWriter) Status() int                       { return w.status }
+func (w *bufferedWriter) Size() int                         { return w.body.Len() }
+func (w *bufferedWriter) Written() bool                     { return w.body.Len() > 0 }
+
+// bufferResponse runs the rest of the chain and returns its response if it is
+// a 200. Any other response is sent as is and nil is returned.
+func bufferResponse(c *gin.Context) *CachedResponse {
+	original := c.Writer
+	w := &bufferedWriter{ResponseWriter: original, status: http.StatusOK}
+	c.Writer = w
+	c.Next()
+	c.Writer = original
+
+	if w.status != http.StatusOK {
+		c.Writer.WriteHeader(w.status)
+		c.Writer.Write(w.body.Bytes())
+		return nil
+	}
+
+	sum := sha256.Sum256(w.body.Bytes())
+	return &CachedResponse{
+		ContentType: original.Header().Get("Content-Type"),
+		ETag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+		Body:        w.body.Bytes(),
+	}
+}
+
+func writeCachedResponse(c *gin.Context, resp *CachedResponse) {
+	c.Header("ETag", resp.ETag)
+	if etagMatches(c.GetHeader("If-None-Match"), resp.ETag) {
+		c.Status(http.StatusNotModified)
+		c.Writer.WriteHeaderNow()
+		return
+	}
+	c.Data(http.StatusOK, resp.ContentType, resp.Body)
+}
+
+// etagMatches implements the weak comparison If-None-Match asks for.
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// CachedResponse is a stored 200 response.
+type CachedResponse struct {
+	ContentType string `json:"contentType"`
+	ETag        string `json:"etag"`
+	Body        []byte `json:"body"`
+}
+
+// ResponseCacheStore holds cached responses under tagged generations: bumping
+// a tag's generation orphans every entry stored under the old one, which then
+// expires on its own TTL.
+type ResponseCacheStore interface {
+	// Generation returns the current generation of tag.
+	Generation(ctx context.Context, tag string) (int64, error)
+	// Get returns nil on a miss.
+	Get(ctx context.Context, key string) (*CachedResponse, error)
+	Set(ctx context.Context, key string, resp *CachedResponse, ttl time.Duration) error
+	Invalidate(ctx context.Context, tags ...string) error
+}
+
+const cacheKeyPrefix = "httpcache:"
+
+type redisResponseCacheStore struct {
+	client *redis.Client
+}
+
+// NewRedisResponseCacheStore returns a store shared by every gateway replica.
+func NewRedisResponseCacheStore(client *redis.Client) ResponseCacheStore {
+	return &redisResponseCacheStore{client: client}
+}
+
+func (s *redisResponseCacheStore) Generation(ctx context.Context, tag string) (int64, error) {
+	gen, err := s.client.Get(ctx, cacheKeyPrefix+"gen:"+tag).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return gen, err
+}
+
+func (s *redisResponseCacheStore) Get(ctx context.Context, key string) (*CachedResponse, error) {
+	data, err := s.client.Get(ctx, cacheKeyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var resp CachedResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (s *redisResponseCacheStore) Set(ctx context.Context, key string, resp *CachedResponse, ttl time.Duration) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, cacheKeyPrefix+key, data, ttl).Err()
+}
+
+func (s *redisResponseCacheStore) Invalidate(ctx context.Context, tags ...string) error {
+	pipe := s.client.Pipeline()
+	for _, tag := range tags {
+		pipe.Incr(ctx, cacheKeyPrefix+"gen:"+tag)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+type memoryCacheEntry struct {
+	resp    *CachedResponse
+	expires time.Time
+}
+
+type memoryResponseCacheStore struct {
+	mu          sync.Mutex
+	generations map[string]int64
+	entries     map[string]memoryCacheEntry
+	lastSweep   time.Time
+}
+
+// NewMemoryResponseCacheStore returns a process-local store for local runs
+// without Redis.
+func NewMemoryResponseCacheStore() ResponseCacheStore {
+	return &memoryResponseCacheStore{
+		generations: make(map[string]int64),
+		entries:     make(map[string]memoryCacheEntry),
+		lastSweep:   time.Now(),
+	}
+}
+
+func (s *memoryResponseCacheStore) Generation(_ context.Context, tag string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.generations[tag], nil
+}
+
+func (s *memoryResponseCacheStore) Get(_ context.Context, key string) (*CachedResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		return nil, nil
+	}
+	return e.resp, nil
+}
+
+func (s *memoryResponseCacheStore) Set(_ context.Context, key string, resp *CachedResponse, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastSweep) > memorySweepInterval {
+		for k, e := range s.entries {
+			if now.After(e.expires) {
+				delete(s.entries, k)
+			}
+		}
+		s.lastSweep = now
+	}
+	s.entries[key] = memoryCacheEntry{resp: resp, expires: now.Add(ttl)}
+	return nil
+}
+
+func (s *memoryResponseCacheStore) Invalidate(_ context.Context, tags ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, tag := range tags {
+		s.generations[tag]++
+	}
+	return nil
+}
+
+// cacheEntryKey places key under the given generation of tag.
+func cacheEntryKey(tag string, gen int64, key string) string {
+	return tag + "@" + strconv.FormatInt(gen, 10) + ":" + key
+}
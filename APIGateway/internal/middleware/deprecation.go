@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var deprecatedRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "gateway_deprecated_requests_total",
+		Help: "Requests served through deprecated route aliases, by method and route.",
+	},
+	[]string{"method", "route"},
+)
+
+func init() {
+	prometheus.MustRegister(deprecatedRequests)
+}
+
+// Deprecation describes a set of routes scheduled for removal.
+type Deprecation struct {
+	Since  time.Time
+	Sunset time.Time
+	// Successor maps a request path to its replacement; an empty result omits the Link header.
+	Successor func(path string) string
+	// Routes reports whether a route, as in gin.Context.FullPath, is
+	// deprecated. Nil deprecates every route the middleware runs for.
+	Routes func(route string) bool
+}
+
+// Deprecated announces d on every response (Deprecation per RFC 9745, Sunset per
+// RFC 8594, and a successor-version Link) and counts the request. Install it
+// ahead of middleware that may answer without calling the handler, such as
+// ResponseCache, so those responses are announced too.
+func Deprecated(d Deprecation) gin.HandlerFunc {
+	deprecation := "@" + strconv.FormatInt(d.Since.Unix(), 10)
+	sunset := d.Sunset.UTC().Format(http.TimeFormat)
+
+	return func(c *gin.Context) {
+		if d.Routes != nil && !d.Routes(c.FullPath()) {
+			c.Next()
+			return
+		}
+		deprecatedRequests.WithLabelValues(c.Request.Method, c.FullPath()).Inc()
+
+		h := c.Writer.Header()
+		h.Set("Deprecation", deprecation)
+		h.Set("Sunset", sunset)
+		if d.Successor != nil {
+			if successor := d.Successor(c.Request.URL.Path); successor != "" {
+				h.Set("Link", "<"+successor+`>; rel="successor-version"`)
+			}
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,176 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// HeaderIdempotencyKey makes a mutating request safe to retry: every request
+	// carrying the same key gets the response of the first one.
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// HeaderIdempotentReplayed marks a response served from the store.
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+	// ContextIdempotencyKey is the gin context key holding the request's key.
+	ContextIdempotencyKey = "idempotencyKey"
+)
+
+const (
+	maxIdempotencyKeyLength = 255
+	// maxIdempotentBodySize caps the bodies that are read for fingerprinting;
+	// the guarded routes take small JSON objects.
+	maxIdempotentBodySize = 64 << 10
+	// idempotencyLockTTL bounds how long a key stays reserved by a request
+	// that never finishes, e.g. because the replica died.
+	idempotencyLockTTL = time.Minute
+)
+
+var idempotencyRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "gateway_idempotency_requests_total",
+		Help: "Requests carrying an Idempotency-Key, by route and result (stored, replayed, mismatch, in_progress, released, error).",
+	},
+	[]string{"route", "result"},
+)
+
+func init() {
+	prometheus.MustRegister(idempotencyRequests)
+}
+
+// IdempotencyRule keeps the first response to a keyed request for TTL.
+type IdempotencyRule struct {
+	TTL time.Duration
+}
+
+// DefaultIdempotencyRules cover the routes whose retries would charge or book
+// twice, keyed like DefaultRateLimits.
+var DefaultIdempotencyRules = map[string]IdempotencyRule{
+	policy.Route(http.MethodPost, "/payments/process"):        {TTL: 24 * time.Hour},
+	policy.Route(http.MethodPost, "/subscriptions/subscribe"): {TTL: 24 * time.Hour},
+	policy.Route(http.MethodPost, "/consultations/book"):      {TTL: 24 * time.Hour},
+}
+
+// Idempotency deduplicates authenticated requests to the routes in rules that
+// carry an Idempotency-Key. Keys are scoped to the caller and route. The first
+// response, unless it is a server error, is stored and replayed to every retry
+// with the same body; reusing a key with a different body gets a 422, and a
+// retry that arrives while the first request is running gets a 409. Requests
+// without a key pass through. It must run after AuthMiddleware.
+func Idempotency(store IdempotencyStore, rules map[string]IdempotencyRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rule, ok := rules[routeKey(c)]
+		key := c.GetHeader(HeaderIdempotencyKey)
+		if !ok || key == "" || UserID(c) == "" {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			apierror.Abort(c, http.StatusBadRequest, "Idempotency-Key must be at most 255 characters")
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxIdempotentBodySize+1))
+		if err != nil {
+			apierror.BadRequest(c, err)
+			return
+		}
+		if len(body) > maxIdempotentBodySize {
+			apierror.Abort(c, http.StatusRequestEntityTooLarge, "request body is too large")
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		c.Set(ContextIdempotencyKey, key)
+
+		ctx := c.Request.Context()
+		route := c.FullPath()
+		storeKey := hash(UserID(c), routeKey(c), key)
+		fingerprint := hash(c.Request.URL.Path, string(body))
+
+		existing, err := store.Reserve(ctx, storeKey, fingerprint, idempotencyLockTTL)
+		if err != nil {
+			// Fail open: PaymentService still deduplicates charges on the key.
+			slog.WarnContext(ctx, "idempotency store unavailable", "route", route, logging.Err(err))
+			idempotencyRequests.WithLabelValues(route, "error").Inc()
+			c.Next()
+			return
+		}
+		if existing != nil {
+			switch {
+			case existing.Fingerprint != fingerprint:
+				idempotencyRequests.WithLabelValues(route, "mismatch").Inc()
+				apierror.AbortCode(c, http.StatusUnprocessableEntity, apierror.CodeIdempotencyKeyReused,
+					"Idempotency-Key was already used with a different request")
+			case existing.Status == 0:
+				idempotencyRequests.WithLabelValues(route, "in_progress").Inc()
+				c.Header("Retry-After", "1")
+				apierror.AbortCode(c, http.StatusConflict, apierror.CodeRequestInProgress,
+					"a request with this Idempotency-Key is still being processed")
+			default:
+				idempotencyRequests.WithLabelValues(route, "replayed").Inc()
+				c.Abort()
+				c.Header(HeaderIdempotentReplayed, "true")
+				c.Data(existing.Status, existing.ContentType, existing.Body)
+			}
+			return
+		}
+
+		resp := captureResponse(c)
+		resp.Fingerprint = fingerprint
+		if resp.Status >= http.StatusInternalServerError {
+			// The request may not have taken effect, so let the client retry it.
+			idempotencyRequests.WithLabelValues(route, "released").Inc()
+			if err := store.Release(ctx, storeKey); err != nil {
+				slog.WarnContext(ctx, "idempotency release failed", "route", route, logging.Err(err))
+			}
+			return
+		}
+		idempotencyRequests.WithLabelValues(route, "stored").Inc()
+		if err := store.Complete(ctx, storeKey, resp, rule.TTL); err != nil {
+			slog.WarnContext(ctx, "idempotency write failed", "route", route, logging.Err(err))
+		}
+	}
+}
+
+// IdempotencyKey returns the request's Idempotency-Key on a guarded route, or "".
+func IdempotencyKey(c *gin.Context) string {
+	return c.GetString(ContextIdempotencyKey)
+}
+
+// captureResponse runs the rest of the chain, sends its response and returns
+// a copy of it.
+func captureResponse(c *gin.Context) *IdempotentResponse {
+	original := c.Writer
+	w := &bufferedWriter{ResponseWriter: original, status: http.StatusOK}
+	c.Writer = w
+	c.Next()
+	c.Writer = original
+
+	c.Writer.WriteHeader(w.status)
+	c.Writer.Write(w.body.Bytes())
+	return &IdempotentResponse{
+		Status:      w.status,
+		ContentType: original.Header().Get("Content-Type"),
+		Body:        w.body.Bytes(),
+	}
+}
+
+// hash joins parts unambiguously and returns their SHA-256 in hex.
+func hash(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
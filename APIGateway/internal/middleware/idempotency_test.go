@@ -0,0 +1,223 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const idempotentPath = "/api/v1/payments/process"
+
+// idempotentRouter serves idempotentPath for user u1 with handler behind
+// Idempotency.
+func idempotentRouter(store IdempotencyStore, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set(ContextUserID, "u1")
+		c.Next()
+	})
+	r.Use(Idempotency(store, DefaultIdempotencyRules))
+	r.POST(idempotentPath, handler)
+	return r
+}
+
+func sendIdempotent(r http.Handler, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, idempotentPath, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(HeaderIdempotencyKey, key)
+	}
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotency(t *testing.T) {
+	tests := []struct {
+		name string
+		// statuses are what the handler answers each call with.
+		statuses   []int
+		keys       []string
+		bodies     []string
+		wantStatus []int
+		wantCalls  int
+		wantReplay []bool
+	}{
+		{
+			name:       "retry replays the first response",
+			statuses:   []int{http.StatusCreated},
+			keys:       []string{"k1", "k1"},
+			bodies:     []string{`{"amount":10}`, `{"amount":10}`},
+			wantStatus: []int{http.StatusCreated, http.StatusCreated},
+			wantCalls:  1,
+			wantReplay: []bool{false, true},
+		},
+		{
+			name:       "client errors are replayed too",
+			statuses:   []int{http.StatusBadRequest},
+			keys:       []string{"k1", "k1"},
+			bodies:     []string{`{}`, `{}`},
+			wantStatus: []int{http.StatusBadRequest, http.StatusBadRequest},
+			wantCalls:  1,
+			wantReplay: []bool{false, true},
+		},
+		{
+			name:       "reusing a key with another body is rejected",
+			statuses:   []int{http.StatusCreated},
+			keys:       []string{"k1", "k1"},
+			bodies:     []string{`{"amount":10}`, `{"amount":20}`},
+			wantStatus: []int{http.StatusCreated, http.StatusUnprocessableEntity},
+			wantCalls:  1,
+			wantReplay: []bool{false, false},
+		},
+		{
+			name:       "server error releases the key",
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusCreated},
+			keys:       []string{"k1", "k1"},
+			bodies:     []string{`{"amount":10}`, `{"amount":10}`},
+			wantStatus: []int{http.StatusServiceUnavailable, http.StatusCreated},
+			wantCalls:  2,
+			wantReplay: []bool{false, false},
+		},
+		{
+			name:       "requests without a key pass through",
+			statuses:   []int{http.StatusCreated, http.StatusCreated},
+			keys:       []string{"", ""},
+			bodies:     []string{`{"amount":10}`, `{"amount":10}`},
+			wantStatus: []int{http.StatusCreated, http.StatusCreated},
+			wantCalls:  2,
+			wantReplay: []bool{false, false},
+		},
+		{
+			name:       "different keys are independent",
+			statuses:   []int{http.StatusCreated, http.StatusCreated},
+			keys:       []string{"k1", "k2"},
+			bodies:     []string{`{"amount":10}`, `{"amount":10}`},
+			wantStatus: []int{http.StatusCreated, http.StatusCreated},
+			wantCalls:  2,
+			wantReplay: []bool{false, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			r := idempotentRouter(NewMemoryIdempotencyStore(), func(c *gin.Context) {
+				status := tt.statuses[calls]
+				calls++
+				c.Header("Content-Type", "application/json; charset=utf-8")
+				c.String(status, `{"call":%d}`, calls)
+			})
+
+			var first string
+			for i := range tt.keys {
+				rec := sendIdempotent(r, tt.keys[i], tt.bodies[i])
+				if rec.Code != tt.wantStatus[i] {
+					t.Fatalf("request %d: status %d, want %d: %s", i, rec.Code, tt.wantStatus[i], rec.Body)
+				}
+				replayed := rec.Header().Get(HeaderIdempotentReplayed) == "true"
+				if replayed != tt.wantReplay[i] {
+					t.Errorf("request %d: replayed = %t, want %t", i, replayed, tt.wantReplay[i])
+				}
+				if i == 0 {
+					first = rec.Body.String()
+				}
+				if replayed {
+					if rec.Body.String() != first {
+						t.Errorf("request %d: replayed body %q, want %q", i, rec.Body, first)
+					}
+					if ct := rec.Header().Get("Content-Type"); ct != "application/json; charset=utf-8" {
+						t.Errorf("request %d: replayed Content-Type %q", i, ct)
+					}
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("handler ran %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestIdempotencyInProgress(t *testing.T) {
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	r := idempotentRouter(NewMemoryIdempotencyStore(), func(c *gin.Context) {
+		close(started)
+		<-finish
+		c.Status(http.StatusCreated)
+	})
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() { done <- sendIdempotent(r, "k1", `{}`) }()
+	<-started
+
+	rec := sendIdempotent(r, "k1", `{}`)
+	if rec.Code != http.StatusConflict {
+		t.Errorf("concurrent retry: status %d, want 409", rec.Code)
+	}
+	if rec.Header().Get("Retry-After") == "" {
+		t.Error("concurrent retry has no Retry-After")
+	}
+
+	close(finish)
+	if first := <-done; first.Code != http.StatusCreated {
+		t.Errorf("first request: status %d, want 201", first.Code)
+	}
+}
+
+// downIdempotencyStore fails every call, like an unreachable Redis.
+type downIdempotencyStore struct{}
+
+var errStoreDown = errors.New("store down")
+
+func (downIdempotencyStore) Reserve(context.Context, string, string, time.Duration) (*IdempotentResponse, error) {
+	return nil, errStoreDown
+}
+
+func (downIdempotencyStore) Complete(context.Context, string, *IdempotentResponse, time.Duration) error {
+	return errStoreDown
+}
+
+func (downIdempotencyStore) Release(context.Context, string) error {
+	return errStoreDown
+}
+
+func TestIdempotencyStoreDown(t *testing.T) {
+	calls := 0
+	r := idempotentRouter(downIdempotencyStore{}, func(c *gin.Context) {
+		calls++
+		c.Status(http.StatusCreated)
+	})
+
+	for i := 0; i < 2; i++ {
+		if rec := sendIdempotent(r, "k1", `{}`); rec.Code != http.StatusCreated {
+			t.Fatalf("request %d: status %d, want 201", i, rec.Code)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("handler ran %d times with the store down, want 2", calls)
+	}
+}
+
+func TestMemoryIdempotencyStoreExpiry(t *testing.T) {
+	s := NewMemoryIdempotencyStore()
+	ctx := context.Background()
+
+	if existing, _ := s.Reserve(ctx, "k", "f1", 20*time.Millisecond); existing != nil {
+		t.Fatal("first reservation found an existing record")
+	}
+	if existing, _ := s.Reserve(ctx, "k", "f1", 20*time.Millisecond); existing == nil || existing.Status != 0 {
+		t.Fatalf("second reservation = %+v, want the pending record", existing)
+	}
+	time.Sleep(30 * time.Millisecond)
+	if existing, _ := s.Reserve(ctx, "k", "f1", time.Minute); existing != nil {
+		t.Errorf("reservation after expiry = %+v, want a fresh claim", existing)
+	}
+}
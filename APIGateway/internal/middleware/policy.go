@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"github.com/gin-gonic/gin"
+)
+
+var versionPrefix = regexp.MustCompile(`^/api/v[0-9]+(/|$)`)
+
+// routeKey is the "METHOD /route/:param" key policy and rate-limit rules are
+// looked up by. The API version prefix is dropped, so /api/v1/auth/login, a
+// future /api/v2/auth/login and the legacy /auth/login share one rule.
+func routeKey(c *gin.Context) string {
+	return policy.Route(c.Request.Method, versionPrefix.ReplaceAllString(c.FullPath(), "/"))
+}
+
+// PolicyMiddleware enforces p on every matched route, keyed by routeKey.
+// Owner checks receive the *gin.Context as the resource. Routes without a rule
+// pass through: the services they call refuse anything their own rules don't
+// allow.
+func PolicyMiddleware(p *policy.Policy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		identity := policy.Identity{UserID: UserID(c), Role: policy.Role(Role(c)), Verified: EmailVerified(c)}
+
+		err := p.Authorize(c.Request.Context(), routeKey(c), identity, c)
+		switch err {
+		case nil, policy.ErrNoRule:
+			c.Next()
+		case policy.ErrUnauthenticated:
+			apierror.Abort(c, http.StatusUnauthorized, "authorization required")
+		case policy.ErrForbidden, policy.ErrUnverified:
+			apierror.Abort(c, http.StatusForbidden, err.Error())
+		case policy.ErrNotFound:
+			apierror.Abort(c, http.StatusNotFound, err.Error())
+		default:
+			apierror.Respond(c, err)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"github.com/gin-gonic/gin"
+)
+
+func TestPolicyMiddleware(t *testing.T) {
+	p := policy.New(map[string]policy.Rule{
+		policy.Route(http.MethodGet, "/posts"):            {Public: true},
+		policy.Route(http.MethodPost, "/posts"):           {},
+		policy.Route(http.MethodDelete, "/posts/:id"):     {Roles: []policy.Role{policy.RoleAdmin, policy.RoleModerator}, Owner: "post"},
+		policy.Route(http.MethodGet, "/admin/dashboard"):  {Roles: []policy.Role{policy.RoleAdmin}},
+		policy.Route(http.MethodGet, "/posts/:id/secret"): {Owner: "post"},
+	})
+	// Post 1 is u1's; every other post is missing.
+	p.RegisterOwner("post", func(_ context.Context, id policy.Identity, resource interface{}) (bool, error) {
+		if resource.(*gin.Context).Param("id") != "1" {
+			return false, policy.ErrNotFound
+		}
+		return id.UserID == "u1", nil
+	})
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		userID string
+		role   policy.Role
+		want   int
+	}{
+		{name: "public route, anonymous", method: http.MethodGet, path: "/api/v1/posts", want: http.StatusOK},
+		{name: "signed-in route, anonymous", method: http.MethodPost, path: "/api/v1/posts", want: http.StatusUnauthorized},
+		{name: "signed-in route, user", method: http.MethodPost, path: "/api/v1/posts", userID: "u2", role: policy.RoleUser, want: http.StatusOK},
+		{name: "staff route, user", method: http.MethodGet, path: "/api/v1/admin/dashboard", userID: "u2", role: policy.RoleUser, want: http.StatusForbidden},
+		{name: "staff route, moderator", method: http.MethodGet, path: "/api/v1/admin/dashboard", userID: "m1", role: policy.RoleModerator, want: http.StatusForbidden},
+		{name: "staff route, admin", method: http.MethodGet, path: "/api/v1/admin/dashboard", userID: "a1", role: policy.RoleAdmin, want: http.StatusOK},
+		{name: "owner", method: http.MethodDelete, path: "/api/v1/posts/1", userID: "u1", role: policy.RoleUser, want: http.StatusOK},
+		{name: "not the owner", method: http.MethodDelete, path: "/api/v1/posts/1", userID: "u2", role: policy.RoleUser, want: http.StatusForbidden},
+		{name: "role instead of ownership", method: http.MethodDelete, path: "/api/v1/posts/1", userID: "m1", role: policy.RoleModerator, want: http.StatusOK},
+		{name: "owner check on a missing resource", method: http.MethodGet, path: "/api/v1/posts/2/secret", userID: "u1", role: policy.RoleUser, want: http.StatusNotFound},
+		{name: "route without a rule", method: http.MethodGet, path: "/api/v1/other", want: http.StatusOK},
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if id := c.GetHeader("X-Test-User"); id != "" {
+			c.Set(ContextUserID, id)
+			c.Set(ContextRole, c.GetHeader("X-Test-Role"))
+		}
+	})
+	r.Use(PolicyMiddleware(p))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	v1 := r.Group("/api/v1")
+	v1.GET("/posts", ok)
+	v1.POST("/posts", ok)
+	v1.DELETE("/posts/:id", ok)
+	v1.GET("/posts/:id/secret", ok)
+	v1.GET("/admin/dashboard", ok)
+	v1.GET("/other", ok)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.userID != "" {
+				req.Header.Set("X-Test-User", tt.userID)
+				req.Header.Set("X-Test-Role", string(tt.role))
+			}
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Errorf("status %d, want %d: %s", rec.Code, tt.want, rec.Body)
+			}
+		})
+	}
+}
+
+func TestRouteKey(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "versioned", path: "/api/v1/posts/7", want: "GET /posts/:id"},
+		{name: "later version", path: "/api/v2/posts/7", want: "GET /posts/:id"},
+		{name: "legacy alias", path: "/posts/7", want: "GET /posts/:id"},
+		{name: "version root", path: "/api/v1", want: "GET /"},
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	var got string
+	record := func(c *gin.Context) { got = routeKey(c) }
+	r.GET("/posts/:id", record)
+	r.GET("/api/v1/posts/:id", record)
+	r.GET("/api/v2/posts/:id", record)
+	r.GET("/api/v1", record)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = ""
+			r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if got != tt.want {
+				t.Errorf("routeKey(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
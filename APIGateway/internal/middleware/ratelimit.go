@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var errInvalidRateLimit = errors.New("expected <limit>/<window>, e.g. 10/1m")
+
+var (
+	rateLimitRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gateway_rate_limit_requests_total",
+			Help: "Requests checked by the rate limiter, by route group and result (allowed or limited).",
+		},
+		[]string{"group", "result"},
+	)
+	rateLimitStoreErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "gateway_rate_limit_store_errors_total",
+			Help: "Rate limit checks that fell back to the in-memory store because the primary store failed.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(rateLimitRequests, rateLimitStoreErrors)
+}
+
+// RateLimitRule allows Limit requests per Window. Routes sharing a Group share a budget.
+type RateLimitRule struct {
+	Group  string
+	Limit  int
+	Window time.Duration
+}
+
+// DefaultRateLimits covers the endpoints that are open to brute force or spam,
+// keyed by "METHOD /route/:param" without the API version prefix.
+var DefaultRateLimits = map[string]RateLimitRule{
+	policy.Route(http.MethodPost, "/auth/login"):           {Group: "auth_login", Limit: 10, Window: time.Minute},
+	policy.Route(http.MethodPost, "/auth/register"):        {Group: "auth_register", Limit: 5, Window: time.Hour},
+	policy.Route(http.MethodPost, "/auth/refresh"):         {Group: "auth_refresh", Limit: 30, Window: time.Minute},
+	policy.Route(http.MethodPost, "/auth/resend-code"):     {Group: "auth_resend_code", Limit: 3, Window: 10 * time.Minute},
+	policy.Route(http.MethodPost, "/auth/password/forgot"): {Group: "auth_password_forgot", Limit: 3, Window: 10 * time.Minute},
+	policy.Route(http.MethodPost, "/auth/password/reset"):  {Group: "auth_password_reset", Limit: 10, Window: time.Hour},
+	policy.Route(http.MethodPost, "/auth/password/change"): {Group: "auth_password_change", Limit: 10, Window: time.Hour},
+	policy.Route(http.MethodPost, "/notify/contact"):       {Group: "contact", Limit: 5, Window: time.Hour},
+	policy.Route(http.MethodPost, "/media"):                {Group: "media_upload", Limit: 30, Window: time.Hour},
+}
+
+// LoadRateLimits returns a copy of rules with per-group overrides applied from the
+// environment, e.g. RATE_LIMIT_AUTH_LOGIN=20/1m. Malformed values are ignored.
+func LoadRateLimits(rules map[string]RateLimitRule) map[string]RateLimitRule {
+	out := make(map[string]RateLimitRule, len(rules))
+	for route, rule := range rules {
+		env := "RATE_LIMIT_" + strings.ToUpper(rule.Group)
+		if value, ok := os.LookupEnv(env); ok {
+			limit, window, err := parseRateLimit(value)
+			if err != nil {
+				slog.Warn("ignoring invalid rate limit", "env", env, "value", value, logging.Err(err))
+			} else {
+				rule.Limit, rule.Window = limit, window
+			}
+		}
+		out[route] = rule
+	}
+	return out
+}
+
+func parseRateLimit(value string) (int, time.Duration, error) {
+	limitStr, windowStr, ok := strings.Cut(value, "/")
+	if !ok {
+		return 0, 0, errInvalidRateLimit
+	}
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit <= 0 {
+		return 0, 0, errInvalidRateLimit
+	}
+	window, err := time.ParseDuration(windowStr)
+	if err != nil || window <= 0 {
+		return 0, 0, errInvalidRateLimit
+	}
+	return limit, window, nil
+}
+
+// RateLimitMiddleware enforces rules on matching routes using a sliding window in store.
+// Authenticated callers are limited per user id, anonymous callers per client IP, so it
+// must run after AuthMiddleware.
+func RateLimitMiddleware(store RateLimitStore, rules map[string]RateLimitRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rule, ok := rules[routeKey(c)]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		d, err := store.Allow(c.Request.Context(), rateLimitKey(c, rule.Group), rule.Limit, rule.Window)
+		if err != nil {
+			// Fail open: an unavailable limiter must not take the endpoint down with it.
+			slog.WarnContext(c.Request.Context(), "rate limit check failed", "group", rule.Group, logging.Err(err))
+			c.Next()
+			return
+		}
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(rule.Limit))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(d.Remaining))
+
+		if !d.Allowed {
+			rateLimitRequests.WithLabelValues(rule.Group, "limited").Inc()
+			c.Header("Retry-After", strconv.Itoa(int(math.Max(1, math.Ceil(d.RetryAfter.Seconds())))))
+			apierror.Abort(c, http.StatusTooManyRequests, "too many requests")
+			return
+		}
+
+		rateLimitRequests.WithLabelValues(rule.Group, "allowed").Inc()
+		c.Next()
+	}
+}
+
+func rateLimitKey(c *gin.Context, group string) string {
+	if userID := UserID(c); userID != "" {
+		return "ratelimit:" + group + ":user:" + userID
+	}
+	return "ratelimit:" + group + ":ip:" + c.ClientIP()
+}
@@ -0,0 +1,160 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/redis/go-redis/v9"
+)
+
+// RateLimitDecision is the outcome of a single Allow call.
+type RateLimitDecision struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+// RateLimitStore records hits in a sliding window and decides whether one more is allowed.
+type RateLimitStore interface {
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (RateLimitDecision, error)
+}
+
+// slidingWindowScript keeps one sorted-set member per hit, scored by its timestamp in ms.
+// It returns {allowed, remaining, retry_after_ms}.
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, 0, now - window)
+local count = redis.call('ZCARD', key)
+if count < limit then
+	redis.call('ZADD', key, now, ARGV[4])
+	redis.call('PEXPIRE', key, window)
+	return {1, limit - count - 1, 0}
+end
+
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+return {0, 0, tonumber(oldest[2]) + window - now}
+`)
+
+type redisRateLimitStore struct {
+	client *redis.Client
+}
+
+// NewRedisRateLimitStore returns a store shared by every gateway replica.
+func NewRedisRateLimitStore(client *redis.Client) RateLimitStore {
+	return &redisRateLimitStore{client: client}
+}
+
+func (s *redisRateLimitStore) Allow(ctx context.Context, key string, limit int, window time.Duration) (RateLimitDecision, error) {
+	now := time.Now().UnixMilli()
+	member := fmt.Sprintf("%d-%d", now, rand.Int63())
+
+	res, err := slidingWindowScript.Run(ctx, s.client, []string{key}, now, window.Milliseconds(), limit, member).Int64Slice()
+	if err != nil {
+		return RateLimitDecision{}, err
+	}
+	if len(res) != 3 {
+		return RateLimitDecision{}, fmt.Errorf("unexpected rate limit script result: %v", res)
+	}
+
+	return RateLimitDecision{
+		Allowed:    res[0] == 1,
+		Remaining:  int(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Millisecond,
+	}, nil
+}
+
+// memorySweepInterval bounds how often the in-memory store drops idle keys.
+const memorySweepInterval = time.Minute
+
+type memoryWindow struct {
+	hits   []time.Time
+	window time.Duration
+}
+
+type memoryRateLimitStore struct {
+	mu        sync.Mutex
+	windows   map[string]*memoryWindow
+	lastSweep time.Time
+}
+
+// NewMemoryRateLimitStore returns a process-local store for local runs and as a Redis fallback.
+func NewMemoryRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{
+		windows:   make(map[string]*memoryWindow),
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *memoryRateLimitStore) Allow(_ context.Context, key string, limit int, window time.Duration) (RateLimitDecision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastSweep) > memorySweepInterval {
+		s.sweep(now)
+	}
+
+	w, ok := s.windows[key]
+	if !ok {
+		w = &memoryWindow{}
+		s.windows[key] = w
+	}
+	w.window = window
+	w.hits = prune(w.hits, now.Add(-window))
+
+	if len(w.hits) < limit {
+		w.hits = append(w.hits, now)
+		return RateLimitDecision{Allowed: true, Remaining: limit - len(w.hits)}, nil
+	}
+
+	return RateLimitDecision{RetryAfter: w.hits[0].Add(window).Sub(now)}, nil
+}
+
+func (s *memoryRateLimitStore) sweep(now time.Time) {
+	for key, w := range s.windows {
+		if w.hits = prune(w.hits, now.Add(-w.window)); len(w.hits) == 0 {
+			delete(s.windows, key)
+		}
+	}
+	s.lastSweep = now
+}
+
+// prune drops hits at or before cutoff; hits are kept in chronological order.
+func prune(hits []time.Time, cutoff time.Time) []time.Time {
+	i := 0
+	for i < len(hits) && !hits[i].After(cutoff) {
+		i++
+	}
+	return hits[i:]
+}
+
+type fallbackRateLimitStore struct {
+	primary  RateLimitStore
+	fallback RateLimitStore
+}
+
+// NewFallbackRateLimitStore uses primary and switches to fallback for any call
+// where primary fails, so a Redis outage degrades to per-replica limits.
+func NewFallbackRateLimitStore(primary, fallback RateLimitStore) RateLimitStore {
+	return &fallbackRateLimitStore{primary: primary, fallback: fallback}
+}
+
+func (s *fallbackRateLimitStore) Allow(ctx context.Context, key string, limit int, window time.Duration) (RateLimitDecision, error) {
+	d, err := s.primary.Allow(ctx, key, limit, window)
+	if err == nil {
+		return d, nil
+	}
+
+	slog.WarnContext(ctx, "rate limit store error, using fallback", logging.Err(err))
+	rateLimitStoreErrors.Inc()
+	return s.fallback.Allow(ctx, key, limit, window)
+}
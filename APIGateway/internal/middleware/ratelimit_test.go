@@ -0,0 +1,146 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRateLimitKey(t *testing.T) {
+	engine := gin.New()
+	if err := engine.SetTrustedProxies([]string{"10.0.0.1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		userID     string
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		{name: "authenticated caller is keyed by user", userID: "u1", remoteAddr: "203.0.113.7:4000", want: "ratelimit:auth_login:user:u1"},
+		{name: "anonymous caller is keyed by IP", remoteAddr: "203.0.113.7:4000", want: "ratelimit:auth_login:ip:203.0.113.7"},
+		{name: "forwarded header from an untrusted peer is ignored", remoteAddr: "203.0.113.7:4000", forwarded: "198.51.100.1", want: "ratelimit:auth_login:ip:203.0.113.7"},
+		{name: "forwarded header from a trusted proxy is used", remoteAddr: "10.0.0.1:4000", forwarded: "198.51.100.1", want: "ratelimit:auth_login:ip:198.51.100.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := gin.CreateTestContextOnly(httptest.NewRecorder(), engine)
+			c.Request = httptest.NewRequest(http.MethodPost, "/auth/login", nil)
+			c.Request.RemoteAddr = tt.remoteAddr
+			if tt.forwarded != "" {
+				c.Request.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			if tt.userID != "" {
+				c.Set(ContextUserID, tt.userID)
+			}
+
+			if got := rateLimitKey(c, "auth_login"); got != tt.want {
+				t.Errorf("rateLimitKey = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRateLimit(t *testing.T) {
+	tests := []struct {
+		value      string
+		wantLimit  int
+		wantWindow time.Duration
+		wantErr    bool
+	}{
+		{value: "10/1m", wantLimit: 10, wantWindow: time.Minute},
+		{value: "3/90s", wantLimit: 3, wantWindow: 90 * time.Second},
+		{value: "10", wantErr: true},
+		{value: "0/1m", wantErr: true},
+		{value: "ten/1m", wantErr: true},
+		{value: "10/0s", wantErr: true},
+		{value: "10/soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			limit, window, err := parseRateLimit(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if limit != tt.wantLimit || window != tt.wantWindow {
+				t.Errorf("got %d/%s, want %d/%s", limit, window, tt.wantLimit, tt.wantWindow)
+			}
+		})
+	}
+}
+
+func TestPrune(t *testing.T) {
+	base := time.Unix(1000, 0)
+	hits := []time.Time{base, base.Add(time.Second), base.Add(2 * time.Second)}
+
+	tests := []struct {
+		name   string
+		cutoff time.Time
+		want   int
+	}{
+		{name: "nothing expired", cutoff: base.Add(-time.Second), want: 3},
+		{name: "hit at the cutoff expires", cutoff: base, want: 2},
+		{name: "between hits", cutoff: base.Add(1500 * time.Millisecond), want: 1},
+		{name: "everything expired", cutoff: base.Add(time.Minute), want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := prune(hits, tt.cutoff); len(got) != tt.want {
+				t.Errorf("kept %d hits, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryRateLimitStoreAllow(t *testing.T) {
+	const limit = 3
+	store := NewMemoryRateLimitStore()
+	ctx := context.Background()
+
+	for i := 1; i <= limit+1; i++ {
+		d, err := store.Allow(ctx, "k", limit, time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantAllowed := i <= limit
+		if d.Allowed != wantAllowed {
+			t.Fatalf("hit %d: allowed = %t, want %t", i, d.Allowed, wantAllowed)
+		}
+		if wantAllowed && d.Remaining != limit-i {
+			t.Errorf("hit %d: remaining = %d, want %d", i, d.Remaining, limit-i)
+		}
+		if !wantAllowed && (d.RetryAfter <= 0 || d.RetryAfter > time.Minute) {
+			t.Errorf("hit %d: retry after %s, want within the window", i, d.RetryAfter)
+		}
+	}
+
+	if d, _ := store.Allow(ctx, "other", limit, time.Minute); !d.Allowed {
+		t.Error("a different key shares the exhausted budget")
+	}
+}
+
+func TestMemoryRateLimitStoreWindowExpiry(t *testing.T) {
+	const window = 50 * time.Millisecond
+	store := NewMemoryRateLimitStore()
+	ctx := context.Background()
+
+	if d, _ := store.Allow(ctx, "k", 1, window); !d.Allowed {
+		t.Fatal("first hit was limited")
+	}
+	if d, _ := store.Allow(ctx, "k", 1, window); d.Allowed {
+		t.Fatal("second hit within the window was allowed")
+	}
+	time.Sleep(window + 10*time.Millisecond)
+	if d, _ := store.Allow(ctx, "k", 1, window); !d.Allowed {
+		t.Error("hit after the window passed was limited")
+	}
+}
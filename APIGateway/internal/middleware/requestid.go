@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"github.com/KaminurOrynbek/BiznesAsh/APIGateway/internal/apierror"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// ContextRequestID is the gin context key holding the request id.
+const ContextRequestID = "requestId"
+
+// RequestID reuses the caller's X-Request-ID when present, generates one otherwise,
+// and echoes it on the response so error bodies and logs can be correlated. The id
+// is also put on the request context, which logs it and forwards it to every gRPC
+// call and NATS message made with that context.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(apierror.HeaderRequestID)
+		if id == "" || len(id) > logging.MaxRequestIDLength {
+			id = logging.NewRequestID()
+		}
+		c.Set(ContextRequestID, id)
+		c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), id))
+		c.Header(apierror.HeaderRequestID, id)
+		c.Next()
+	}
+}
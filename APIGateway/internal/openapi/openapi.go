@@ -0,0 +1,219 @@
+// Package openapi builds an OpenAPI 3 document for the gateway from a route
+// table and the Go types the handlers bind and return, and checks JSON bodies
+// against it.
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const Version = "3.0.3"
+
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// PathItem maps a lower-case HTTP method to its operation.
+type PathItem map[string]*Operation
+
+type Operation struct {
+	Summary     string                `json:"summary,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Deprecated  bool                  `json:"deprecated,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]Response   `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required,omitempty"`
+	Schema   *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+}
+
+// Schema is the subset of JSON Schema the generator emits.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"`
+}
+
+// Route documents one gateway endpoint. Request and Response are zero values of
+// the types the handler binds and returns; nil means no body. Path uses gin syntax
+// (/posts/:id).
+type Route struct {
+	Method  string
+	Path    string
+	Summary string
+	Tag     string
+	Auth    bool
+	Query   []string
+	// Headers are optional request headers the operation reads.
+	Headers []string
+	Request interface{}
+	// Upload names the file field of a multipart/form-data request body; it
+	// is used instead of Request.
+	Upload   string
+	Response interface{}
+	// Status is the success status; it defaults to 200.
+	Status     int
+	Deprecated bool
+}
+
+const bearerAuth = "bearerAuth"
+
+// Build generates the document for routes. Every operation also documents the
+// shared error body as its default response.
+func Build(title, version string, routes []Route, problem interface{}) *Document {
+	g := newGenerator()
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    Info{Title: title, Version: version},
+		Paths:   map[string]PathItem{},
+		Components: Components{
+			Schemas: g.schemas,
+			SecuritySchemes: map[string]SecurityScheme{
+				bearerAuth: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+	problemSchema := g.schemaFor(reflect.TypeOf(problem))
+
+	for _, rt := range routes {
+		path, params := convertPath(rt.Path)
+		op := &Operation{
+			Summary:    rt.Summary,
+			Deprecated: rt.Deprecated,
+			Responses:  map[string]Response{},
+		}
+		if rt.Tag != "" {
+			op.Tags = []string{rt.Tag}
+		}
+		for _, p := range params {
+			op.Parameters = append(op.Parameters, Parameter{Name: p, In: "path", Required: true, Schema: &Schema{Type: "string"}})
+		}
+		for _, q := range rt.Query {
+			op.Parameters = append(op.Parameters, Parameter{Name: q, In: "query", Schema: &Schema{Type: "string"}})
+		}
+		for _, h := range rt.Headers {
+			op.Parameters = append(op.Parameters, Parameter{Name: h, In: "header", Schema: &Schema{Type: "string"}})
+		}
+		if rt.Auth {
+			op.Security = []map[string][]string{{bearerAuth: {}}}
+		}
+		if rt.Request != nil {
+			op.RequestBody = &RequestBody{
+				Required: true,
+				Content:  jsonContent(g.schemaFor(reflect.TypeOf(rt.Request))),
+			}
+		}
+		if rt.Upload != "" {
+			op.RequestBody = &RequestBody{
+				Required: true,
+				Content:  uploadContent(rt.Upload),
+			}
+		}
+
+		status := rt.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		success := Response{Description: http.StatusText(status)}
+		if rt.Response != nil {
+			success.Content = jsonContent(g.schemaFor(reflect.TypeOf(rt.Response)))
+		}
+		op.Responses[strconv.Itoa(status)] = success
+		op.Responses["default"] = Response{Description: "Error", Content: jsonContent(problemSchema)}
+
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = PathItem{}
+			doc.Paths[path] = item
+		}
+		item[strings.ToLower(rt.Method)] = op
+	}
+	return doc
+}
+
+// Operation returns the operation for a gin route, or nil if it isn't documented.
+func (d *Document) Operation(method, ginPath string) *Operation {
+	path, _ := convertPath(ginPath)
+	return d.Paths[path][strings.ToLower(method)]
+}
+
+// Resolve follows a $ref to the component schema.
+func (d *Document) Resolve(s *Schema) *Schema {
+	for s != nil && s.Ref != "" {
+		s = d.Components.Schemas[strings.TrimPrefix(s.Ref, refPrefix)]
+	}
+	return s
+}
+
+func jsonContent(s *Schema) map[string]MediaType {
+	return map[string]MediaType{"application/json": {Schema: s}}
+}
+
+func uploadContent(field string) map[string]MediaType {
+	return map[string]MediaType{"multipart/form-data": {Schema: &Schema{
+		Type:       "object",
+		Properties: map[string]*Schema{field: {Type: "string", Format: "binary"}},
+		Required:   []string{field},
+	}}}
+}
+
+// convertPath turns /posts/:id into /posts/{id} and returns the parameter names.
+func convertPath(ginPath string) (string, []string) {
+	segments := strings.Split(ginPath, "/")
+	var params []string
+	for i, seg := range segments {
+		if strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*") {
+			name := seg[1:]
+			params = append(params, name)
+			segments[i] = "{" + name + "}"
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
@@ -0,0 +1,90 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Validate checks a JSON body against s and returns one message per mismatch:
+// wrong types, missing required properties and properties the schema doesn't
+// declare.
+func (d *Document) Validate(s *Schema, body []byte) []string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return []string{fmt.Sprintf("invalid JSON: %v", err)}
+	}
+	var problems []string
+	d.validate(s, v, "$", &problems)
+	return problems
+}
+
+func (d *Document) validate(s *Schema, v interface{}, path string, problems *[]string) {
+	s = d.Resolve(s)
+	if s == nil || s.Type == "" {
+		return
+	}
+	if v == nil {
+		if !s.Nullable {
+			*problems = append(*problems, fmt.Sprintf("%s: null, want %s", path, s.Type))
+		}
+		return
+	}
+
+	switch s.Type {
+	case "object":
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			*problems = append(*problems, fmt.Sprintf("%s: %T, want object", path, v))
+			return
+		}
+		for _, name := range s.Required {
+			if _, ok := obj[name]; !ok {
+				*problems = append(*problems, fmt.Sprintf("%s: missing required property %q", path, name))
+			}
+		}
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if prop, ok := s.Properties[k]; ok {
+				d.validate(prop, obj[k], path+"."+k, problems)
+				continue
+			}
+			switch extra := s.AdditionalProperties.(type) {
+			case bool:
+				if !extra {
+					*problems = append(*problems, fmt.Sprintf("%s: undocumented property %q", path, k))
+				}
+			case *Schema:
+				d.validate(extra, obj[k], path+"."+k, problems)
+			}
+		}
+	case "array":
+		arr, ok := v.([]interface{})
+		if !ok {
+			*problems = append(*problems, fmt.Sprintf("%s: %T, want array", path, v))
+			return
+		}
+		for i, item := range arr {
+			d.validate(s.Items, item, fmt.Sprintf("%s[%d]", path, i), problems)
+		}
+	case "string":
+		if _, ok := v.(string); !ok {
+			*problems = append(*problems, fmt.Sprintf("%s: %T, want string", path, v))
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			*problems = append(*problems, fmt.Sprintf("%s: %T, want boolean", path, v))
+		}
+	case "integer", "number":
+		n, ok := v.(float64)
+		if !ok {
+			*problems = append(*problems, fmt.Sprintf("%s: %T, want %s", path, v, s.Type))
+		} else if s.Type == "integer" && n != float64(int64(n)) {
+			*problems = append(*problems, fmt.Sprintf("%s: %v, want integer", path, n))
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Package stream fans NATS events out to the gateway's /events clients. The hub
+// keeps a short backlog of recent events so a client that reconnects with its
+// last-seen cursor receives what it missed instead of polling.
+package stream
+
+import (
+	"encoding/json"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultBacklog is how many recent events a hub keeps for resuming clients.
+const DefaultBacklog = 1024
+
+// Event types sent to clients besides the ones derived from NATS.
+const (
+	// TypeReady opens a stream that did not resume; its id is the cursor to resume from.
+	TypeReady = "ready"
+	// TypeReset replaces the backlog when the client's cursor is unknown or too
+	// old: events were missed, so the client should refetch and continue from its id.
+	TypeReset = "reset"
+)
+
+var (
+	openStreams = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "gateway_event_streams",
+		Help: "Event streams currently open on the gateway.",
+	})
+	droppedStreams = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "gateway_event_streams_dropped_total",
+		Help: "Event streams closed because the client fell too far behind.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(openStreams, droppedStreams)
+}
+
+// Event is one message on a stream. ID is the cursor a client sends back as
+// Last-Event-ID to resume after it.
+type Event struct {
+	ID   string
+	Type string
+	Data json.RawMessage
+
+	seq    uint64
+	userID string // recipient; empty when every client may see the event
+}
+
+// Hub assigns cursors to events, remembers the most recent ones and delivers
+// them to subscribers. Cursors are only meaningful to the hub that issued
+// them: after a restart, or on another gateway replica, a client gets a reset.
+type Hub struct {
+	epoch string
+	size  int
+
+	mu      sync.Mutex
+	seq     uint64
+	backlog []Event
+	subs    map[*Subscription]struct{}
+}
+
+// NewHub returns a hub that keeps the last size events.
+func NewHub(size int) *Hub {
+	return &Hub{
+		epoch: strconv.FormatInt(time.Now().UnixNano(), 36),
+		size:  size,
+		subs:  map[*Subscription]struct{}{},
+	}
+}
+
+// Subscription receives the events visible to one user.
+type Subscription struct {
+	hub    *Hub
+	userID string
+	ch     chan Event
+}
+
+// subscriptionBuffer bounds how far a client may lag before it is dropped.
+const subscriptionBuffer = 64
+
+// Events is closed when the hub drops a subscriber that fell behind.
+func (s *Subscription) Events() <-chan Event {
+	return s.ch
+}
+
+// Close unregisters the subscription.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	if _, ok := s.hub.subs[s]; ok {
+		delete(s.hub.subs, s)
+		close(s.ch)
+		openStreams.Dec()
+	}
+}
+
+// Subscribe registers userID and returns what to send before live events: the
+// missed events after cursor, or a single ready or reset event when there is
+// nothing to resume from.
+func (h *Hub) Subscribe(userID, cursor string) (*Subscription, []Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub := &Subscription{hub: h, userID: userID, ch: make(chan Event, subscriptionBuffer)}
+	h.subs[sub] = struct{}{}
+	openStreams.Inc()
+
+	head := h.cursor(h.seq)
+	if cursor == "" {
+		return sub, []Event{{ID: head, Type: TypeReady, Data: json.RawMessage("{}")}}
+	}
+	seq, ok := h.parseCursor(cursor)
+	if !ok || !h.retained(seq) {
+		return sub, []Event{{ID: head, Type: TypeReset, Data: json.RawMessage("{}")}}
+	}
+
+	var missed []Event
+	for _, e := range h.backlog {
+		if e.seq > seq && sub.sees(e) {
+			missed = append(missed, e)
+		}
+	}
+	return sub, missed
+}
+
+// Publish records an event for userID, or for everyone when userID is empty,
+// and delivers it to the matching subscribers.
+func (h *Hub) Publish(typ, userID string, data interface{}) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		slog.Error("stream: failed to marshal event", "type", typ, logging.Err(err))
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.seq++
+	e := Event{ID: h.cursor(h.seq), Type: typ, Data: raw, seq: h.seq, userID: userID}
+	h.backlog = append(h.backlog, e)
+	if len(h.backlog) > h.size {
+		h.backlog = h.backlog[len(h.backlog)-h.size:]
+	}
+
+	for sub := range h.subs {
+		if !sub.sees(e) {
+			continue
+		}
+		select {
+		case sub.ch <- e:
+		default:
+			// The client resumes from its last cursor when it reconnects.
+			delete(h.subs, sub)
+			close(sub.ch)
+			openStreams.Dec()
+			droppedStreams.Inc()
+		}
+	}
+}
+
+func (s *Subscription) sees(e Event) bool {
+	return e.userID == "" || e.userID == s.userID
+}
+
+// retained reports whether every event after seq is still in the backlog.
+func (h *Hub) retained(seq uint64) bool {
+	if seq > h.seq {
+		return false
+	}
+	if len(h.backlog) == 0 {
+		return seq == h.seq
+	}
+	return seq+1 >= h.backlog[0].seq
+}
+
+func (h *Hub) cursor(seq uint64) string {
+	return h.epoch + "-" + strconv.FormatUint(seq, 10)
+}
+
+func (h *Hub) parseCursor(cursor string) (uint64, bool) {
+	epoch, seq, ok := strings.Cut(cursor, "-")
+	if !ok || epoch != h.epoch {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(seq, 10, 64)
+	return n, err == nil
+}
@@ -0,0 +1,154 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	notificationpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/notification"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
+)
+
+// Event types derived from NATS subjects.
+const (
+	// TypeNotification is private to its recipient; Data has the shape of the
+	// items returned by GET /notifications.
+	TypeNotification = "notification"
+	TypePostLikes    = "post.likes"
+	TypePostComment  = "post.comment"
+	TypePollTally    = "poll.tally"
+)
+
+// PostLikes is the data of a post.likes event.
+type PostLikes struct {
+	PostID     string `json:"postId"`
+	LikesCount int32  `json:"likesCount"`
+}
+
+// PostComment is the data of a post.comment event.
+type PostComment struct {
+	PostID    string `json:"postId"`
+	CommentID string `json:"commentId"`
+}
+
+// PollTally is the data of a poll.tally event.
+type PollTally struct {
+	PostID     string        `json:"postId"`
+	PollID     string        `json:"pollId"`
+	Options    []OptionTally `json:"options"`
+	TotalVotes int32         `json:"totalVotes"`
+}
+
+type OptionTally struct {
+	OptionID   string `json:"optionId"`
+	VotesCount int32  `json:"votesCount"`
+}
+
+// Payloads published by NotificationService and ContentService.
+type (
+	notificationCreated struct {
+		ID            string `json:"id"`
+		UserID        string `json:"user_id"`
+		Type          string `json:"type"`
+		Message       string `json:"message"`
+		ActorID       string `json:"actor_id"`
+		ActorUsername string `json:"actor_username"`
+		PostID        string `json:"post_id"`
+		CommentID     string `json:"comment_id"`
+		CreatedAt     string `json:"created_at"`
+	}
+	likesChanged struct {
+		PostID     string `json:"post_id"`
+		LikesCount int32  `json:"likes_count"`
+	}
+	commentCreated struct {
+		CommentID string `json:"comment_id"`
+		PostID    string `json:"post_id"`
+	}
+	pollVoted struct {
+		PostID  string `json:"post_id"`
+		PollID  string `json:"poll_id"`
+		Options []struct {
+			OptionID   string `json:"option_id"`
+			VotesCount int32  `json:"votes_count"`
+		} `json:"options"`
+		TotalVotes int32 `json:"total_votes"`
+	}
+)
+
+// Consume subscribes the hub to the subjects it turns into events. Every
+// gateway replica consumes every message, since its clients are its own.
+func (h *Hub) Consume(q queue.MessageQueue) error {
+	handlers := map[string]func(data []byte) error{
+		"notification.created": h.onNotificationCreated,
+		"post.liked":           h.onLikesChanged,
+		"post.unliked":         h.onLikesChanged,
+		"comment.created":      h.onCommentCreated,
+		"poll.voted":           h.onPollVoted,
+	}
+	for subject, handle := range handlers {
+		err := tracing.Subscribe(q, subject, func(ctx context.Context, data []byte) {
+			if err := handle(data); err != nil {
+				slog.ErrorContext(ctx, "stream: failed to handle event", "subject", subject, logging.Err(err))
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *Hub) onNotificationCreated(data []byte) error {
+	var p notificationCreated
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	h.Publish(TypeNotification, p.UserID, &notificationpb.Notification{
+		Id:        p.ID,
+		UserId:    p.UserID,
+		Type:      p.Type,
+		Message:   p.Message,
+		CreatedAt: p.CreatedAt,
+		PostId:    p.PostID,
+		CommentId: p.CommentID,
+		Data: map[string]string{
+			"actor_id":       p.ActorID,
+			"actor_username": p.ActorUsername,
+		},
+	})
+	return nil
+}
+
+func (h *Hub) onLikesChanged(data []byte) error {
+	var p likesChanged
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	h.Publish(TypePostLikes, "", PostLikes{PostID: p.PostID, LikesCount: p.LikesCount})
+	return nil
+}
+
+func (h *Hub) onCommentCreated(data []byte) error {
+	var p commentCreated
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	h.Publish(TypePostComment, "", PostComment{PostID: p.PostID, CommentID: p.CommentID})
+	return nil
+}
+
+func (h *Hub) onPollVoted(data []byte) error {
+	var p pollVoted
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	tally := PollTally{PostID: p.PostID, PollID: p.PollID, Options: make([]OptionTally, 0, len(p.Options)), TotalVotes: p.TotalVotes}
+	for _, o := range p.Options {
+		tally.Options = append(tally.Options, OptionTally{OptionID: o.OptionID, VotesCount: o.VotesCount})
+	}
+	h.Publish(TypePollTally, "", tally)
+	return nil
+}
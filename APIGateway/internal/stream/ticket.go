@@ -0,0 +1,113 @@
+package stream
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// TicketTTL is how long a ticket may wait before it opens a stream.
+const TicketTTL = 30 * time.Second
+
+const ticketKeyPrefix = "events:ticket:"
+
+// ErrInvalidTicket is returned for tickets that are unknown, expired or
+// already used.
+var ErrInvalidTicket = errors.New("invalid or expired event stream ticket")
+
+// TicketStore hands out the single-use tickets EventSource clients open
+// /events with, since they can't send an Authorization header. A ticket
+// travels in the URL, so it is short-lived and works once.
+type TicketStore interface {
+	// Issue returns a new ticket for userID, valid for ttl.
+	Issue(ctx context.Context, userID string, ttl time.Duration) (string, error)
+	// Redeem returns the user a ticket was issued to and invalidates it.
+	Redeem(ctx context.Context, ticket string) (string, error)
+}
+
+func newTicket() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+type redisTicketStore struct {
+	client *redis.Client
+}
+
+// NewRedisTicketStore returns a store shared by every gateway replica, so a
+// ticket issued by one opens a stream on another.
+func NewRedisTicketStore(client *redis.Client) TicketStore {
+	return &redisTicketStore{client: client}
+}
+
+func (s *redisTicketStore) Issue(ctx context.Context, userID string, ttl time.Duration) (string, error) {
+	ticket, err := newTicket()
+	if err != nil {
+		return "", err
+	}
+	if err := s.client.Set(ctx, ticketKeyPrefix+ticket, userID, ttl).Err(); err != nil {
+		return "", err
+	}
+	return ticket, nil
+}
+
+func (s *redisTicketStore) Redeem(ctx context.Context, ticket string) (string, error) {
+	userID, err := s.client.GetDel(ctx, ticketKeyPrefix+ticket).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ErrInvalidTicket
+	}
+	return userID, err
+}
+
+type memoryTicket struct {
+	userID  string
+	expires time.Time
+}
+
+type memoryTicketStore struct {
+	mu      sync.Mutex
+	tickets map[string]memoryTicket
+}
+
+// NewMemoryTicketStore returns a process-local store for local runs without
+// Redis.
+func NewMemoryTicketStore() TicketStore {
+	return &memoryTicketStore{tickets: make(map[string]memoryTicket)}
+}
+
+func (s *memoryTicketStore) Issue(_ context.Context, userID string, ttl time.Duration) (string, error) {
+	ticket, err := newTicket()
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for k, t := range s.tickets {
+		if now.After(t.expires) {
+			delete(s.tickets, k)
+		}
+	}
+	s.tickets[ticket] = memoryTicket{userID: userID, expires: now.Add(ttl)}
+	return ticket, nil
+}
+
+func (s *memoryTicketStore) Redeem(_ context.Context, ticket string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tickets[ticket]
+	delete(s.tickets, ticket)
+	if !ok || time.Now().After(t.expires) {
+		return "", ErrInvalidTicket
+	}
+	return t.userID, nil
+}
@@ -0,0 +1,77 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMemoryTicketStore(t *testing.T) {
+	tests := []struct {
+		name string
+		ttl  time.Duration
+		// wait passes before the ticket is redeemed.
+		wait    time.Duration
+		redeems int
+		want    []error
+	}{
+		{name: "ticket works once", ttl: time.Minute, redeems: 2, want: []error{nil, ErrInvalidTicket}},
+		{name: "expired ticket", ttl: 10 * time.Millisecond, wait: 20 * time.Millisecond, redeems: 1, want: []error{ErrInvalidTicket}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMemoryTicketStore()
+			ctx := context.Background()
+			ticket, err := s.Issue(ctx, "u1", tt.ttl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			time.Sleep(tt.wait)
+
+			for i := 0; i < tt.redeems; i++ {
+				userID, err := s.Redeem(ctx, ticket)
+				if !errors.Is(err, tt.want[i]) {
+					t.Fatalf("redeem %d: err = %v, want %v", i, err, tt.want[i])
+				}
+				if err == nil && userID != "u1" {
+					t.Errorf("redeem %d: user %q, want u1", i, userID)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryTicketStoreUnknownTicket(t *testing.T) {
+	s := NewMemoryTicketStore()
+	ctx := context.Background()
+	issued, err := s.Issue(ctx, "u1", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ticket := range []string{"", "guess", issued + "x"} {
+		if _, err := s.Redeem(ctx, ticket); !errors.Is(err, ErrInvalidTicket) {
+			t.Errorf("Redeem(%q): err = %v, want ErrInvalidTicket", ticket, err)
+		}
+	}
+	if _, err := s.Redeem(ctx, issued); err != nil {
+		t.Errorf("failed guesses invalidated the issued ticket: %v", err)
+	}
+}
+
+func TestMemoryTicketStoreTicketsAreDistinct(t *testing.T) {
+	s := NewMemoryTicketStore()
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		ticket, err := s.Issue(context.Background(), "u1", time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen[ticket] {
+			t.Fatalf("ticket %q issued twice", ticket)
+		}
+		seen[ticket] = true
+	}
+}
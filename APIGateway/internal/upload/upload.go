@@ -0,0 +1,185 @@
+// Package upload validates media uploads: it decides what a file is from its
+// bytes rather than from the client's name or header, enforces size limits per
+// kind and renders image thumbnails.
+package upload
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"os"
+	"strconv"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// Kind is what an upload is used as.
+type Kind string
+
+const (
+	KindImage Kind = "image"
+	KindFile  Kind = "file"
+)
+
+// sniffLen is how much of an upload http.DetectContentType looks at.
+const sniffLen = 512
+
+// Content types accepted for each kind, as detected from the bytes.
+var allowed = map[string]Kind{
+	"image/jpeg":      KindImage,
+	"image/png":       KindImage,
+	"image/gif":       KindImage,
+	"image/webp":      KindImage,
+	"application/pdf": KindFile,
+	// Office Open XML documents are zip archives to the sniffer
+	"application/zip": KindFile,
+	"text/plain":      KindFile,
+}
+
+var (
+	ErrUnsupportedType = errors.New("unsupported file type")
+	ErrTooLarge        = errors.New("file too large")
+	ErrInvalidImage    = errors.New("image could not be decoded")
+)
+
+// Limits caps upload sizes in bytes.
+type Limits struct {
+	Image int64
+	File  int64
+	// Pixels caps width*height so a small, highly compressed image can't
+	// expand into gigabytes when decoded.
+	Pixels int64
+}
+
+// DefaultLimits are used unless overridden by LoadLimits.
+var DefaultLimits = Limits{
+	Image:  10 << 20,
+	File:   25 << 20,
+	Pixels: 40_000_000,
+}
+
+// LoadLimits applies MEDIA_MAX_IMAGE_BYTES and MEDIA_MAX_FILE_BYTES from the
+// environment to l.
+func LoadLimits(l Limits) Limits {
+	l.Image = getBytes("MEDIA_MAX_IMAGE_BYTES", l.Image)
+	l.File = getBytes("MEDIA_MAX_FILE_BYTES", l.File)
+	return l
+}
+
+// Max is the largest upload of any kind.
+func (l Limits) Max() int64 {
+	return max(l.Image, l.File)
+}
+
+func (l Limits) forKind(k Kind) int64 {
+	if k == KindImage {
+		return l.Image
+	}
+	return l.File
+}
+
+// Detected is the outcome of Inspect.
+type Detected struct {
+	Kind        Kind
+	ContentType string
+}
+
+// Inspect sniffs the content type of an upload of size bytes, checks it
+// against the allowlist and the kind's size limit, and rewinds r.
+func Inspect(r io.ReadSeeker, size int64, limits Limits) (Detected, error) {
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return Detected{}, err
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return Detected{}, err
+	}
+
+	contentType := http.DetectContentType(head[:n])
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return Detected{}, ErrUnsupportedType
+	}
+	kind, ok := allowed[mediaType]
+	if !ok {
+		return Detected{}, fmt.Errorf("%w: %s", ErrUnsupportedType, mediaType)
+	}
+	if size > limits.forKind(kind) {
+		return Detected{}, fmt.Errorf("%w: %s uploads are limited to %d bytes", ErrTooLarge, kind, limits.forKind(kind))
+	}
+
+	if charset := params["charset"]; charset != "" {
+		mediaType = mime.FormatMediaType(mediaType, map[string]string{"charset": charset})
+	}
+	return Detected{Kind: kind, ContentType: mediaType}, nil
+}
+
+// ThumbnailSize bounds the longer edge of a thumbnail in pixels.
+const ThumbnailSize = 320
+
+// Thumbnail decodes an image and returns a JPEG no larger than ThumbnailSize
+// on either edge. Images already that small are re-encoded at their own size,
+// which also strips metadata such as EXIF location.
+func Thumbnail(r io.ReadSeeker, limits Limits) ([]byte, error) {
+	cfg, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > limits.Pixels {
+		return nil, fmt.Errorf("%w: images are limited to %d pixels", ErrTooLarge, limits.Pixels)
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	src, _, err := image.Decode(r)
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > ThumbnailSize || h > ThumbnailSize {
+		if w >= h {
+			w, h = ThumbnailSize, max(h*ThumbnailSize/w, 1)
+		} else {
+			w, h = max(w*ThumbnailSize/h, 1), ThumbnailSize
+		}
+	}
+
+	// JPEG has no alpha, so transparent areas are flattened onto white
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Over, nil)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func getBytes(key string, fallback int64) int64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n <= 0 {
+		slog.Warn("ignoring invalid setting, expected a positive number of bytes", "env", key, "value", value)
+		return fallback
+	}
+	return n
+}
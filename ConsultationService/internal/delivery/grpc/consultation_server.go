@@ -6,6 +6,9 @@ import (
 
 	"github.com/KaminurOrynbek/BiznesAsh/ConsultationService/internal/usecase"
 	pb "github.com/KaminurOrynbek/BiznesAsh/ConsultationService/proto"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ConsultationServer struct {
@@ -20,7 +23,7 @@ func NewConsultationServer(u *usecase.ConsultationUsecase) *ConsultationServer {
 func (s *ConsultationServer) RegisterExpert(ctx context.Context, req *pb.ExpertData) (*pb.ExpertProfile, error) {
 	expert, err := s.usecase.RegisterExpert(ctx, req.GetUserId(), req.GetSpecialization(), req.GetPricePerSession())
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to register expert")
 	}
 	return &pb.ExpertProfile{
 		Id:              expert.ID,
@@ -34,7 +37,7 @@ func (s *ConsultationServer) RegisterExpert(ctx context.Context, req *pb.ExpertD
 func (s *ConsultationServer) ListAvailableExperts(ctx context.Context, req *pb.Filter) (*pb.ExpertList, error) {
 	experts, err := s.usecase.ListExperts(ctx)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to list experts")
 	}
 
 	var resp pb.ExpertList
@@ -53,12 +56,12 @@ func (s *ConsultationServer) ListAvailableExperts(ctx context.Context, req *pb.F
 func (s *ConsultationServer) CreateBooking(ctx context.Context, req *pb.BookingData) (*pb.BookingResponse, error) {
 	scheduledAt, err := time.Parse(time.RFC3339, req.GetScheduledAt())
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "scheduledAt must be RFC3339: %v", err)
 	}
 
 	booking, err := s.usecase.CreateBooking(ctx, req.GetUserId(), req.GetExpertId(), req.GetExpertName(), scheduledAt)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to create booking")
 	}
 
 	return &pb.BookingResponse{
@@ -71,7 +74,7 @@ func (s *ConsultationServer) CreateBooking(ctx context.Context, req *pb.BookingD
 func (s *ConsultationServer) ConfirmBookingPayment(ctx context.Context, req *pb.ConfirmPaymentRequest) (*pb.Empty, error) {
 	err := s.usecase.ConfirmPayment(ctx, req.GetBookingId())
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to confirm booking payment")
 	}
 	return &pb.Empty{}, nil
 }
@@ -79,7 +82,7 @@ func (s *ConsultationServer) ConfirmBookingPayment(ctx context.Context, req *pb.
 func (s *ConsultationServer) CancelBooking(ctx context.Context, req *pb.CancelBookingRequest) (*pb.BookingResponse, error) {
 	booking, err := s.usecase.CancelBooking(ctx, req.GetBookingId())
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to cancel booking")
 	}
 	return &pb.BookingResponse{
 		Id:          booking.ID,
@@ -91,7 +94,7 @@ func (s *ConsultationServer) CancelBooking(ctx context.Context, req *pb.CancelBo
 func (s *ConsultationServer) GetUserBookings(ctx context.Context, req *pb.GetUserBookingsRequest) (*pb.BookingList, error) {
 	bookings, err := s.usecase.GetUserBookings(ctx, req.GetUserId())
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to get user bookings")
 	}
 
 	var resp pb.BookingList
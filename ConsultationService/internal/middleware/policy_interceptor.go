@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/KaminurOrynbek/BiznesAsh/ConsultationService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/auth"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"google.golang.org/grpc"
+)
+
+type BookingGetter interface {
+	GetBookingByID(ctx context.Context, id string) (*entity.ConsultationBooking, error)
+}
+
+// PolicyInterceptor enforces the shared role policy on the caller
+// authenticated by auth.UnaryServerInterceptor.
+func PolicyInterceptor(bookings BookingGetter) grpc.UnaryServerInterceptor {
+	p := policy.Default()
+
+	p.RegisterOwner(policy.OwnerBooking, func(ctx context.Context, id policy.Identity, req interface{}) (bool, error) {
+		r, ok := req.(interface{ GetBookingId() string })
+		if !ok {
+			return false, nil
+		}
+		booking, err := bookings.GetBookingByID(ctx, r.GetBookingId())
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, policy.ErrNotFound
+		}
+		if err != nil {
+			return false, err
+		}
+		return booking.UserID == id.UserID, nil
+	})
+
+	return policy.UnaryServerInterceptor(p, auth.Identity)
+}
@@ -22,7 +22,7 @@ func (d *ConsultationDAO) CreateExpert(ctx context.Context, expert *entity.Exper
 	`
 	_, err := d.db.NamedExecContext(ctx, query, expert)
 	if err != nil {
-		return fmt.Errorf("failed to create expert profile: %v", err)
+		return fmt.Errorf("failed to create expert profile: %w", err)
 	}
 	return nil
 }
@@ -44,7 +44,7 @@ func (d *ConsultationDAO) CreateBooking(ctx context.Context, booking *entity.Con
 	`
 	_, err := d.db.NamedExecContext(ctx, query, booking)
 	if err != nil {
-		return fmt.Errorf("failed to create booking: %v", err)
+		return fmt.Errorf("failed to create booking: %w", err)
 	}
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/KaminurOrynbek/BiznesAsh/ConsultationService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
 	"github.com/google/uuid"
 )
 
@@ -56,7 +57,7 @@ func (u *ConsultationUsecase) CreateBooking(ctx context.Context, userID, expertI
 		return nil, err
 	}
 	if hasActive {
-		return nil, fmt.Errorf("user already has an active booking with this expert")
+		return nil, fmt.Errorf("user already has an active booking with this expert: %w", grpcerr.ErrAlreadyExists)
 	}
 
 	booking := &entity.ConsultationBooking{
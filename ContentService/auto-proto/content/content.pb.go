@@ -68,6 +68,52 @@ func (PostType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_content_proto_rawDescGZIP(), []int{0}
 }
 
+type MediaKind int32
+
+const (
+	MediaKind_MEDIA_IMAGE MediaKind = 0
+	MediaKind_MEDIA_FILE  MediaKind = 1
+)
+
+// Enum value maps for MediaKind.
+var (
+	MediaKind_name = map[int32]string{
+		0: "MEDIA_IMAGE",
+		1: "MEDIA_FILE",
+	}
+	MediaKind_value = map[string]int32{
+		"MEDIA_IMAGE": 0,
+		"MEDIA_FILE":  1,
+	}
+)
+
+func (x MediaKind) Enum() *MediaKind {
+	p := new(MediaKind)
+	*p = x
+	return p
+}
+
+func (x MediaKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MediaKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_content_proto_enumTypes[1].Descriptor()
+}
+
+func (MediaKind) Type() protoreflect.EnumType {
+	return &file_proto_content_proto_enumTypes[1]
+}
+
+func (x MediaKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MediaKind.Descriptor instead.
+func (MediaKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{1}
+}
+
 type Post struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -86,6 +132,7 @@ type Post struct {
 	Images        []string               `protobuf:"bytes,14,rep,name=images,proto3" json:"images,omitempty"`
 	Poll          *Poll                  `protobuf:"bytes,15,opt,name=poll,proto3" json:"poll,omitempty"`
 	Files         []string               `protobuf:"bytes,16,rep,name=files,proto3" json:"files,omitempty"`
+	Media         []*Media               `protobuf:"bytes,17,rep,name=media,proto3" json:"media,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -232,6 +279,303 @@ func (x *Post) GetFiles() []string {
 	return nil
 }
 
+func (x *Post) GetMedia() []*Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+// Media is an uploaded blob. The bytes live in the gateway's blob store; the
+// content service only records ownership and which post uses it.
+type Media struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Kind          MediaKind              `protobuf:"varint,3,opt,name=kind,proto3,enum=content.MediaKind" json:"kind,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Filename      string                 `protobuf:"bytes,6,opt,name=filename,proto3" json:"filename,omitempty"`
+	HasThumbnail  bool                   `protobuf:"varint,7,opt,name=has_thumbnail,json=hasThumbnail,proto3" json:"has_thumbnail,omitempty"`
+	PostId        string                 `protobuf:"bytes,8,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Media) Reset() {
+	*x = Media{}
+	mi := &file_proto_content_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Media) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media) ProtoMessage() {}
+
+func (x *Media) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media.ProtoReflect.Descriptor instead.
+func (*Media) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Media) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Media) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Media) GetKind() MediaKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MediaKind_MEDIA_IMAGE
+}
+
+func (x *Media) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Media) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Media) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Media) GetHasThumbnail() bool {
+	if x != nil {
+		return x.HasThumbnail
+	}
+	return false
+}
+
+func (x *Media) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Media) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateMediaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Kind          MediaKind              `protobuf:"varint,3,opt,name=kind,proto3,enum=content.MediaKind" json:"kind,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Filename      string                 `protobuf:"bytes,6,opt,name=filename,proto3" json:"filename,omitempty"`
+	HasThumbnail  bool                   `protobuf:"varint,7,opt,name=has_thumbnail,json=hasThumbnail,proto3" json:"has_thumbnail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMediaRequest) Reset() {
+	*x = CreateMediaRequest{}
+	mi := &file_proto_content_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMediaRequest) ProtoMessage() {}
+
+func (x *CreateMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMediaRequest.ProtoReflect.Descriptor instead.
+func (*CreateMediaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateMediaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateMediaRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateMediaRequest) GetKind() MediaKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MediaKind_MEDIA_IMAGE
+}
+
+func (x *CreateMediaRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreateMediaRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CreateMediaRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *CreateMediaRequest) GetHasThumbnail() bool {
+	if x != nil {
+		return x.HasThumbnail
+	}
+	return false
+}
+
+type MediaIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaIdRequest) Reset() {
+	*x = MediaIdRequest{}
+	mi := &file_proto_content_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaIdRequest) ProtoMessage() {}
+
+func (x *MediaIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaIdRequest.ProtoReflect.Descriptor instead.
+func (*MediaIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MediaIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type MediaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Media         *Media                 `protobuf:"bytes,1,opt,name=media,proto3" json:"media,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaResponse) Reset() {
+	*x = MediaResponse{}
+	mi := &file_proto_content_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaResponse) ProtoMessage() {}
+
+func (x *MediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaResponse.ProtoReflect.Descriptor instead.
+func (*MediaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MediaResponse) GetMedia() *Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
 type Poll struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -247,7 +591,7 @@ type Poll struct {
 
 func (x *Poll) Reset() {
 	*x = Poll{}
-	mi := &file_proto_content_proto_msgTypes[1]
+	mi := &file_proto_content_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -259,7 +603,7 @@ func (x *Poll) String() string {
 func (*Poll) ProtoMessage() {}
 
 func (x *Poll) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[1]
+	mi := &file_proto_content_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -272,7 +616,7 @@ func (x *Poll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Poll.ProtoReflect.Descriptor instead.
 func (*Poll) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{1}
+	return file_proto_content_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Poll) GetId() string {
@@ -335,7 +679,7 @@ type PollOption struct {
 
 func (x *PollOption) Reset() {
 	*x = PollOption{}
-	mi := &file_proto_content_proto_msgTypes[2]
+	mi := &file_proto_content_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +691,7 @@ func (x *PollOption) String() string {
 func (*PollOption) ProtoMessage() {}
 
 func (x *PollOption) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[2]
+	mi := &file_proto_content_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +704,7 @@ func (x *PollOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PollOption.ProtoReflect.Descriptor instead.
 func (*PollOption) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{2}
+	return file_proto_content_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PollOption) GetId() string {
@@ -395,7 +739,7 @@ type PollCreate struct {
 
 func (x *PollCreate) Reset() {
 	*x = PollCreate{}
-	mi := &file_proto_content_proto_msgTypes[3]
+	mi := &file_proto_content_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -407,7 +751,7 @@ func (x *PollCreate) String() string {
 func (*PollCreate) ProtoMessage() {}
 
 func (x *PollCreate) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[3]
+	mi := &file_proto_content_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +764,7 @@ func (x *PollCreate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PollCreate.ProtoReflect.Descriptor instead.
 func (*PollCreate) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{3}
+	return file_proto_content_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PollCreate) GetQuestion() string {
@@ -455,7 +799,7 @@ type VotePollRequest struct {
 
 func (x *VotePollRequest) Reset() {
 	*x = VotePollRequest{}
-	mi := &file_proto_content_proto_msgTypes[4]
+	mi := &file_proto_content_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -467,7 +811,7 @@ func (x *VotePollRequest) String() string {
 func (*VotePollRequest) ProtoMessage() {}
 
 func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[4]
+	mi := &file_proto_content_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,7 +824,7 @@ func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
 func (*VotePollRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{4}
+	return file_proto_content_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *VotePollRequest) GetPostId() string {
@@ -513,7 +857,7 @@ type VotePollResponse struct {
 
 func (x *VotePollResponse) Reset() {
 	*x = VotePollResponse{}
-	mi := &file_proto_content_proto_msgTypes[5]
+	mi := &file_proto_content_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -525,7 +869,7 @@ func (x *VotePollResponse) String() string {
 func (*VotePollResponse) ProtoMessage() {}
 
 func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[5]
+	mi := &file_proto_content_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,7 +882,7 @@ func (x *VotePollResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VotePollResponse.ProtoReflect.Descriptor instead.
 func (*VotePollResponse) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{5}
+	return file_proto_content_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *VotePollResponse) GetPoll() *Poll {
@@ -564,7 +908,7 @@ type Comment struct {
 
 func (x *Comment) Reset() {
 	*x = Comment{}
-	mi := &file_proto_content_proto_msgTypes[6]
+	mi := &file_proto_content_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -576,7 +920,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[6]
+	mi := &file_proto_content_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,7 +933,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{6}
+	return file_proto_content_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Comment) GetId() string {
@@ -649,23 +993,25 @@ func (x *Comment) GetLikesCount() int32 {
 }
 
 type CreatePostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Type          PostType               `protobuf:"varint,4,opt,name=type,proto3,enum=content.PostType" json:"type,omitempty"`
-	AuthorId      string                 `protobuf:"bytes,5,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	Published     bool                   `protobuf:"varint,6,opt,name=published,proto3" json:"published,omitempty"`
-	Images        []string               `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
-	Poll          *PollCreate            `protobuf:"bytes,8,opt,name=poll,proto3" json:"poll,omitempty"`
-	Files         []string               `protobuf:"bytes,9,rep,name=files,proto3" json:"files,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content   string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Type      PostType               `protobuf:"varint,4,opt,name=type,proto3,enum=content.PostType" json:"type,omitempty"`
+	AuthorId  string                 `protobuf:"bytes,5,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Published bool                   `protobuf:"varint,6,opt,name=published,proto3" json:"published,omitempty"`
+	Images    []string               `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
+	Poll      *PollCreate            `protobuf:"bytes,8,opt,name=poll,proto3" json:"poll,omitempty"`
+	Files     []string               `protobuf:"bytes,9,rep,name=files,proto3" json:"files,omitempty"`
+	// media_ids attach previously uploaded media owned by the author.
+	MediaIds      []string `protobuf:"bytes,10,rep,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePostRequest) Reset() {
 	*x = CreatePostRequest{}
-	mi := &file_proto_content_proto_msgTypes[7]
+	mi := &file_proto_content_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -677,7 +1023,7 @@ func (x *CreatePostRequest) String() string {
 func (*CreatePostRequest) ProtoMessage() {}
 
 func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[7]
+	mi := &file_proto_content_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,7 +1036,7 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
 func (*CreatePostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{7}
+	return file_proto_content_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreatePostRequest) GetId() string {
@@ -756,6 +1102,13 @@ func (x *CreatePostRequest) GetFiles() []string {
 	return nil
 }
 
+func (x *CreatePostRequest) GetMediaIds() []string {
+	if x != nil {
+		return x.MediaIds
+	}
+	return nil
+}
+
 type UpdatePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -768,7 +1121,7 @@ type UpdatePostRequest struct {
 
 func (x *UpdatePostRequest) Reset() {
 	*x = UpdatePostRequest{}
-	mi := &file_proto_content_proto_msgTypes[8]
+	mi := &file_proto_content_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -780,7 +1133,7 @@ func (x *UpdatePostRequest) String() string {
 func (*UpdatePostRequest) ProtoMessage() {}
 
 func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[8]
+	mi := &file_proto_content_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,7 +1146,7 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{8}
+	return file_proto_content_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdatePostRequest) GetId() string {
@@ -834,7 +1187,7 @@ type PostIdRequest struct {
 
 func (x *PostIdRequest) Reset() {
 	*x = PostIdRequest{}
-	mi := &file_proto_content_proto_msgTypes[9]
+	mi := &file_proto_content_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -846,7 +1199,7 @@ func (x *PostIdRequest) String() string {
 func (*PostIdRequest) ProtoMessage() {}
 
 func (x *PostIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[9]
+	mi := &file_proto_content_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,7 +1212,7 @@ func (x *PostIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostIdRequest.ProtoReflect.Descriptor instead.
 func (*PostIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{9}
+	return file_proto_content_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PostIdRequest) GetId() string {
@@ -888,7 +1241,7 @@ type ListPostsRequest struct {
 
 func (x *ListPostsRequest) Reset() {
 	*x = ListPostsRequest{}
-	mi := &file_proto_content_proto_msgTypes[10]
+	mi := &file_proto_content_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -900,7 +1253,7 @@ func (x *ListPostsRequest) String() string {
 func (*ListPostsRequest) ProtoMessage() {}
 
 func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[10]
+	mi := &file_proto_content_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,7 +1266,7 @@ func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPostsRequest.ProtoReflect.Descriptor instead.
 func (*ListPostsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{10}
+	return file_proto_content_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListPostsRequest) GetType() PostType {
@@ -956,7 +1309,7 @@ type SearchPostsRequest struct {
 
 func (x *SearchPostsRequest) Reset() {
 	*x = SearchPostsRequest{}
-	mi := &file_proto_content_proto_msgTypes[11]
+	mi := &file_proto_content_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -968,7 +1321,7 @@ func (x *SearchPostsRequest) String() string {
 func (*SearchPostsRequest) ProtoMessage() {}
 
 func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[11]
+	mi := &file_proto_content_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1334,7 @@ func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
 func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{11}
+	return file_proto_content_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SearchPostsRequest) GetQuery() string {
@@ -1021,7 +1374,7 @@ type PostResponse struct {
 
 func (x *PostResponse) Reset() {
 	*x = PostResponse{}
-	mi := &file_proto_content_proto_msgTypes[12]
+	mi := &file_proto_content_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1033,7 +1386,7 @@ func (x *PostResponse) String() string {
 func (*PostResponse) ProtoMessage() {}
 
 func (x *PostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[12]
+	mi := &file_proto_content_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,7 +1399,7 @@ func (x *PostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostResponse.ProtoReflect.Descriptor instead.
 func (*PostResponse) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{12}
+	return file_proto_content_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PostResponse) GetPost() *Post {
@@ -1065,7 +1418,7 @@ type ListPostsResponse struct {
 
 func (x *ListPostsResponse) Reset() {
 	*x = ListPostsResponse{}
-	mi := &file_proto_content_proto_msgTypes[13]
+	mi := &file_proto_content_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1077,7 +1430,7 @@ func (x *ListPostsResponse) String() string {
 func (*ListPostsResponse) ProtoMessage() {}
 
 func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[13]
+	mi := &file_proto_content_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,7 +1443,7 @@ func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPostsResponse.ProtoReflect.Descriptor instead.
 func (*ListPostsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{13}
+	return file_proto_content_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListPostsResponse) GetPosts() []*Post {
@@ -1109,7 +1462,7 @@ type DeleteResponse struct {
 
 func (x *DeleteResponse) Reset() {
 	*x = DeleteResponse{}
-	mi := &file_proto_content_proto_msgTypes[14]
+	mi := &file_proto_content_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1121,7 +1474,7 @@ func (x *DeleteResponse) String() string {
 func (*DeleteResponse) ProtoMessage() {}
 
 func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[14]
+	mi := &file_proto_content_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1134,7 +1487,7 @@ func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{14}
+	return file_proto_content_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DeleteResponse) GetSuccess() bool {
@@ -1156,7 +1509,7 @@ type CreateCommentRequest struct {
 
 func (x *CreateCommentRequest) Reset() {
 	*x = CreateCommentRequest{}
-	mi := &file_proto_content_proto_msgTypes[15]
+	mi := &file_proto_content_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1168,7 +1521,7 @@ func (x *CreateCommentRequest) String() string {
 func (*CreateCommentRequest) ProtoMessage() {}
 
 func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[15]
+	mi := &file_proto_content_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,7 +1534,7 @@ func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
 func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{15}
+	return file_proto_content_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateCommentRequest) GetId() string {
@@ -1222,7 +1575,7 @@ type UpdateCommentRequest struct {
 
 func (x *UpdateCommentRequest) Reset() {
 	*x = UpdateCommentRequest{}
-	mi := &file_proto_content_proto_msgTypes[16]
+	mi := &file_proto_content_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1234,7 +1587,7 @@ func (x *UpdateCommentRequest) String() string {
 func (*UpdateCommentRequest) ProtoMessage() {}
 
 func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[16]
+	mi := &file_proto_content_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1247,7 +1600,7 @@ func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{16}
+	return file_proto_content_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateCommentRequest) GetId() string {
@@ -1273,7 +1626,7 @@ type CommentIdRequest struct {
 
 func (x *CommentIdRequest) Reset() {
 	*x = CommentIdRequest{}
-	mi := &file_proto_content_proto_msgTypes[17]
+	mi := &file_proto_content_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1285,7 +1638,7 @@ func (x *CommentIdRequest) String() string {
 func (*CommentIdRequest) ProtoMessage() {}
 
 func (x *CommentIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[17]
+	mi := &file_proto_content_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,7 +1651,7 @@ func (x *CommentIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentIdRequest.ProtoReflect.Descriptor instead.
 func (*CommentIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{17}
+	return file_proto_content_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CommentIdRequest) GetId() string {
@@ -1318,7 +1671,7 @@ type ListCommentsRequest struct {
 
 func (x *ListCommentsRequest) Reset() {
 	*x = ListCommentsRequest{}
-	mi := &file_proto_content_proto_msgTypes[18]
+	mi := &file_proto_content_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1330,7 +1683,7 @@ func (x *ListCommentsRequest) String() string {
 func (*ListCommentsRequest) ProtoMessage() {}
 
 func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[18]
+	mi := &file_proto_content_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,7 +1696,7 @@ func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
 func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{18}
+	return file_proto_content_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListCommentsRequest) GetPostId() string {
@@ -1369,7 +1722,7 @@ type CommentResponse struct {
 
 func (x *CommentResponse) Reset() {
 	*x = CommentResponse{}
-	mi := &file_proto_content_proto_msgTypes[19]
+	mi := &file_proto_content_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1381,7 +1734,7 @@ func (x *CommentResponse) String() string {
 func (*CommentResponse) ProtoMessage() {}
 
 func (x *CommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[19]
+	mi := &file_proto_content_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1394,7 +1747,7 @@ func (x *CommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommentResponse.ProtoReflect.Descriptor instead.
 func (*CommentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{19}
+	return file_proto_content_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CommentResponse) GetComment() *Comment {
@@ -1413,7 +1766,7 @@ type ListCommentsResponse struct {
 
 func (x *ListCommentsResponse) Reset() {
 	*x = ListCommentsResponse{}
-	mi := &file_proto_content_proto_msgTypes[20]
+	mi := &file_proto_content_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1425,7 +1778,7 @@ func (x *ListCommentsResponse) String() string {
 func (*ListCommentsResponse) ProtoMessage() {}
 
 func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[20]
+	mi := &file_proto_content_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1438,7 +1791,7 @@ func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
 func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{20}
+	return file_proto_content_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListCommentsResponse) GetComments() []*Comment {
@@ -1458,7 +1811,7 @@ type LikePostRequest struct {
 
 func (x *LikePostRequest) Reset() {
 	*x = LikePostRequest{}
-	mi := &file_proto_content_proto_msgTypes[21]
+	mi := &file_proto_content_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1470,7 +1823,7 @@ func (x *LikePostRequest) String() string {
 func (*LikePostRequest) ProtoMessage() {}
 
 func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[21]
+	mi := &file_proto_content_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1483,7 +1836,7 @@ func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
 func (*LikePostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{21}
+	return file_proto_content_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *LikePostRequest) GetPostId() string {
@@ -1509,7 +1862,7 @@ type LikePostResponse struct {
 
 func (x *LikePostResponse) Reset() {
 	*x = LikePostResponse{}
-	mi := &file_proto_content_proto_msgTypes[22]
+	mi := &file_proto_content_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1521,7 +1874,7 @@ func (x *LikePostResponse) String() string {
 func (*LikePostResponse) ProtoMessage() {}
 
 func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[22]
+	mi := &file_proto_content_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,7 +1887,7 @@ func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
 func (*LikePostResponse) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{22}
+	return file_proto_content_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *LikePostResponse) GetLikesCount() int32 {
@@ -1554,7 +1907,7 @@ type UnlikePostRequest struct {
 
 func (x *UnlikePostRequest) Reset() {
 	*x = UnlikePostRequest{}
-	mi := &file_proto_content_proto_msgTypes[23]
+	mi := &file_proto_content_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1566,7 +1919,7 @@ func (x *UnlikePostRequest) String() string {
 func (*UnlikePostRequest) ProtoMessage() {}
 
 func (x *UnlikePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[23]
+	mi := &file_proto_content_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1579,7 +1932,7 @@ func (x *UnlikePostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlikePostRequest.ProtoReflect.Descriptor instead.
 func (*UnlikePostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{23}
+	return file_proto_content_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *UnlikePostRequest) GetPostId() string {
@@ -1605,7 +1958,7 @@ type UnlikePostResponse struct {
 
 func (x *UnlikePostResponse) Reset() {
 	*x = UnlikePostResponse{}
-	mi := &file_proto_content_proto_msgTypes[24]
+	mi := &file_proto_content_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1617,7 +1970,7 @@ func (x *UnlikePostResponse) String() string {
 func (*UnlikePostResponse) ProtoMessage() {}
 
 func (x *UnlikePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[24]
+	mi := &file_proto_content_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1630,7 +1983,7 @@ func (x *UnlikePostResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlikePostResponse.ProtoReflect.Descriptor instead.
 func (*UnlikePostResponse) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{24}
+	return file_proto_content_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UnlikePostResponse) GetLikesCount() int32 {
@@ -1650,7 +2003,7 @@ type LikeCommentRequest struct {
 
 func (x *LikeCommentRequest) Reset() {
 	*x = LikeCommentRequest{}
-	mi := &file_proto_content_proto_msgTypes[25]
+	mi := &file_proto_content_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1662,7 +2015,7 @@ func (x *LikeCommentRequest) String() string {
 func (*LikeCommentRequest) ProtoMessage() {}
 
 func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[25]
+	mi := &file_proto_content_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1675,7 +2028,7 @@ func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeCommentRequest.ProtoReflect.Descriptor instead.
 func (*LikeCommentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{25}
+	return file_proto_content_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *LikeCommentRequest) GetCommentId() string {
@@ -1701,7 +2054,7 @@ type LikeCommentResponse struct {
 
 func (x *LikeCommentResponse) Reset() {
 	*x = LikeCommentResponse{}
-	mi := &file_proto_content_proto_msgTypes[26]
+	mi := &file_proto_content_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1713,7 +2066,7 @@ func (x *LikeCommentResponse) String() string {
 func (*LikeCommentResponse) ProtoMessage() {}
 
 func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[26]
+	mi := &file_proto_content_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1726,7 +2079,7 @@ func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeCommentResponse.ProtoReflect.Descriptor instead.
 func (*LikeCommentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{26}
+	return file_proto_content_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *LikeCommentResponse) GetLikesCount() int32 {
@@ -1746,7 +2099,7 @@ type UnlikeCommentRequest struct {
 
 func (x *UnlikeCommentRequest) Reset() {
 	*x = UnlikeCommentRequest{}
-	mi := &file_proto_content_proto_msgTypes[27]
+	mi := &file_proto_content_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1758,7 +2111,7 @@ func (x *UnlikeCommentRequest) String() string {
 func (*UnlikeCommentRequest) ProtoMessage() {}
 
 func (x *UnlikeCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[27]
+	mi := &file_proto_content_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1771,7 +2124,7 @@ func (x *UnlikeCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlikeCommentRequest.ProtoReflect.Descriptor instead.
 func (*UnlikeCommentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{27}
+	return file_proto_content_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UnlikeCommentRequest) GetCommentId() string {
@@ -1797,7 +2150,7 @@ type UnlikeCommentResponse struct {
 
 func (x *UnlikeCommentResponse) Reset() {
 	*x = UnlikeCommentResponse{}
-	mi := &file_proto_content_proto_msgTypes[28]
+	mi := &file_proto_content_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1809,7 +2162,7 @@ func (x *UnlikeCommentResponse) String() string {
 func (*UnlikeCommentResponse) ProtoMessage() {}
 
 func (x *UnlikeCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_content_proto_msgTypes[28]
+	mi := &file_proto_content_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,7 +2175,7 @@ func (x *UnlikeCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlikeCommentResponse.ProtoReflect.Descriptor instead.
 func (*UnlikeCommentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_content_proto_rawDescGZIP(), []int{28}
+	return file_proto_content_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *UnlikeCommentResponse) GetLikesCount() int32 {
@@ -1832,11 +2185,123 @@ func (x *UnlikeCommentResponse) GetLikesCount() int32 {
 	return 0
 }
 
+type ContentStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentStatsRequest) Reset() {
+	*x = ContentStatsRequest{}
+	mi := &file_proto_content_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentStatsRequest) ProtoMessage() {}
+
+func (x *ContentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentStatsRequest.ProtoReflect.Descriptor instead.
+func (*ContentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{33}
+}
+
+type ContentStatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Posts          int64                  `protobuf:"varint,1,opt,name=posts,proto3" json:"posts,omitempty"`
+	PublishedPosts int64                  `protobuf:"varint,2,opt,name=published_posts,json=publishedPosts,proto3" json:"published_posts,omitempty"`
+	Comments       int64                  `protobuf:"varint,3,opt,name=comments,proto3" json:"comments,omitempty"`
+	Likes          int64                  `protobuf:"varint,4,opt,name=likes,proto3" json:"likes,omitempty"`
+	Media          int64                  `protobuf:"varint,5,opt,name=media,proto3" json:"media,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ContentStatsResponse) Reset() {
+	*x = ContentStatsResponse{}
+	mi := &file_proto_content_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentStatsResponse) ProtoMessage() {}
+
+func (x *ContentStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_content_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentStatsResponse.ProtoReflect.Descriptor instead.
+func (*ContentStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_content_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ContentStatsResponse) GetPosts() int64 {
+	if x != nil {
+		return x.Posts
+	}
+	return 0
+}
+
+func (x *ContentStatsResponse) GetPublishedPosts() int64 {
+	if x != nil {
+		return x.PublishedPosts
+	}
+	return 0
+}
+
+func (x *ContentStatsResponse) GetComments() int64 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+func (x *ContentStatsResponse) GetLikes() int64 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *ContentStatsResponse) GetMedia() int64 {
+	if x != nil {
+		return x.Media
+	}
+	return 0
+}
+
 var File_proto_content_proto protoreflect.FileDescriptor
 
 const file_proto_content_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/content.proto\x12\acontent\x1a\x1fgoogle/protobuf/timestamp.proto\"\xea\x03\n" +
+	"\x13proto/content.proto\x12\acontent\x1a\x1fgoogle/protobuf/timestamp.proto\"\x90\x04\n" +
 	"\x04Post\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
@@ -1857,7 +2322,31 @@ const file_proto_content_proto_rawDesc = "" +
 	"\x05liked\x18\r \x01(\bR\x05liked\x12\x16\n" +
 	"\x06images\x18\x0e \x03(\tR\x06images\x12!\n" +
 	"\x04poll\x18\x0f \x01(\v2\r.content.PollR\x04poll\x12\x14\n" +
-	"\x05files\x18\x10 \x03(\tR\x05files\"\xec\x01\n" +
+	"\x05files\x18\x10 \x03(\tR\x05files\x12$\n" +
+	"\x05media\x18\x11 \x03(\v2\x0e.content.MediaR\x05media\"\x8a\x02\n" +
+	"\x05Media\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12&\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x12.content.MediaKindR\x04kind\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bfilename\x18\x06 \x01(\tR\bfilename\x12#\n" +
+	"\rhas_thumbnail\x18\a \x01(\bR\fhasThumbnail\x12\x17\n" +
+	"\apost_id\x18\b \x01(\tR\x06postId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\xdf\x01\n" +
+	"\x12CreateMediaRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12&\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x12.content.MediaKindR\x04kind\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bfilename\x18\x06 \x01(\tR\bfilename\x12#\n" +
+	"\rhas_thumbnail\x18\a \x01(\bR\fhasThumbnail\" \n" +
+	"\x0eMediaIdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\rMediaResponse\x12$\n" +
+	"\x05media\x18\x01 \x01(\v2\x0e.content.MediaR\x05media\"\xec\x01\n" +
 	"\x04Poll\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\bquestion\x18\x02 \x01(\tR\bquestion\x12-\n" +
@@ -1896,7 +2385,7 @@ const file_proto_content_proto_rawDesc = "" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x14\n" +
 	"\x05liked\x18\a \x01(\bR\x05liked\x12\x1f\n" +
 	"\vlikes_count\x18\b \x01(\x05R\n" +
-	"likesCount\"\x8c\x02\n" +
+	"likesCount\"\xa9\x02\n" +
 	"\x11CreatePostRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
@@ -1906,7 +2395,9 @@ const file_proto_content_proto_rawDesc = "" +
 	"\tpublished\x18\x06 \x01(\bR\tpublished\x12\x16\n" +
 	"\x06images\x18\a \x03(\tR\x06images\x12'\n" +
 	"\x04poll\x18\b \x01(\v2\x13.content.PollCreateR\x04poll\x12\x14\n" +
-	"\x05files\x18\t \x03(\tR\x05files\"q\n" +
+	"\x05files\x18\t \x03(\tR\x05files\x12\x1b\n" +
+	"\tmedia_ids\x18\n" +
+	" \x03(\tR\bmediaIds\"q\n" +
 	"\x11UpdatePostRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
@@ -1973,11 +2464,22 @@ const file_proto_content_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"8\n" +
 	"\x15UnlikeCommentResponse\x12\x1f\n" +
 	"\vlikes_count\x18\x01 \x01(\x05R\n" +
-	"likesCount*%\n" +
+	"likesCount\"\x15\n" +
+	"\x13ContentStatsRequest\"\x9d\x01\n" +
+	"\x14ContentStatsResponse\x12\x14\n" +
+	"\x05posts\x18\x01 \x01(\x03R\x05posts\x12'\n" +
+	"\x0fpublished_posts\x18\x02 \x01(\x03R\x0epublishedPosts\x12\x1a\n" +
+	"\bcomments\x18\x03 \x01(\x03R\bcomments\x12\x14\n" +
+	"\x05likes\x18\x04 \x01(\x03R\x05likes\x12\x14\n" +
+	"\x05media\x18\x05 \x01(\x03R\x05media*%\n" +
 	"\bPostType\x12\x0e\n" +
 	"\n" +
 	"LEGAL_INFO\x10\x00\x12\t\n" +
-	"\x05GUIDE\x10\x012\xa0\b\n" +
+	"\x05GUIDE\x10\x01*,\n" +
+	"\tMediaKind\x12\x0f\n" +
+	"\vMEDIA_IMAGE\x10\x00\x12\x0e\n" +
+	"\n" +
+	"MEDIA_FILE\x10\x012\xf1\t\n" +
 	"\x0eContentService\x12?\n" +
 	"\n" +
 	"CreatePost\x12\x1a.content.CreatePostRequest\x1a\x15.content.PostResponse\x12?\n" +
@@ -1997,7 +2499,10 @@ const file_proto_content_proto_rawDesc = "" +
 	"UnlikePost\x12\x1a.content.UnlikePostRequest\x1a\x1b.content.UnlikePostResponse\x12H\n" +
 	"\vLikeComment\x12\x1b.content.LikeCommentRequest\x1a\x1c.content.LikeCommentResponse\x12N\n" +
 	"\rUnlikeComment\x12\x1d.content.UnlikeCommentRequest\x1a\x1e.content.UnlikeCommentResponse\x12?\n" +
-	"\bVotePoll\x12\x18.content.VotePollRequest\x1a\x19.content.VotePollResponseB@Z>github.com/KaminurOrynbek/BiznesAsh/auto-proto/content;contentb\x06proto3"
+	"\bVotePoll\x12\x18.content.VotePollRequest\x1a\x19.content.VotePollResponse\x12B\n" +
+	"\vCreateMedia\x12\x1b.content.CreateMediaRequest\x1a\x16.content.MediaResponse\x12;\n" +
+	"\bGetMedia\x12\x17.content.MediaIdRequest\x1a\x16.content.MediaResponse\x12N\n" +
+	"\x0fGetContentStats\x12\x1c.content.ContentStatsRequest\x1a\x1d.content.ContentStatsResponseB@Z>github.com/KaminurOrynbek/BiznesAsh/auto-proto/content;contentb\x06proto3"
 
 var (
 	file_proto_content_proto_rawDescOnce sync.Once
@@ -2011,91 +2516,108 @@ func file_proto_content_proto_rawDescGZIP() []byte {
 	return file_proto_content_proto_rawDescData
 }
 
-var file_proto_content_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_content_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_proto_content_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_content_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_proto_content_proto_goTypes = []any{
 	(PostType)(0),                 // 0: content.PostType
-	(*Post)(nil),                  // 1: content.Post
-	(*Poll)(nil),                  // 2: content.Poll
-	(*PollOption)(nil),            // 3: content.PollOption
-	(*PollCreate)(nil),            // 4: content.PollCreate
-	(*VotePollRequest)(nil),       // 5: content.VotePollRequest
-	(*VotePollResponse)(nil),      // 6: content.VotePollResponse
-	(*Comment)(nil),               // 7: content.Comment
-	(*CreatePostRequest)(nil),     // 8: content.CreatePostRequest
-	(*UpdatePostRequest)(nil),     // 9: content.UpdatePostRequest
-	(*PostIdRequest)(nil),         // 10: content.PostIdRequest
-	(*ListPostsRequest)(nil),      // 11: content.ListPostsRequest
-	(*SearchPostsRequest)(nil),    // 12: content.SearchPostsRequest
-	(*PostResponse)(nil),          // 13: content.PostResponse
-	(*ListPostsResponse)(nil),     // 14: content.ListPostsResponse
-	(*DeleteResponse)(nil),        // 15: content.DeleteResponse
-	(*CreateCommentRequest)(nil),  // 16: content.CreateCommentRequest
-	(*UpdateCommentRequest)(nil),  // 17: content.UpdateCommentRequest
-	(*CommentIdRequest)(nil),      // 18: content.CommentIdRequest
-	(*ListCommentsRequest)(nil),   // 19: content.ListCommentsRequest
-	(*CommentResponse)(nil),       // 20: content.CommentResponse
-	(*ListCommentsResponse)(nil),  // 21: content.ListCommentsResponse
-	(*LikePostRequest)(nil),       // 22: content.LikePostRequest
-	(*LikePostResponse)(nil),      // 23: content.LikePostResponse
-	(*UnlikePostRequest)(nil),     // 24: content.UnlikePostRequest
-	(*UnlikePostResponse)(nil),    // 25: content.UnlikePostResponse
-	(*LikeCommentRequest)(nil),    // 26: content.LikeCommentRequest
-	(*LikeCommentResponse)(nil),   // 27: content.LikeCommentResponse
-	(*UnlikeCommentRequest)(nil),  // 28: content.UnlikeCommentRequest
-	(*UnlikeCommentResponse)(nil), // 29: content.UnlikeCommentResponse
-	(*timestamppb.Timestamp)(nil), // 30: google.protobuf.Timestamp
+	(MediaKind)(0),                // 1: content.MediaKind
+	(*Post)(nil),                  // 2: content.Post
+	(*Media)(nil),                 // 3: content.Media
+	(*CreateMediaRequest)(nil),    // 4: content.CreateMediaRequest
+	(*MediaIdRequest)(nil),        // 5: content.MediaIdRequest
+	(*MediaResponse)(nil),         // 6: content.MediaResponse
+	(*Poll)(nil),                  // 7: content.Poll
+	(*PollOption)(nil),            // 8: content.PollOption
+	(*PollCreate)(nil),            // 9: content.PollCreate
+	(*VotePollRequest)(nil),       // 10: content.VotePollRequest
+	(*VotePollResponse)(nil),      // 11: content.VotePollResponse
+	(*Comment)(nil),               // 12: content.Comment
+	(*CreatePostRequest)(nil),     // 13: content.CreatePostRequest
+	(*UpdatePostRequest)(nil),     // 14: content.UpdatePostRequest
+	(*PostIdRequest)(nil),         // 15: content.PostIdRequest
+	(*ListPostsRequest)(nil),      // 16: content.ListPostsRequest
+	(*SearchPostsRequest)(nil),    // 17: content.SearchPostsRequest
+	(*PostResponse)(nil),          // 18: content.PostResponse
+	(*ListPostsResponse)(nil),     // 19: content.ListPostsResponse
+	(*DeleteResponse)(nil),        // 20: content.DeleteResponse
+	(*CreateCommentRequest)(nil),  // 21: content.CreateCommentRequest
+	(*UpdateCommentRequest)(nil),  // 22: content.UpdateCommentRequest
+	(*CommentIdRequest)(nil),      // 23: content.CommentIdRequest
+	(*ListCommentsRequest)(nil),   // 24: content.ListCommentsRequest
+	(*CommentResponse)(nil),       // 25: content.CommentResponse
+	(*ListCommentsResponse)(nil),  // 26: content.ListCommentsResponse
+	(*LikePostRequest)(nil),       // 27: content.LikePostRequest
+	(*LikePostResponse)(nil),      // 28: content.LikePostResponse
+	(*UnlikePostRequest)(nil),     // 29: content.UnlikePostRequest
+	(*UnlikePostResponse)(nil),    // 30: content.UnlikePostResponse
+	(*LikeCommentRequest)(nil),    // 31: content.LikeCommentRequest
+	(*LikeCommentResponse)(nil),   // 32: content.LikeCommentResponse
+	(*UnlikeCommentRequest)(nil),  // 33: content.UnlikeCommentRequest
+	(*UnlikeCommentResponse)(nil), // 34: content.UnlikeCommentResponse
+	(*ContentStatsRequest)(nil),   // 35: content.ContentStatsRequest
+	(*ContentStatsResponse)(nil),  // 36: content.ContentStatsResponse
+	(*timestamppb.Timestamp)(nil), // 37: google.protobuf.Timestamp
 }
 var file_proto_content_proto_depIdxs = []int32{
 	0,  // 0: content.Post.type:type_name -> content.PostType
-	7,  // 1: content.Post.comments:type_name -> content.Comment
-	2,  // 2: content.Post.poll:type_name -> content.Poll
-	3,  // 3: content.Poll.options:type_name -> content.PollOption
-	2,  // 4: content.VotePollResponse.poll:type_name -> content.Poll
-	30, // 5: content.Comment.created_at:type_name -> google.protobuf.Timestamp
-	30, // 6: content.Comment.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 7: content.CreatePostRequest.type:type_name -> content.PostType
-	4,  // 8: content.CreatePostRequest.poll:type_name -> content.PollCreate
-	0,  // 9: content.ListPostsRequest.type:type_name -> content.PostType
-	1,  // 10: content.PostResponse.post:type_name -> content.Post
-	1,  // 11: content.ListPostsResponse.posts:type_name -> content.Post
-	7,  // 12: content.CommentResponse.comment:type_name -> content.Comment
-	7,  // 13: content.ListCommentsResponse.comments:type_name -> content.Comment
-	8,  // 14: content.ContentService.CreatePost:input_type -> content.CreatePostRequest
-	9,  // 15: content.ContentService.UpdatePost:input_type -> content.UpdatePostRequest
-	10, // 16: content.ContentService.DeletePost:input_type -> content.PostIdRequest
-	10, // 17: content.ContentService.GetPost:input_type -> content.PostIdRequest
-	11, // 18: content.ContentService.ListPosts:input_type -> content.ListPostsRequest
-	12, // 19: content.ContentService.SearchPosts:input_type -> content.SearchPostsRequest
-	16, // 20: content.ContentService.CreateComment:input_type -> content.CreateCommentRequest
-	17, // 21: content.ContentService.UpdateComment:input_type -> content.UpdateCommentRequest
-	18, // 22: content.ContentService.DeleteComment:input_type -> content.CommentIdRequest
-	19, // 23: content.ContentService.ListComments:input_type -> content.ListCommentsRequest
-	22, // 24: content.ContentService.LikePost:input_type -> content.LikePostRequest
-	24, // 25: content.ContentService.UnlikePost:input_type -> content.UnlikePostRequest
-	26, // 26: content.ContentService.LikeComment:input_type -> content.LikeCommentRequest
-	28, // 27: content.ContentService.UnlikeComment:input_type -> content.UnlikeCommentRequest
-	5,  // 28: content.ContentService.VotePoll:input_type -> content.VotePollRequest
-	13, // 29: content.ContentService.CreatePost:output_type -> content.PostResponse
-	13, // 30: content.ContentService.UpdatePost:output_type -> content.PostResponse
-	15, // 31: content.ContentService.DeletePost:output_type -> content.DeleteResponse
-	13, // 32: content.ContentService.GetPost:output_type -> content.PostResponse
-	14, // 33: content.ContentService.ListPosts:output_type -> content.ListPostsResponse
-	14, // 34: content.ContentService.SearchPosts:output_type -> content.ListPostsResponse
-	20, // 35: content.ContentService.CreateComment:output_type -> content.CommentResponse
-	20, // 36: content.ContentService.UpdateComment:output_type -> content.CommentResponse
-	15, // 37: content.ContentService.DeleteComment:output_type -> content.DeleteResponse
-	21, // 38: content.ContentService.ListComments:output_type -> content.ListCommentsResponse
-	23, // 39: content.ContentService.LikePost:output_type -> content.LikePostResponse
-	25, // 40: content.ContentService.UnlikePost:output_type -> content.UnlikePostResponse
-	27, // 41: content.ContentService.LikeComment:output_type -> content.LikeCommentResponse
-	29, // 42: content.ContentService.UnlikeComment:output_type -> content.UnlikeCommentResponse
-	6,  // 43: content.ContentService.VotePoll:output_type -> content.VotePollResponse
-	29, // [29:44] is the sub-list for method output_type
-	14, // [14:29] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	12, // 1: content.Post.comments:type_name -> content.Comment
+	7,  // 2: content.Post.poll:type_name -> content.Poll
+	3,  // 3: content.Post.media:type_name -> content.Media
+	1,  // 4: content.Media.kind:type_name -> content.MediaKind
+	1,  // 5: content.CreateMediaRequest.kind:type_name -> content.MediaKind
+	3,  // 6: content.MediaResponse.media:type_name -> content.Media
+	8,  // 7: content.Poll.options:type_name -> content.PollOption
+	7,  // 8: content.VotePollResponse.poll:type_name -> content.Poll
+	37, // 9: content.Comment.created_at:type_name -> google.protobuf.Timestamp
+	37, // 10: content.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 11: content.CreatePostRequest.type:type_name -> content.PostType
+	9,  // 12: content.CreatePostRequest.poll:type_name -> content.PollCreate
+	0,  // 13: content.ListPostsRequest.type:type_name -> content.PostType
+	2,  // 14: content.PostResponse.post:type_name -> content.Post
+	2,  // 15: content.ListPostsResponse.posts:type_name -> content.Post
+	12, // 16: content.CommentResponse.comment:type_name -> content.Comment
+	12, // 17: content.ListCommentsResponse.comments:type_name -> content.Comment
+	13, // 18: content.ContentService.CreatePost:input_type -> content.CreatePostRequest
+	14, // 19: content.ContentService.UpdatePost:input_type -> content.UpdatePostRequest
+	15, // 20: content.ContentService.DeletePost:input_type -> content.PostIdRequest
+	15, // 21: content.ContentService.GetPost:input_type -> content.PostIdRequest
+	16, // 22: content.ContentService.ListPosts:input_type -> content.ListPostsRequest
+	17, // 23: content.ContentService.SearchPosts:input_type -> content.SearchPostsRequest
+	21, // 24: content.ContentService.CreateComment:input_type -> content.CreateCommentRequest
+	22, // 25: content.ContentService.UpdateComment:input_type -> content.UpdateCommentRequest
+	23, // 26: content.ContentService.DeleteComment:input_type -> content.CommentIdRequest
+	24, // 27: content.ContentService.ListComments:input_type -> content.ListCommentsRequest
+	27, // 28: content.ContentService.LikePost:input_type -> content.LikePostRequest
+	29, // 29: content.ContentService.UnlikePost:input_type -> content.UnlikePostRequest
+	31, // 30: content.ContentService.LikeComment:input_type -> content.LikeCommentRequest
+	33, // 31: content.ContentService.UnlikeComment:input_type -> content.UnlikeCommentRequest
+	10, // 32: content.ContentService.VotePoll:input_type -> content.VotePollRequest
+	4,  // 33: content.ContentService.CreateMedia:input_type -> content.CreateMediaRequest
+	5,  // 34: content.ContentService.GetMedia:input_type -> content.MediaIdRequest
+	35, // 35: content.ContentService.GetContentStats:input_type -> content.ContentStatsRequest
+	18, // 36: content.ContentService.CreatePost:output_type -> content.PostResponse
+	18, // 37: content.ContentService.UpdatePost:output_type -> content.PostResponse
+	20, // 38: content.ContentService.DeletePost:output_type -> content.DeleteResponse
+	18, // 39: content.ContentService.GetPost:output_type -> content.PostResponse
+	19, // 40: content.ContentService.ListPosts:output_type -> content.ListPostsResponse
+	19, // 41: content.ContentService.SearchPosts:output_type -> content.ListPostsResponse
+	25, // 42: content.ContentService.CreateComment:output_type -> content.CommentResponse
+	25, // 43: content.ContentService.UpdateComment:output_type -> content.CommentResponse
+	20, // 44: content.ContentService.DeleteComment:output_type -> content.DeleteResponse
+	26, // 45: content.ContentService.ListComments:output_type -> content.ListCommentsResponse
+	28, // 46: content.ContentService.LikePost:output_type -> content.LikePostResponse
+	30, // 47: content.ContentService.UnlikePost:output_type -> content.UnlikePostResponse
+	32, // 48: content.ContentService.LikeComment:output_type -> content.LikeCommentResponse
+	34, // 49: content.ContentService.UnlikeComment:output_type -> content.UnlikeCommentResponse
+	11, // 50: content.ContentService.VotePoll:output_type -> content.VotePollResponse
+	6,  // 51: content.ContentService.CreateMedia:output_type -> content.MediaResponse
+	6,  // 52: content.ContentService.GetMedia:output_type -> content.MediaResponse
+	36, // 53: content.ContentService.GetContentStats:output_type -> content.ContentStatsResponse
+	36, // [36:54] is the sub-list for method output_type
+	18, // [18:36] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_proto_content_proto_init() }
@@ -2108,8 +2630,8 @@ func file_proto_content_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_content_proto_rawDesc), len(file_proto_content_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   29,
+			NumEnums:      2,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
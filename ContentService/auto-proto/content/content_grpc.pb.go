@@ -19,21 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ContentService_CreatePost_FullMethodName    = "/content.ContentService/CreatePost"
-	ContentService_UpdatePost_FullMethodName    = "/content.ContentService/UpdatePost"
-	ContentService_DeletePost_FullMethodName    = "/content.ContentService/DeletePost"
-	ContentService_GetPost_FullMethodName       = "/content.ContentService/GetPost"
-	ContentService_ListPosts_FullMethodName     = "/content.ContentService/ListPosts"
-	ContentService_SearchPosts_FullMethodName   = "/content.ContentService/SearchPosts"
-	ContentService_CreateComment_FullMethodName = "/content.ContentService/CreateComment"
-	ContentService_UpdateComment_FullMethodName = "/content.ContentService/UpdateComment"
-	ContentService_DeleteComment_FullMethodName = "/content.ContentService/DeleteComment"
-	ContentService_ListComments_FullMethodName  = "/content.ContentService/ListComments"
-	ContentService_LikePost_FullMethodName      = "/content.ContentService/LikePost"
-	ContentService_UnlikePost_FullMethodName    = "/content.ContentService/UnlikePost"
-	ContentService_LikeComment_FullMethodName   = "/content.ContentService/LikeComment"
-	ContentService_UnlikeComment_FullMethodName = "/content.ContentService/UnlikeComment"
-	ContentService_VotePoll_FullMethodName      = "/content.ContentService/VotePoll"
+	ContentService_CreatePost_FullMethodName      = "/content.ContentService/CreatePost"
+	ContentService_UpdatePost_FullMethodName      = "/content.ContentService/UpdatePost"
+	ContentService_DeletePost_FullMethodName      = "/content.ContentService/DeletePost"
+	ContentService_GetPost_FullMethodName         = "/content.ContentService/GetPost"
+	ContentService_ListPosts_FullMethodName       = "/content.ContentService/ListPosts"
+	ContentService_SearchPosts_FullMethodName     = "/content.ContentService/SearchPosts"
+	ContentService_CreateComment_FullMethodName   = "/content.ContentService/CreateComment"
+	ContentService_UpdateComment_FullMethodName   = "/content.ContentService/UpdateComment"
+	ContentService_DeleteComment_FullMethodName   = "/content.ContentService/DeleteComment"
+	ContentService_ListComments_FullMethodName    = "/content.ContentService/ListComments"
+	ContentService_LikePost_FullMethodName        = "/content.ContentService/LikePost"
+	ContentService_UnlikePost_FullMethodName      = "/content.ContentService/UnlikePost"
+	ContentService_LikeComment_FullMethodName     = "/content.ContentService/LikeComment"
+	ContentService_UnlikeComment_FullMethodName   = "/content.ContentService/UnlikeComment"
+	ContentService_VotePoll_FullMethodName        = "/content.ContentService/VotePoll"
+	ContentService_CreateMedia_FullMethodName     = "/content.ContentService/CreateMedia"
+	ContentService_GetMedia_FullMethodName        = "/content.ContentService/GetMedia"
+	ContentService_GetContentStats_FullMethodName = "/content.ContentService/GetContentStats"
 )
 
 // ContentServiceClient is the client API for ContentService service.
@@ -55,6 +58,9 @@ type ContentServiceClient interface {
 	LikeComment(ctx context.Context, in *LikeCommentRequest, opts ...grpc.CallOption) (*LikeCommentResponse, error)
 	UnlikeComment(ctx context.Context, in *UnlikeCommentRequest, opts ...grpc.CallOption) (*UnlikeCommentResponse, error)
 	VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*VotePollResponse, error)
+	CreateMedia(ctx context.Context, in *CreateMediaRequest, opts ...grpc.CallOption) (*MediaResponse, error)
+	GetMedia(ctx context.Context, in *MediaIdRequest, opts ...grpc.CallOption) (*MediaResponse, error)
+	GetContentStats(ctx context.Context, in *ContentStatsRequest, opts ...grpc.CallOption) (*ContentStatsResponse, error)
 }
 
 type contentServiceClient struct {
@@ -215,6 +221,36 @@ func (c *contentServiceClient) VotePoll(ctx context.Context, in *VotePollRequest
 	return out, nil
 }
 
+func (c *contentServiceClient) CreateMedia(ctx context.Context, in *CreateMediaRequest, opts ...grpc.CallOption) (*MediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MediaResponse)
+	err := c.cc.Invoke(ctx, ContentService_CreateMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) GetMedia(ctx context.Context, in *MediaIdRequest, opts ...grpc.CallOption) (*MediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MediaResponse)
+	err := c.cc.Invoke(ctx, ContentService_GetMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) GetContentStats(ctx context.Context, in *ContentStatsRequest, opts ...grpc.CallOption) (*ContentStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentStatsResponse)
+	err := c.cc.Invoke(ctx, ContentService_GetContentStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ContentServiceServer is the server API for ContentService service.
 // All implementations must embed UnimplementedContentServiceServer
 // for forward compatibility.
@@ -234,6 +270,9 @@ type ContentServiceServer interface {
 	LikeComment(context.Context, *LikeCommentRequest) (*LikeCommentResponse, error)
 	UnlikeComment(context.Context, *UnlikeCommentRequest) (*UnlikeCommentResponse, error)
 	VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error)
+	CreateMedia(context.Context, *CreateMediaRequest) (*MediaResponse, error)
+	GetMedia(context.Context, *MediaIdRequest) (*MediaResponse, error)
+	GetContentStats(context.Context, *ContentStatsRequest) (*ContentStatsResponse, error)
 	mustEmbedUnimplementedContentServiceServer()
 }
 
@@ -289,6 +328,15 @@ func (UnimplementedContentServiceServer) UnlikeComment(context.Context, *UnlikeC
 func (UnimplementedContentServiceServer) VotePoll(context.Context, *VotePollRequest) (*VotePollResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method VotePoll not implemented")
 }
+func (UnimplementedContentServiceServer) CreateMedia(context.Context, *CreateMediaRequest) (*MediaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMedia not implemented")
+}
+func (UnimplementedContentServiceServer) GetMedia(context.Context, *MediaIdRequest) (*MediaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMedia not implemented")
+}
+func (UnimplementedContentServiceServer) GetContentStats(context.Context, *ContentStatsRequest) (*ContentStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetContentStats not implemented")
+}
 func (UnimplementedContentServiceServer) mustEmbedUnimplementedContentServiceServer() {}
 func (UnimplementedContentServiceServer) testEmbeddedByValue()                        {}
 
@@ -580,6 +628,60 @@ func _ContentService_VotePoll_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContentService_CreateMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).CreateMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_CreateMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).CreateMedia(ctx, req.(*CreateMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_GetMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MediaIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).GetMedia(ctx, req.(*MediaIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_GetContentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContentStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetContentStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetContentStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).GetContentStats(ctx, req.(*ContentStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ContentService_ServiceDesc is the grpc.ServiceDesc for ContentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -647,6 +749,18 @@ var ContentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VotePoll",
 			Handler:    _ContentService_VotePoll_Handler,
 		},
+		{
+			MethodName: "CreateMedia",
+			Handler:    _ContentService_CreateMedia_Handler,
+		},
+		{
+			MethodName: "GetMedia",
+			Handler:    _ContentService_GetMedia_Handler,
+		},
+		{
+			MethodName: "GetContentStats",
+			Handler:    _ContentService_GetContentStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/content.proto",
@@ -3,134 +3,144 @@ package main
 import (
 	"context"
 	"github.com/KaminurOrynbek/BiznesAsh_lib/adapter/nats"
-	natscfg "github.com/KaminurOrynbek/BiznesAsh_lib/config/nats"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/auth"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/config"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/denylist"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/health"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/metrics"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
 	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/validate"
 
 	"github.com/jmoiron/sqlx"
-	"github.com/joho/godotenv"
-	"log"
+	"log/slog"
 	"net"
-	"os"
 
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
 
 	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/publisher"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/postgres/dao"
-	"github.com/KaminurOrynbek/BiznesAsh_lib/config/postgres"
 
 	redisclient "github.com/KaminurOrynbek/BiznesAsh_lib/adapter/redis"
-	rediscfg "github.com/KaminurOrynbek/BiznesAsh_lib/config/redis"
 
 	repoimpl "github.com/KaminurOrynbek/BiznesAsh/internal/repository/Impl"
 	usecaseimpl "github.com/KaminurOrynbek/BiznesAsh/internal/usecase/impl"
 
 	pb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
 	handler "github.com/KaminurOrynbek/BiznesAsh/internal/delivery/grpc"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/middleware"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/migration"
 )
 
-func init() {
-
-	err := godotenv.Load(".env")
-	if err != nil {
-		log.Println(".env file not found in current dir, continuing...")
-	} else {
-		log.Println("env file loaded successfully")
-	}
-}
-
 func main() {
-	// 1. Load config
-	pgConfig := postgres.LoadPostgresConfig()
+	var cfg config.ContentService
+	config.MustLoad("ContentService", &cfg)
+	logging.Init("ContentService")
 
-	// 2. Run migrations
-	migration.RunMigrations(pgConfig.DSN())
+	shutdownTracing := tracing.MustInit("ContentService")
+	defer shutdownTracing(context.Background())
 
-	// 3. Init postgres
+	// 1. Run migrations
+	migration.RunMigrations(cfg.Postgres.DSN())
+
+	// 2. Init postgres
 	// Создаём подключение к БД
-	db, err := sqlx.Connect("postgres", pgConfig.DSN())
+	db, err := sqlx.Connect("postgres", cfg.Postgres.DSN())
 	if err != nil {
-		log.Fatalf("Failed to connect to Postgres: %v", err)
+		logging.Fatal("failed to connect to Postgres", logging.Err(err))
 	}
 
 	defer db.Close()
 
-	cwd, _ := os.Getwd()
-	log.Printf("CWD=%s\n", cwd)
-
-	log.Println("Successfully connected to Postgres!")
+	slog.Info("connected to Postgres")
+	metrics.RegisterDB("ContentService", db.DB)
 
-	// DEBUG: check which DB + schema the service actually uses
+	// Check which DB + schema the service actually uses
 	var dbName string
 	var searchPath string
 	var regclass *string
 
 	if err := db.Get(&dbName, "select current_database()"); err != nil {
-		log.Fatalf("failed to read current_database: %v", err)
+		logging.Fatal("failed to read current_database", logging.Err(err))
 	}
 	if err := db.Get(&searchPath, "show search_path"); err != nil {
-		log.Fatalf("failed to read search_path: %v", err)
+		logging.Fatal("failed to read search_path", logging.Err(err))
 	}
 
 	if err := db.Get(&regclass, "select to_regclass('public.posts')"); err != nil {
-		log.Fatalf("failed to read to_regclass: %v", err)
+		logging.Fatal("failed to read to_regclass", logging.Err(err))
 	}
+	slog.Debug("database selected", "database", dbName, "search_path", searchPath, "posts_table", regclass != nil)
 	// 3. Init Redis
-	redisConfig := rediscfg.LoadRedisConfig()
-
-	redisClient := redisclient.NewRedisClient(redisConfig.Addr, redisConfig.Password, redisConfig.DB)
+	redisClient := redisclient.NewRedisClient(cfg.Redis.Addr, cfg.Redis.Password, cfg.Redis.DB)
 	if err := redisClient.Ping(context.Background()); err != nil {
-		log.Fatalf("Redis connection failed: %v", err)
+		logging.Fatal("Redis connection failed", logging.Err(err))
+	}
+	slog.Info("connected to Redis")
+
+	// Access tokens are verified with the keys UserService publishes; tokens it
+	// revoked are refused.
+	tokenKeys, err := auth.NewKeyCache(cfg.UserServiceAddr)
+	if err != nil {
+		logging.Fatal("failed to set up token verification", logging.Err(err))
 	}
-	log.Println("Connected to Redis successfully!")
 
 	// 4. Init DAOs
 	postDAO := dao.NewPostDAO(db)
 	commentDAO := dao.NewCommentDAO(db)
 	likeDAO := dao.NewLikeDao(db)
 	pollDAO := dao.NewPollDAO(db)
+	mediaDAO := dao.NewMediaDAO(db)
+	statsDAO := dao.NewStatsDAO(db)
 
 	// 5. Init Repositories
 	postRepo := repoimpl.NewPostRepository(postDAO, pollDAO)
 	commentRepo := repoimpl.NewCommentRepository(commentDAO)
 	likeRepo := repoimpl.NewLikeRepository(likeDAO)
-
-	// 6. Init Usecases
-	postUsecase := usecaseimpl.NewPostUsecase(postRepo, commentRepo, likeRepo)
+	mediaRepo := repoimpl.NewMediaRepository(mediaDAO)
+	statsRepo := repoimpl.NewStatsRepository(statsDAO)
 
 	// nats config (MOVED BEFORE using contentPublisher)
-	natsConfig := natscfg.LoadNatsConfig()
-	natsConn := nats.NewConnection(natsConfig)
+	natsConn := nats.NewConnection(cfg.NATS)
 	natsQueue := queue.NewNATSQueue(natsConn)
 
 	contentPublisher := publisher.NewContentPublisher(natsQueue)
 
+	// 6. Init Usecases
+	postUsecase := usecaseimpl.NewPostUsecase(postRepo, commentRepo, likeRepo, mediaRepo, contentPublisher)
+	mediaUsecase := usecaseimpl.NewMediaUsecase(mediaRepo)
+	statsUsecase := usecaseimpl.NewStatsUsecase(statsRepo)
 	commentUsecase := usecaseimpl.NewCommentUsecase(commentRepo, postRepo, likeRepo, contentPublisher)
 
 	defer natsConn.Close()
 
 	// 7. Init gRPC handler
-	contentHandler := handler.NewContentHandler(postUsecase, commentUsecase, likeRepo)
+	contentHandler := handler.NewContentHandler(postUsecase, commentUsecase, mediaUsecase, statsUsecase)
 
 	// 8. Start gRPC server
-	port := os.Getenv("GRPC_PORT")
-	if port == "" {
-		port = "50055" // fallback if not set
-	}
-	lis, err := net.Listen("tcp", ":"+port)
+	lis, err := net.Listen("tcp", ":"+cfg.GRPCPort)
 
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		logging.Fatal("failed to listen", logging.Err(err))
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(logging.UnaryServerInterceptor(), metrics.UnaryServerInterceptor(), auth.UnaryServerInterceptor(tokenKeys, denylist.NewRedis(redisClient.Client)), middleware.PolicyInterceptor(postRepo, commentRepo), validate.UnaryServerInterceptor()),
+	)
 	pb.RegisterContentServiceServer(s, contentHandler)
+	health.Register(s, pb.ContentService_ServiceDesc.ServiceName, health.Postgres(db.DB), health.Redis(redisClient), health.NATS(natsConn))
+	if missing := policy.Uncovered(s); len(missing) > 0 {
+		logging.Fatal("gRPC methods without a policy rule", "methods", missing)
+	}
+	metrics.Serve(cfg.MetricsPort)
 
-	log.Printf("ENV GRPC_PORT=%q\n", os.Getenv("GRPC_PORT"))
-
+	slog.Info("gRPC server listening", "port", cfg.GRPCPort)
 	if err := s.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+		logging.Fatal("failed to serve", logging.Err(err))
 	}
 
 }
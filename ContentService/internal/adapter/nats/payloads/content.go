@@ -7,8 +7,13 @@ type PostCreated struct {
 }
 
 type PostUpdated struct {
+	PostID   string `json:"post_id"`
+	Title    string `json:"title"`
+	AuthorID string `json:"author_id"`
+}
+
+type PostDeleted struct {
 	PostID string `json:"post_id"`
-	Title  string `json:"title"`
 }
 
 type CommentCreated struct {
@@ -19,6 +24,11 @@ type CommentCreated struct {
 	Content      string `json:"content"`
 }
 
+type CommentDeleted struct {
+	CommentID string `json:"comment_id"`
+	PostID    string `json:"post_id"`
+}
+
 type PostReported struct {
 	PostID     string `json:"post_id"`
 	ReporterID string `json:"reporter_id"`
@@ -29,10 +39,32 @@ type PostLiked struct {
 	ActorID      string `json:"actor_id"`
 	PostID       string `json:"post_id"`
 	TargetUserID string `json:"target_user_id"`
+	LikesCount   int32  `json:"likes_count"`
+}
+
+type PostUnliked struct {
+	ActorID    string `json:"actor_id"`
+	PostID     string `json:"post_id"`
+	LikesCount int32  `json:"likes_count"`
 }
 
 type CommentLiked struct {
 	ActorID      string `json:"actor_id"`
 	CommentID    string `json:"comment_id"`
 	TargetUserID string `json:"target_user_id"`
+	LikesCount   int32  `json:"likes_count"`
+}
+
+// PollVoted carries the poll's tallies after the vote. Voters are not
+// included, since the event is pushed to every client watching the feed.
+type PollVoted struct {
+	PostID     string            `json:"post_id"`
+	PollID     string            `json:"poll_id"`
+	Options    []PollOptionTally `json:"options"`
+	TotalVotes int32             `json:"total_votes"`
+}
+
+type PollOptionTally struct {
+	OptionID   string `json:"option_id"`
+	VotesCount int32  `json:"votes_count"`
 }
@@ -1,20 +1,27 @@
 package publisher
 
 import (
+	"context"
 	"encoding/json"
-	"log"
+	"log/slog"
 
 	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/payloads"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
 	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
 )
 
 const (
 	PostCreatedSubject    = "post.created"
 	PostUpdatedSubject    = "post.updated"
+	PostDeletedSubject    = "post.deleted"
 	CommentCreatedSubject = "comment.created"
+	CommentDeletedSubject = "comment.deleted"
 	PostReportedSubject   = "post.reported"
 	PostLikedSubject      = "post.liked"
+	PostUnlikedSubject    = "post.unliked"
 	CommentLikedSubject   = "comment.liked"
+	PollVotedSubject      = "poll.voted"
 )
 
 type ContentPublisher struct {
@@ -25,42 +32,59 @@ func NewContentPublisher(q queue.MessageQueue) *ContentPublisher {
 	return &ContentPublisher{queue: q}
 }
 
-func (p *ContentPublisher) publish(subject string, payload any) error {
+func (p *ContentPublisher) publish(ctx context.Context, subject string, payload any) error {
 	data, err := json.Marshal(payload)
 	if err != nil {
-		log.Printf("[PUBLISH ERROR] Failed to marshal payload for subject %s: %v", subject, err)
+		slog.ErrorContext(ctx, "failed to marshal event payload", "subject", subject, logging.Err(err))
 		return err
 	}
 
-	log.Printf("[PUBLISH] Subject: %s | Payload: %s", subject, string(data))
+	// Payloads carry user content, so only their size is logged.
+	slog.DebugContext(ctx, "publishing event", "subject", subject, "bytes", len(data))
 
-	err = p.queue.Publish(subject, data)
+	err = tracing.Publish(ctx, p.queue, subject, data)
 	if err != nil {
-		log.Printf("[PUBLISH ERROR] Failed to publish to subject %s: %v", subject, err)
+		slog.ErrorContext(ctx, "failed to publish event", "subject", subject, logging.Err(err))
 	}
 	return err
 }
 
-func (p *ContentPublisher) PublishPostCreated(payload payloads.PostCreated) error {
-	return p.publish(PostCreatedSubject, payload)
+func (p *ContentPublisher) PublishPostCreated(ctx context.Context, payload payloads.PostCreated) error {
+	return p.publish(ctx, PostCreatedSubject, payload)
 }
 
-func (p *ContentPublisher) PublishPostUpdated(payload payloads.PostUpdated) error {
-	return p.publish(PostUpdatedSubject, payload)
+func (p *ContentPublisher) PublishPostUpdated(ctx context.Context, payload payloads.PostUpdated) error {
+	return p.publish(ctx, PostUpdatedSubject, payload)
 }
 
-func (p *ContentPublisher) PublishCommentCreated(payload payloads.CommentCreated) error {
-	return p.publish(CommentCreatedSubject, payload)
+func (p *ContentPublisher) PublishPostDeleted(ctx context.Context, payload payloads.PostDeleted) error {
+	return p.publish(ctx, PostDeletedSubject, payload)
 }
 
-func (p *ContentPublisher) PublishPostReported(payload payloads.PostReported) error {
-	return p.publish(PostReportedSubject, payload)
+func (p *ContentPublisher) PublishCommentCreated(ctx context.Context, payload payloads.CommentCreated) error {
+	return p.publish(ctx, CommentCreatedSubject, payload)
 }
 
-func (p *ContentPublisher) PublishPostLiked(payload payloads.PostLiked) error {
-	return p.publish(PostLikedSubject, payload)
+func (p *ContentPublisher) PublishCommentDeleted(ctx context.Context, payload payloads.CommentDeleted) error {
+	return p.publish(ctx, CommentDeletedSubject, payload)
 }
 
-func (p *ContentPublisher) PublishCommentLiked(payload payloads.CommentLiked) error {
-	return p.publish(CommentLikedSubject, payload)
+func (p *ContentPublisher) PublishPostReported(ctx context.Context, payload payloads.PostReported) error {
+	return p.publish(ctx, PostReportedSubject, payload)
+}
+
+func (p *ContentPublisher) PublishPostLiked(ctx context.Context, payload payloads.PostLiked) error {
+	return p.publish(ctx, PostLikedSubject, payload)
+}
+
+func (p *ContentPublisher) PublishPostUnliked(ctx context.Context, payload payloads.PostUnliked) error {
+	return p.publish(ctx, PostUnlikedSubject, payload)
+}
+
+func (p *ContentPublisher) PublishPollVoted(ctx context.Context, payload payloads.PollVoted) error {
+	return p.publish(ctx, PollVotedSubject, payload)
+}
+
+func (p *ContentPublisher) PublishCommentLiked(ctx context.Context, payload payloads.CommentLiked) error {
+	return p.publish(ctx, CommentLikedSubject, payload)
 }
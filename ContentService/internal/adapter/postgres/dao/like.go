@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/postgres/model"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -34,7 +35,7 @@ func (d *LikeDao) Delete(ctx context.Context, postID, commentID, userID string)
 		query = `DELETE FROM likes WHERE comment_id = $1 AND user_id = $2`
 		args = append(args, commentID, userID)
 	} else {
-		return fmt.Errorf("postID or commentID must be provided")
+		return fmt.Errorf("postID or commentID must be provided: %w", grpcerr.ErrInvalidArgument)
 	}
 
 	_, err := d.db.ExecContext(ctx, query, args...)
@@ -53,7 +54,7 @@ func (d *LikeDao) Count(ctx context.Context, postID, commentID string) (int32, e
 		query = `SELECT COUNT(*) FROM likes WHERE comment_id = $1`
 		args = append(args, commentID)
 	} else {
-		return 0, fmt.Errorf("postID or commentID must be provided")
+		return 0, fmt.Errorf("postID or commentID must be provided: %w", grpcerr.ErrInvalidArgument)
 	}
 
 	err := d.db.QueryRowContext(ctx, query, args...).Scan(&count)
@@ -75,7 +76,7 @@ func (d *LikeDao) Exists(ctx context.Context, postID, commentID, userID string)
 		query = `SELECT COUNT(*) FROM likes WHERE comment_id = $1 AND user_id = $2`
 		args = append(args, commentID, userID)
 	} else {
-		return false, fmt.Errorf("postID or commentID must be provided")
+		return false, fmt.Errorf("postID or commentID must be provided: %w", grpcerr.ErrInvalidArgument)
 	}
 
 	err := d.db.QueryRowContext(ctx, query, args...).Scan(&count)
@@ -0,0 +1,88 @@
+package dao
+
+import (
+	"context"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/postgres/model"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type MediaDAO struct {
+	db *sqlx.DB
+}
+
+func NewMediaDAO(db *sqlx.DB) *MediaDAO {
+	return &MediaDAO{db: db}
+}
+
+func (dao *MediaDAO) Create(ctx context.Context, media *model.Media) error {
+	query := `
+		INSERT INTO media (id, owner_id, post_id, kind, content_type, size, filename, has_thumbnail, created_at)
+		VALUES (:id, :owner_id, :post_id, :kind, :content_type, :size, :filename, :has_thumbnail, :created_at)
+	`
+	_, err := dao.db.NamedExecContext(ctx, query, media)
+	return err
+}
+
+func (dao *MediaDAO) GetByID(ctx context.Context, id string) (*model.Media, error) {
+	query := `
+		SELECT id, owner_id, post_id, kind, content_type, size, filename, has_thumbnail, created_at
+		FROM media
+		WHERE id = $1
+	`
+	var media model.Media
+	if err := dao.db.GetContext(ctx, &media, query, id); err != nil {
+		return nil, err
+	}
+	return &media, nil
+}
+
+func (dao *MediaDAO) ListByIDs(ctx context.Context, ids []string) ([]*model.Media, error) {
+	query := `
+		SELECT id, owner_id, post_id, kind, content_type, size, filename, has_thumbnail, created_at
+		FROM media
+		WHERE id = ANY($1)
+	`
+	var media []*model.Media
+	err := dao.db.SelectContext(ctx, &media, query, pq.Array(ids))
+	return media, err
+}
+
+func (dao *MediaDAO) ListByPostID(ctx context.Context, postID string) ([]*model.Media, error) {
+	query := `
+		SELECT id, owner_id, post_id, kind, content_type, size, filename, has_thumbnail, created_at
+		FROM media
+		WHERE post_id = $1
+		ORDER BY created_at
+	`
+	var media []*model.Media
+	err := dao.db.SelectContext(ctx, &media, query, postID)
+	return media, err
+}
+
+// ListByPostIDs returns the media of all the given posts in one query.
+func (dao *MediaDAO) ListByPostIDs(ctx context.Context, postIDs []string) ([]*model.Media, error) {
+	query := `
+		SELECT id, owner_id, post_id, kind, content_type, size, filename, has_thumbnail, created_at
+		FROM media
+		WHERE post_id = ANY($1)
+		ORDER BY created_at
+	`
+	var media []*model.Media
+	err := dao.db.SelectContext(ctx, &media, query, pq.Array(postIDs))
+	return media, err
+}
+
+// Attach links the owner's unattached media to a post and returns how many
+// rows it claimed, so a concurrent attach of the same media can be detected.
+func (dao *MediaDAO) Attach(ctx context.Context, postID, ownerID string, ids []string) (int64, error) {
+	query := `
+		UPDATE media SET post_id = $1
+		WHERE id = ANY($3) AND owner_id = $2 AND post_id IS NULL
+	`
+	res, err := dao.db.ExecContext(ctx, query, postID, ownerID, pq.Array(ids))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
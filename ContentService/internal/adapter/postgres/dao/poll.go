@@ -3,8 +3,9 @@ package dao
 import (
 	"context"
 	"database/sql"
-	"errors"
+	"fmt"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/postgres/model"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -93,7 +94,7 @@ func (dao *PollDAO) Vote(ctx context.Context, pollID, optionID string, userID st
 		if existingOptionID == optionID {
 			return nil
 		}
-		return errors.New("user already voted for a different option")
+		return fmt.Errorf("user already voted for a different option: %w", grpcerr.ErrAlreadyExists)
 	} else if err != sql.ErrNoRows {
 		return err
 	}
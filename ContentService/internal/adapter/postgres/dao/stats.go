@@ -0,0 +1,39 @@
+package dao
+
+import (
+	"context"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
+	"github.com/jmoiron/sqlx"
+)
+
+type StatsDAO struct {
+	db *sqlx.DB
+}
+
+func NewStatsDAO(db *sqlx.DB) *StatsDAO {
+	return &StatsDAO{db: db}
+}
+
+// Counts reads every total in one round trip.
+func (dao *StatsDAO) Counts(ctx context.Context) (*entity.ContentStats, error) {
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM posts) AS posts,
+			(SELECT COUNT(*) FROM posts WHERE published) AS published_posts,
+			(SELECT COUNT(*) FROM comments) AS comments,
+			(SELECT COUNT(*) FROM likes) AS likes,
+			(SELECT COUNT(*) FROM media) AS media
+	`
+	var row struct {
+		Posts          int64 `db:"posts"`
+		PublishedPosts int64 `db:"published_posts"`
+		Comments       int64 `db:"comments"`
+		Likes          int64 `db:"likes"`
+		Media          int64 `db:"media"`
+	}
+	if err := dao.db.GetContext(ctx, &row, query); err != nil {
+		return nil, err
+	}
+	stats := entity.ContentStats(row)
+	return &stats, nil
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"database/sql"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity/enum"
+	"time"
+)
+
+type Media struct {
+	ID           string         `db:"id"`
+	OwnerID      string         `db:"owner_id"`
+	PostID       sql.NullString `db:"post_id"`
+	Kind         enum.MediaKind `db:"kind"`
+	ContentType  string         `db:"content_type"`
+	Size         int64          `db:"size"`
+	Filename     string         `db:"filename"`
+	HasThumbnail bool           `db:"has_thumbnail"`
+	CreatedAt    time.Time      `db:"created_at"`
+}
+
+func (Media) TableName() string {
+	return "media"
+}
+
+func (m *Media) ToEntity() *entity.Media {
+	return &entity.Media{
+		ID:           m.ID,
+		OwnerID:      m.OwnerID,
+		PostID:       m.PostID.String,
+		Kind:         m.Kind,
+		ContentType:  m.ContentType,
+		Size:         m.Size,
+		Filename:     m.Filename,
+		HasThumbnail: m.HasThumbnail,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+func FromEntityMedia(m *entity.Media) *Media {
+	return &Media{
+		ID:           m.ID,
+		OwnerID:      m.OwnerID,
+		PostID:       sql.NullString{String: m.PostID, Valid: m.PostID != ""},
+		Kind:         m.Kind,
+		ContentType:  m.ContentType,
+		Size:         m.Size,
+		Filename:     m.Filename,
+		HasThumbnail: m.HasThumbnail,
+		CreatedAt:    m.CreatedAt,
+	}
+}
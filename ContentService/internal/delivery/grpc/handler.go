@@ -10,26 +10,29 @@ import (
 	"github.com/KaminurOrynbek/BiznesAsh/internal/delivery/mapper"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/entity/enum"
-	_interface "github.com/KaminurOrynbek/BiznesAsh/internal/repository/interface"
 	usecase "github.com/KaminurOrynbek/BiznesAsh/internal/usecase/interface"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
 )
 
 type ContentHandler struct {
 	pb.UnimplementedContentServiceServer
 	postUsecase    usecase.PostUsecase
 	commentUsecase usecase.CommentUsecase
-	likeRepo       _interface.LikeRepository
+	mediaUsecase   usecase.MediaUsecase
+	statsUsecase   usecase.StatsUsecase
 }
 
 func NewContentHandler(
 	postUC usecase.PostUsecase,
 	commentUC usecase.CommentUsecase,
-	likeRepo _interface.LikeRepository,
+	mediaUC usecase.MediaUsecase,
+	statsUC usecase.StatsUsecase,
 ) *ContentHandler {
 	return &ContentHandler{
 		postUsecase:    postUC,
 		commentUsecase: commentUC,
-		likeRepo:       likeRepo,
+		mediaUsecase:   mediaUC,
+		statsUsecase:   statsUC,
 	}
 }
 
@@ -48,6 +51,7 @@ func (h *ContentHandler) CreatePost(ctx context.Context, req *pb.CreatePostReque
 		UpdatedAt: time.Now(),
 		Images:    req.Images,
 		Files:     req.Files,
+		MediaIDs:  req.MediaIds,
 	}
 
 	if req.Poll != nil {
@@ -65,19 +69,19 @@ func (h *ContentHandler) CreatePost(ctx context.Context, req *pb.CreatePostReque
 	}
 
 	if err := h.postUsecase.CreatePost(ctx, post); err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to create post")
 	}
 	return &pb.PostResponse{Post: mapper.ConvertPostToPB(post)}, nil
 }
 
 func (h *ContentHandler) VotePoll(ctx context.Context, req *pb.VotePollRequest) (*pb.VotePollResponse, error) {
 	if err := h.postUsecase.VotePoll(ctx, req.PostId, req.OptionId, req.UserId); err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to vote in poll")
 	}
 
 	post, err := h.postUsecase.GetPost(ctx, req.PostId, req.UserId)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to vote in poll")
 	}
 
 	return &pb.VotePollResponse{Poll: mapper.ConvertPollToPB(post.Poll)}, nil
@@ -92,14 +96,14 @@ func (h *ContentHandler) UpdatePost(ctx context.Context, req *pb.UpdatePostReque
 		UpdatedAt: time.Now(),
 	}
 	if err := h.postUsecase.UpdatePost(ctx, post); err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to update post")
 	}
 	return &pb.PostResponse{Post: mapper.ConvertPostToPB(post)}, nil
 }
 
 func (h *ContentHandler) DeletePost(ctx context.Context, req *pb.PostIdRequest) (*pb.DeleteResponse, error) {
 	if err := h.postUsecase.DeletePost(ctx, req.Id); err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to delete post")
 	}
 	return &pb.DeleteResponse{Success: true}, nil
 }
@@ -107,7 +111,7 @@ func (h *ContentHandler) DeletePost(ctx context.Context, req *pb.PostIdRequest)
 func (h *ContentHandler) GetPost(ctx context.Context, req *pb.PostIdRequest) (*pb.PostResponse, error) {
 	post, err := h.postUsecase.GetPost(ctx, req.Id, req.UserId)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to get post")
 	}
 	return &pb.PostResponse{Post: mapper.ConvertPostToPB(post)}, nil
 }
@@ -116,7 +120,7 @@ func (h *ContentHandler) ListPosts(ctx context.Context, req *pb.ListPostsRequest
 	offset := (int(req.Page) - 1) * int(req.Limit)
 	posts, err := h.postUsecase.ListPosts(ctx, offset, int(req.Limit), req.UserId)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to list posts")
 	}
 	var pbPosts []*pb.Post
 	for _, p := range posts {
@@ -129,7 +133,7 @@ func (h *ContentHandler) SearchPosts(ctx context.Context, req *pb.SearchPostsReq
 	offset := (int(req.Page) - 1) * int(req.Limit)
 	posts, err := h.postUsecase.SearchPosts(ctx, req.Query, offset, int(req.Limit), req.UserId)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to search posts")
 	}
 	var pbPosts []*pb.Post
 	for _, p := range posts {
@@ -151,7 +155,7 @@ func (h *ContentHandler) CreateComment(ctx context.Context, req *pb.CreateCommen
 		UpdatedAt: time.Now(),
 	}
 	if err := h.commentUsecase.CreateComment(ctx, comment); err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to create comment")
 	}
 	return &pb.CommentResponse{Comment: mapper.ConvertCommentToPB(comment)}, nil
 }
@@ -163,14 +167,14 @@ func (h *ContentHandler) UpdateComment(ctx context.Context, req *pb.UpdateCommen
 		UpdatedAt: time.Now(),
 	}
 	if err := h.commentUsecase.UpdateComment(ctx, comment); err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to update comment")
 	}
 	return &pb.CommentResponse{Comment: mapper.ConvertCommentToPB(comment)}, nil
 }
 
 func (h *ContentHandler) DeleteComment(ctx context.Context, req *pb.CommentIdRequest) (*pb.DeleteResponse, error) {
 	if err := h.commentUsecase.DeleteComment(ctx, req.Id); err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to delete comment")
 	}
 	return &pb.DeleteResponse{Success: true}, nil
 }
@@ -178,7 +182,7 @@ func (h *ContentHandler) DeleteComment(ctx context.Context, req *pb.CommentIdReq
 func (h *ContentHandler) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
 	comments, err := h.commentUsecase.ListCommentsByPostID(ctx, req.GetPostId(), req.GetUserId())
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to list comments")
 	}
 
 	var pbComments []*pb.Comment
@@ -199,49 +203,71 @@ func (h *ContentHandler) ListComments(ctx context.Context, req *pb.ListCommentsR
 }
 
 func (h *ContentHandler) LikePost(ctx context.Context, req *pb.LikePostRequest) (*pb.LikePostResponse, error) {
-	if err := h.likeRepo.LikePost(ctx, req.UserId, req.PostId); err != nil {
-		return nil, err
-	}
-
-	count, err := h.likeRepo.GetPostLikes(ctx, req.PostId)
+	count, err := h.postUsecase.LikePost(ctx, req.UserId, req.PostId)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to like post")
 	}
 	return &pb.LikePostResponse{LikesCount: count}, nil
 }
 
 func (h *ContentHandler) UnlikePost(ctx context.Context, req *pb.UnlikePostRequest) (*pb.UnlikePostResponse, error) {
-	if err := h.likeRepo.UnlikePost(ctx, req.UserId, req.PostId); err != nil {
-		return nil, err
-	}
-
-	count, err := h.likeRepo.GetPostLikes(ctx, req.PostId)
+	count, err := h.postUsecase.UnlikePost(ctx, req.UserId, req.PostId)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to unlike post")
 	}
 	return &pb.UnlikePostResponse{LikesCount: count}, nil
 }
 
 func (h *ContentHandler) LikeComment(ctx context.Context, req *pb.LikeCommentRequest) (*pb.LikeCommentResponse, error) {
-	if err := h.likeRepo.LikeComment(ctx, req.UserId, req.CommentId); err != nil {
-		return nil, err
-	}
-
-	count, err := h.likeRepo.GetCommentLikes(ctx, req.CommentId)
+	count, err := h.commentUsecase.LikeComment(ctx, req.UserId, req.CommentId)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to like comment")
 	}
 	return &pb.LikeCommentResponse{LikesCount: count}, nil
 }
 
 func (h *ContentHandler) UnlikeComment(ctx context.Context, req *pb.UnlikeCommentRequest) (*pb.UnlikeCommentResponse, error) {
-	if err := h.likeRepo.UnlikeComment(ctx, req.UserId, req.CommentId); err != nil {
-		return nil, err
+	count, err := h.commentUsecase.UnlikeComment(ctx, req.UserId, req.CommentId)
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to unlike comment")
+	}
+	return &pb.UnlikeCommentResponse{LikesCount: count}, nil
+}
+
+func (h *ContentHandler) CreateMedia(ctx context.Context, req *pb.CreateMediaRequest) (*pb.MediaResponse, error) {
+	media := &entity.Media{
+		ID:           req.Id,
+		OwnerID:      req.OwnerId,
+		Kind:         enum.MediaKind(req.Kind.String()),
+		ContentType:  req.ContentType,
+		Size:         req.Size,
+		Filename:     req.Filename,
+		HasThumbnail: req.HasThumbnail,
+	}
+	if err := h.mediaUsecase.CreateMedia(ctx, media); err != nil {
+		return nil, grpcerr.Wrap(err, "failed to create media")
 	}
+	return &pb.MediaResponse{Media: mapper.ConvertMediaToPB(media)}, nil
+}
 
-	count, err := h.likeRepo.GetCommentLikes(ctx, req.CommentId)
+func (h *ContentHandler) GetContentStats(ctx context.Context, _ *pb.ContentStatsRequest) (*pb.ContentStatsResponse, error) {
+	stats, err := h.statsUsecase.GetContentStats(ctx)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to get content stats")
 	}
-	return &pb.UnlikeCommentResponse{LikesCount: count}, nil
+	return &pb.ContentStatsResponse{
+		Posts:          stats.Posts,
+		PublishedPosts: stats.PublishedPosts,
+		Comments:       stats.Comments,
+		Likes:          stats.Likes,
+		Media:          stats.Media,
+	}, nil
+}
+
+func (h *ContentHandler) GetMedia(ctx context.Context, req *pb.MediaIdRequest) (*pb.MediaResponse, error) {
+	media, err := h.mediaUsecase.GetMedia(ctx, req.Id)
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to get media")
+	}
+	return &pb.MediaResponse{Media: mapper.ConvertMediaToPB(media)}, nil
 }
@@ -0,0 +1,22 @@
+package mapper
+
+import (
+	"time"
+
+	pb "github.com/KaminurOrynbek/BiznesAsh/auto-proto/content"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
+)
+
+func ConvertMediaToPB(m *entity.Media) *pb.Media {
+	return &pb.Media{
+		Id:           m.ID,
+		OwnerId:      m.OwnerID,
+		Kind:         pb.MediaKind(pb.MediaKind_value[string(m.Kind)]),
+		ContentType:  m.ContentType,
+		Size:         m.Size,
+		Filename:     m.Filename,
+		HasThumbnail: m.HasThumbnail,
+		PostId:       m.PostID,
+		CreatedAt:    m.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -13,6 +13,11 @@ func ConvertPostToPB(p *entity.Post) *pb.Post {
 		pbComments = append(pbComments, ConvertCommentToPB(c))
 	}
 
+	pbMedia := make([]*pb.Media, 0, len(p.Media))
+	for _, m := range p.Media {
+		pbMedia = append(pbMedia, ConvertMediaToPB(m))
+	}
+
 	return &pb.Post{
 		Id:            p.ID,
 		Title:         p.Title,
@@ -30,6 +35,7 @@ func ConvertPostToPB(p *entity.Post) *pb.Post {
 		Images:        p.Images,
 		Files:         p.Files,
 		Poll:          ConvertPollToPB(p.Poll),
+		Media:         pbMedia,
 	}
 }
 
@@ -7,6 +7,13 @@ const (
 	PostTypeGuide     PostType = "GUIDE"
 )
 
+type MediaKind string
+
+const (
+	MediaKindImage MediaKind = "MEDIA_IMAGE"
+	MediaKindFile  MediaKind = "MEDIA_FILE"
+)
+
 type ReactionType int32
 
 const (
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity/enum"
+	"time"
+)
+
+type Media struct {
+	ID           string
+	OwnerID      string
+	PostID       string
+	Kind         enum.MediaKind
+	ContentType  string
+	Size         int64
+	Filename     string
+	HasThumbnail bool
+	CreatedAt    time.Time
+}
@@ -22,6 +22,8 @@ type Post struct {
 	Images        []string
 	Files         []string
 	Poll          *Poll
+	MediaIDs      []string
+	Media         []*Media
 }
 
 type Poll struct {
@@ -0,0 +1,10 @@
+package entity
+
+// ContentStats counts what has been posted, for the admin dashboard.
+type ContentStats struct {
+	Posts          int64
+	PublishedPosts int64
+	Comments       int64
+	Likes          int64
+	Media          int64
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	_interface "github.com/KaminurOrynbek/BiznesAsh/internal/repository/interface"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/auth"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"google.golang.org/grpc"
+)
+
+// PolicyInterceptor enforces the shared role policy on the caller
+// authenticated by auth.UnaryServerInterceptor, resolving post and comment
+// authorship from the repositories.
+func PolicyInterceptor(postRepo _interface.PostRepository, commentRepo _interface.CommentRepository) grpc.UnaryServerInterceptor {
+	p := policy.Default()
+
+	p.RegisterOwner(policy.OwnerPostAuthor, func(ctx context.Context, id policy.Identity, req interface{}) (bool, error) {
+		r, ok := req.(interface{ GetId() string })
+		if !ok {
+			return false, nil
+		}
+		post, err := postRepo.GetByID(ctx, r.GetId(), "")
+		if err != nil {
+			return false, notFound(err)
+		}
+		return post.AuthorID == id.UserID, nil
+	})
+
+	p.RegisterOwner(policy.OwnerCommentAuthor, func(ctx context.Context, id policy.Identity, req interface{}) (bool, error) {
+		r, ok := req.(interface{ GetId() string })
+		if !ok {
+			return false, nil
+		}
+		comment, err := commentRepo.GetByID(ctx, r.GetId())
+		if err != nil {
+			return false, notFound(err)
+		}
+		return comment.AuthorID == id.UserID, nil
+	})
+
+	return policy.UnaryServerInterceptor(p, auth.Identity)
+}
+
+func notFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return policy.ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,15 @@
+-- Uploaded media. The blobs live in the gateway's blob store; a row is
+-- attached to at most one post by its owner.
+CREATE TABLE media (
+    id TEXT PRIMARY KEY,
+    owner_id TEXT NOT NULL,
+    post_id TEXT REFERENCES posts(id) ON DELETE SET NULL,
+    kind TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    filename TEXT NOT NULL DEFAULT '',
+    has_thumbnail BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_media_post_id ON media(post_id);
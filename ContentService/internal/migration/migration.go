@@ -1,23 +1,24 @@
 package migration
 
 import (
-	"fmt"
+	"log/slog"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/jmoiron/sqlx"
-	"log"
 )
 
 func RunMigrations(dsn string) {
 	db, err := sqlx.Open("postgres", dsn)
 	if err != nil {
-		log.Fatalf("failed to connect to DB: %v", err)
+		logging.Fatal("failed to connect to DB", logging.Err(err))
 	}
 
 	driver, err := postgres.WithInstance(db.DB, &postgres.Config{})
 	if err != nil {
-		log.Fatalf("failed to create driver: %v", err)
+		logging.Fatal("failed to create migration driver", logging.Err(err))
 	}
 
 	m, err := migrate.NewWithDatabaseInstance(
@@ -26,12 +27,12 @@ func RunMigrations(dsn string) {
 		driver,
 	)
 	if err != nil {
-		log.Fatalf("migration setup failed: %v", err)
+		logging.Fatal("migration setup failed", logging.Err(err))
 	}
 
 	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
-		log.Fatalf("migration failed: %v", err)
+		logging.Fatal("migration failed", logging.Err(err))
 	}
 
-	fmt.Println("✅ Database migrated successfully")
+	slog.Info("database migrated")
 }
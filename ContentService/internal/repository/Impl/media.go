@@ -0,0 +1,78 @@
+package Impl
+
+import (
+	"context"
+	"fmt"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/postgres/dao"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/postgres/model"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
+	_interface "github.com/KaminurOrynbek/BiznesAsh/internal/repository/interface"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+)
+
+type mediaRepositoryImpl struct {
+	dao *dao.MediaDAO
+}
+
+func NewMediaRepository(dao *dao.MediaDAO) _interface.MediaRepository {
+	return &mediaRepositoryImpl{dao: dao}
+}
+
+func (r *mediaRepositoryImpl) Create(ctx context.Context, media *entity.Media) error {
+	return r.dao.Create(ctx, model.FromEntityMedia(media))
+}
+
+func (r *mediaRepositoryImpl) GetByID(ctx context.Context, id string) (*entity.Media, error) {
+	m, err := r.dao.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return m.ToEntity(), nil
+}
+
+func (r *mediaRepositoryImpl) ListByIDs(ctx context.Context, ids []string) ([]*entity.Media, error) {
+	models, err := r.dao.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return toEntityMedia(models), nil
+}
+
+func (r *mediaRepositoryImpl) ListByPostID(ctx context.Context, postID string) ([]*entity.Media, error) {
+	models, err := r.dao.ListByPostID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	return toEntityMedia(models), nil
+}
+
+func (r *mediaRepositoryImpl) ListByPostIDs(ctx context.Context, postIDs []string) (map[string][]*entity.Media, error) {
+	models, err := r.dao.ListByPostIDs(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	byPost := make(map[string][]*entity.Media, len(postIDs))
+	for _, m := range models {
+		byPost[m.PostID.String] = append(byPost[m.PostID.String], m.ToEntity())
+	}
+	return byPost, nil
+}
+
+func (r *mediaRepositoryImpl) Attach(ctx context.Context, postID, ownerID string, ids []string) error {
+	n, err := r.dao.Attach(ctx, postID, ownerID, ids)
+	if err != nil {
+		return err
+	}
+	if n != int64(len(ids)) {
+		return fmt.Errorf("media already attached to another post: %w", grpcerr.ErrFailedPrecondition)
+	}
+	return nil
+}
+
+func toEntityMedia(models []*model.Media) []*entity.Media {
+	out := make([]*entity.Media, 0, len(models))
+	for _, m := range models {
+		out = append(out, m.ToEntity())
+	}
+	return out
+}
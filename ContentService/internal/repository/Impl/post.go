@@ -2,11 +2,12 @@ package Impl
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/postgres/dao"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/postgres/model"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
 	_interface "github.com/KaminurOrynbek/BiznesAsh/internal/repository/interface"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
 	"github.com/google/uuid"
 	"time"
 )
@@ -119,7 +120,7 @@ func (r *postRepositoryImpl) VotePoll(ctx context.Context, postID, optionID, use
 		return err
 	}
 	if poll == nil {
-		return errors.New("poll not found for this post")
+		return fmt.Errorf("poll for post %s: %w", postID, grpcerr.ErrNotFound)
 	}
 	return r.pollDao.Vote(ctx, poll.ID, optionID, userID)
 }
@@ -0,0 +1,20 @@
+package Impl
+
+import (
+	"context"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/postgres/dao"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
+	_interface "github.com/KaminurOrynbek/BiznesAsh/internal/repository/interface"
+)
+
+type statsRepositoryImpl struct {
+	dao *dao.StatsDAO
+}
+
+func NewStatsRepository(dao *dao.StatsDAO) _interface.StatsRepository {
+	return &statsRepositoryImpl{dao: dao}
+}
+
+func (r *statsRepositoryImpl) Counts(ctx context.Context) (*entity.ContentStats, error) {
+	return r.dao.Counts(ctx)
+}
@@ -0,0 +1,16 @@
+package _interface
+
+import (
+	"context"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
+)
+
+type MediaRepository interface {
+	Create(ctx context.Context, media *entity.Media) error
+	GetByID(ctx context.Context, id string) (*entity.Media, error)
+	ListByIDs(ctx context.Context, ids []string) ([]*entity.Media, error)
+	ListByPostID(ctx context.Context, postID string) ([]*entity.Media, error)
+	// ListByPostIDs returns the media of each of the posts, keyed by post id.
+	ListByPostIDs(ctx context.Context, postIDs []string) (map[string][]*entity.Media, error)
+	Attach(ctx context.Context, postID, ownerID string, ids []string) error
+}
@@ -0,0 +1,10 @@
+package _interface
+
+import (
+	"context"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
+)
+
+type StatsRepository interface {
+	Counts(ctx context.Context) (*entity.ContentStats, error)
+}
@@ -44,7 +44,7 @@ func (u *commentUsecaseImpl) CreateComment(ctx context.Context, comment *entity.
 	// Find post owner
 	post, err := u.postRepo.GetByID(ctx, comment.PostID, "")
 	if err == nil {
-		_ = u.contentPublisher.PublishCommentCreated(payloads.CommentCreated{
+		_ = u.contentPublisher.PublishCommentCreated(ctx, payloads.CommentCreated{
 			CommentID:    comment.ID,
 			PostID:       comment.PostID,
 			ActorID:      comment.AuthorID,
@@ -62,7 +62,16 @@ func (u *commentUsecaseImpl) UpdateComment(ctx context.Context, comment *entity.
 }
 
 func (u *commentUsecaseImpl) DeleteComment(ctx context.Context, commentID string) error {
-	return u.commentRepo.Delete(ctx, commentID)
+	comment, err := u.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if err := u.commentRepo.Delete(ctx, commentID); err != nil {
+		return err
+	}
+
+	_ = u.contentPublisher.PublishCommentDeleted(ctx, payloads.CommentDeleted{CommentID: commentID, PostID: comment.PostID})
+	return nil
 }
 
 func (u *commentUsecaseImpl) ListCommentsByPostID(ctx context.Context, postID string, currentUserID string) ([]*entity.Comment, error) {
@@ -83,3 +92,42 @@ func (u *commentUsecaseImpl) ListCommentsByPostID(ctx context.Context, postID st
 
 	return comments, nil
 }
+
+// LikeComment returns the comment's like count. Liking a comment twice is a
+// no-op and publishes nothing.
+func (u *commentUsecaseImpl) LikeComment(ctx context.Context, userID, commentID string) (int32, error) {
+	liked, err := u.likeRepo.IsCommentLiked(ctx, userID, commentID)
+	if err != nil {
+		return 0, err
+	}
+	if !liked {
+		if err := u.likeRepo.LikeComment(ctx, userID, commentID); err != nil {
+			return 0, err
+		}
+	}
+
+	count, err := u.likeRepo.GetCommentLikes(ctx, commentID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !liked {
+		comment, err := u.commentRepo.GetByID(ctx, commentID)
+		if err == nil {
+			_ = u.contentPublisher.PublishCommentLiked(ctx, payloads.CommentLiked{
+				ActorID:      userID,
+				CommentID:    commentID,
+				TargetUserID: comment.AuthorID,
+				LikesCount:   count,
+			})
+		}
+	}
+	return count, nil
+}
+
+func (u *commentUsecaseImpl) UnlikeComment(ctx context.Context, userID, commentID string) (int32, error) {
+	if err := u.likeRepo.UnlikeComment(ctx, userID, commentID); err != nil {
+		return 0, err
+	}
+	return u.likeRepo.GetCommentLikes(ctx, commentID)
+}
@@ -0,0 +1,78 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity/enum"
+	_interface "github.com/KaminurOrynbek/BiznesAsh/internal/repository/interface"
+	usecase "github.com/KaminurOrynbek/BiznesAsh/internal/usecase/interface"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+	"time"
+)
+
+// maxMediaPerPost bounds how many uploads a single post may reference.
+const maxMediaPerPost = 10
+
+type mediaUsecaseImpl struct {
+	mediaRepo _interface.MediaRepository
+}
+
+func NewMediaUsecase(mediaRepo _interface.MediaRepository) usecase.MediaUsecase {
+	return &mediaUsecaseImpl{mediaRepo: mediaRepo}
+}
+
+// CreateMedia records an upload the gateway has already stored; the id is the
+// blob key chosen by the gateway.
+func (u *mediaUsecaseImpl) CreateMedia(ctx context.Context, media *entity.Media) error {
+	if media.ID == "" || media.OwnerID == "" {
+		return fmt.Errorf("media id and owner are required: %w", grpcerr.ErrInvalidArgument)
+	}
+	if media.Kind != enum.MediaKindImage && media.Kind != enum.MediaKindFile {
+		return fmt.Errorf("unknown media kind %q: %w", media.Kind, grpcerr.ErrInvalidArgument)
+	}
+	media.PostID = ""
+	media.CreatedAt = time.Now()
+	return u.mediaRepo.Create(ctx, media)
+}
+
+func (u *mediaUsecaseImpl) GetMedia(ctx context.Context, id string) (*entity.Media, error) {
+	return u.mediaRepo.GetByID(ctx, id)
+}
+
+// verifyMedia checks that every referenced media exists, belongs to the author
+// and is not used by another post.
+func verifyMedia(ctx context.Context, repo _interface.MediaRepository, authorID string, ids []string) error {
+	if len(ids) > maxMediaPerPost {
+		return fmt.Errorf("a post may reference at most %d media: %w", maxMediaPerPost, grpcerr.ErrInvalidArgument)
+	}
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return fmt.Errorf("media %s referenced twice: %w", id, grpcerr.ErrInvalidArgument)
+		}
+		seen[id] = true
+	}
+
+	found, err := repo.ListByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*entity.Media, len(found))
+	for _, m := range found {
+		byID[m.ID] = m
+	}
+	for _, id := range ids {
+		m, ok := byID[id]
+		if !ok {
+			return fmt.Errorf("media %s: %w", id, grpcerr.ErrNotFound)
+		}
+		if m.OwnerID != authorID {
+			return fmt.Errorf("media %s belongs to another user: %w", id, grpcerr.ErrPermissionDenied)
+		}
+		if m.PostID != "" {
+			return fmt.Errorf("media %s is already attached to a post: %w", id, grpcerr.ErrFailedPrecondition)
+		}
+	}
+	return nil
+}
@@ -2,25 +2,40 @@ package impl
 
 import (
 	"context"
+	"log/slog"
+
+	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/payloads"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/publisher"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
 	_interface "github.com/KaminurOrynbek/BiznesAsh/internal/repository/interface"
 	usecase "github.com/KaminurOrynbek/BiznesAsh/internal/usecase/interface"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
 	"github.com/google/uuid"
 
 	"time"
 )
 
 type postUsecaseImpl struct {
-	postRepo    _interface.PostRepository
-	commentRepo _interface.CommentRepository
-	likeRepo    _interface.LikeRepository
+	postRepo         _interface.PostRepository
+	commentRepo      _interface.CommentRepository
+	likeRepo         _interface.LikeRepository
+	mediaRepo        _interface.MediaRepository
+	contentPublisher *publisher.ContentPublisher
 }
 
-func NewPostUsecase(postRepo _interface.PostRepository, commentRepo _interface.CommentRepository, likeRepo _interface.LikeRepository) usecase.PostUsecase {
+func NewPostUsecase(
+	postRepo _interface.PostRepository,
+	commentRepo _interface.CommentRepository,
+	likeRepo _interface.LikeRepository,
+	mediaRepo _interface.MediaRepository,
+	contentPublisher *publisher.ContentPublisher,
+) usecase.PostUsecase {
 	return &postUsecaseImpl{
-		postRepo:    postRepo,
-		commentRepo: commentRepo,
-		likeRepo:    likeRepo,
+		postRepo:         postRepo,
+		commentRepo:      commentRepo,
+		likeRepo:         likeRepo,
+		mediaRepo:        mediaRepo,
+		contentPublisher: contentPublisher,
 	}
 }
 
@@ -28,16 +43,62 @@ func (u *postUsecaseImpl) CreatePost(ctx context.Context, post *entity.Post) err
 	post.ID = uuid.NewString()
 	post.CreatedAt = time.Now()
 	post.UpdatedAt = post.CreatedAt
-	return u.postRepo.Create(ctx, post)
+	if len(post.MediaIDs) > 0 {
+		if err := verifyMedia(ctx, u.mediaRepo, post.AuthorID, post.MediaIDs); err != nil {
+			return err
+		}
+	}
+	if err := u.postRepo.Create(ctx, post); err != nil {
+		return err
+	}
+	if len(post.MediaIDs) > 0 {
+		// Lost a race for the media since verification; don't keep a post
+		// without the attachments the author asked for
+		if err := u.mediaRepo.Attach(ctx, post.ID, post.AuthorID, post.MediaIDs); err != nil {
+			_ = u.postRepo.Delete(ctx, post.ID)
+			return err
+		}
+		// The post exists now; failing the call would invite a duplicate
+		media, err := u.mediaRepo.ListByPostID(ctx, post.ID)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to load media of the new post", "post_id", post.ID, logging.Err(err))
+		}
+		post.Media = media
+	}
+
+	_ = u.contentPublisher.PublishPostCreated(ctx, payloads.PostCreated{
+		PostID:   post.ID,
+		AuthorID: post.AuthorID,
+		Title:    post.Title,
+	})
+	return nil
 }
 
 func (u *postUsecaseImpl) UpdatePost(ctx context.Context, post *entity.Post) error {
 	post.UpdatedAt = time.Now()
-	return u.postRepo.Update(ctx, post)
+	if err := u.postRepo.Update(ctx, post); err != nil {
+		return err
+	}
+
+	// The update carries only the edited fields; the author comes from the stored post
+	stored, err := u.postRepo.GetByID(ctx, post.ID, "")
+	if err == nil {
+		_ = u.contentPublisher.PublishPostUpdated(ctx, payloads.PostUpdated{
+			PostID:   post.ID,
+			Title:    stored.Title,
+			AuthorID: stored.AuthorID,
+		})
+	}
+	return nil
 }
 
 func (u *postUsecaseImpl) DeletePost(ctx context.Context, id string) error {
-	return u.postRepo.Delete(ctx, id)
+	if err := u.postRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	_ = u.contentPublisher.PublishPostDeleted(ctx, payloads.PostDeleted{PostID: id})
+	return nil
 }
 
 func (u *postUsecaseImpl) GetPost(ctx context.Context, id string, currentUserID string) (*entity.Post, error) {
@@ -53,6 +114,12 @@ func (u *postUsecaseImpl) GetPost(ctx context.Context, id string, currentUserID
 	post.Comments = comments
 	post.CommentsCount = int32(len(comments))
 
+	media, err := u.mediaRepo.ListByPostID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	post.Media = media
+
 	likesCount, _ := u.likeRepo.GetPostLikes(ctx, id)
 	post.LikesCount = likesCount
 
@@ -69,6 +136,9 @@ func (u *postUsecaseImpl) ListPosts(ctx context.Context, offset, limit int, curr
 	if err != nil {
 		return nil, err
 	}
+	if err := u.loadMedia(ctx, posts); err != nil {
+		return nil, err
+	}
 
 	for _, post := range posts {
 		comments, err := u.commentRepo.ListByPostID(ctx, post.ID)
@@ -94,6 +164,9 @@ func (u *postUsecaseImpl) SearchPosts(ctx context.Context, keyword string, offse
 	if err != nil {
 		return nil, err
 	}
+	if err := u.loadMedia(ctx, posts); err != nil {
+		return nil, err
+	}
 
 	for _, post := range posts {
 		comments, err := u.commentRepo.ListByPostID(ctx, post.ID)
@@ -113,6 +186,93 @@ func (u *postUsecaseImpl) SearchPosts(ctx context.Context, keyword string, offse
 	return posts, nil
 }
 
+// loadMedia sets the media of every post, fetched in one query.
+func (u *postUsecaseImpl) loadMedia(ctx context.Context, posts []*entity.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+	ids := make([]string, len(posts))
+	for i, post := range posts {
+		ids[i] = post.ID
+	}
+	media, err := u.mediaRepo.ListByPostIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, post := range posts {
+		post.Media = media[post.ID]
+	}
+	return nil
+}
+
 func (u *postUsecaseImpl) VotePoll(ctx context.Context, postID, optionID, userID string) error {
-	return u.postRepo.VotePoll(ctx, postID, optionID, userID)
+	if err := u.postRepo.VotePoll(ctx, postID, optionID, userID); err != nil {
+		return err
+	}
+
+	// Publish the new tallies; the vote itself already succeeded
+	post, err := u.postRepo.GetByID(ctx, postID, "")
+	if err == nil && post.Poll != nil {
+		tallies := make([]payloads.PollOptionTally, 0, len(post.Poll.Options))
+		for _, o := range post.Poll.Options {
+			tallies = append(tallies, payloads.PollOptionTally{OptionID: o.ID, VotesCount: o.VotesCount})
+		}
+		_ = u.contentPublisher.PublishPollVoted(ctx, payloads.PollVoted{
+			PostID:     postID,
+			PollID:     post.Poll.ID,
+			Options:    tallies,
+			TotalVotes: post.Poll.TotalVotes,
+		})
+	}
+	return nil
+}
+
+// LikePost returns the post's like count. Liking a post twice is a no-op and
+// publishes nothing, so the author is notified once.
+func (u *postUsecaseImpl) LikePost(ctx context.Context, userID, postID string) (int32, error) {
+	liked, err := u.likeRepo.IsPostLiked(ctx, userID, postID)
+	if err != nil {
+		return 0, err
+	}
+	if !liked {
+		if err := u.likeRepo.LikePost(ctx, userID, postID); err != nil {
+			return 0, err
+		}
+	}
+
+	count, err := u.likeRepo.GetPostLikes(ctx, postID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !liked {
+		post, err := u.postRepo.GetByID(ctx, postID, "")
+		if err == nil {
+			_ = u.contentPublisher.PublishPostLiked(ctx, payloads.PostLiked{
+				ActorID:      userID,
+				PostID:       postID,
+				TargetUserID: post.AuthorID,
+				LikesCount:   count,
+			})
+		}
+	}
+	return count, nil
+}
+
+func (u *postUsecaseImpl) UnlikePost(ctx context.Context, userID, postID string) (int32, error) {
+	if err := u.likeRepo.UnlikePost(ctx, userID, postID); err != nil {
+		return 0, err
+	}
+
+	count, err := u.likeRepo.GetPostLikes(ctx, postID)
+	if err != nil {
+		return 0, err
+	}
+
+	_ = u.contentPublisher.PublishPostUnliked(ctx, payloads.PostUnliked{
+		ActorID:    userID,
+		PostID:     postID,
+		LikesCount: count,
+	})
+	return count, nil
 }
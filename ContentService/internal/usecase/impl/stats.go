@@ -0,0 +1,21 @@
+package impl
+
+import (
+	"context"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
+	_interface "github.com/KaminurOrynbek/BiznesAsh/internal/repository/interface"
+	usecase "github.com/KaminurOrynbek/BiznesAsh/internal/usecase/interface"
+)
+
+type statsUsecaseImpl struct {
+	statsRepo _interface.StatsRepository
+}
+
+func NewStatsUsecase(statsRepo _interface.StatsRepository) usecase.StatsUsecase {
+	return &statsUsecaseImpl{statsRepo: statsRepo}
+}
+
+// GetContentStats is only reachable by admins; see policy.Rules.
+func (u *statsUsecaseImpl) GetContentStats(ctx context.Context) (*entity.ContentStats, error) {
+	return u.statsRepo.Counts(ctx)
+}
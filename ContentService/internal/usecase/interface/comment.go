@@ -10,4 +10,6 @@ type CommentUsecase interface {
 	UpdateComment(ctx context.Context, comment *entity.Comment) error
 	DeleteComment(ctx context.Context, commentID string) error
 	ListCommentsByPostID(ctx context.Context, postID string, currentUserID string) ([]*entity.Comment, error)
+	LikeComment(ctx context.Context, userID, commentID string) (int32, error)
+	UnlikeComment(ctx context.Context, userID, commentID string) (int32, error)
 }
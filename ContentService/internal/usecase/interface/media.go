@@ -0,0 +1,11 @@
+package _interface
+
+import (
+	"context"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
+)
+
+type MediaUsecase interface {
+	CreateMedia(ctx context.Context, media *entity.Media) error
+	GetMedia(ctx context.Context, id string) (*entity.Media, error)
+}
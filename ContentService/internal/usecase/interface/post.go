@@ -13,4 +13,6 @@ type PostUsecase interface {
 	ListPosts(ctx context.Context, offset, limit int, currentUserID string) ([]*entity.Post, error)
 	SearchPosts(ctx context.Context, keyword string, offset, limit int, currentUserID string) ([]*entity.Post, error)
 	VotePoll(ctx context.Context, postID, optionID, userID string) error
+	LikePost(ctx context.Context, userID, postID string) (int32, error)
+	UnlikePost(ctx context.Context, userID, postID string) (int32, error)
 }
@@ -2,6 +2,7 @@ import React, { useEffect, useRef } from 'react';
 import { useNavigate } from 'react-router-dom';
 import { toast } from 'react-hot-toast';
 import { notificationService, type Notification } from '../services/notificationService';
+import { openEventStream } from '../services/eventStream';
 import { useAuth } from '../context/useAuth';
 
 export const NotificationWatcher: React.FC = () => {
@@ -26,59 +27,56 @@ export const NotificationWatcher: React.FC = () => {
         }
     };
 
-    const pollNotifications = async () => {
+    const showToast = (n: Notification) => {
+        if (processedIds.current.has(n.id)) return;
+        toast(
+            (t) => (
+                <div
+                    onClick={() => {
+                        toast.dismiss(t.id);
+                        handleToastClick(n);
+                    }}
+                    className="flex items-center gap-3 cursor-pointer"
+                >
+                    <span className="text-xl group-hover:scale-110 transition-transform">
+                        {n.type === 'COMMENT' ? '💬' : n.type.includes('LIKE') ? '❤️' : '🔔'}
+                    </span>
+                    <div className="flex-1">
+                        <p className="font-semibold text-gray-900 leading-tight">
+                            {getToastMessage(n)}
+                        </p>
+                        <p className="text-xs text-gray-400 mt-0.5">Click to view</p>
+                    </div>
+                </div>
+            ),
+            {
+                id: n.id,
+                duration: 5000,
+                position: 'bottom-right',
+                style: {
+                    borderRadius: '16px',
+                    background: '#ffffff',
+                    color: '#1f2937',
+                    border: '1px solid #f1f5f9',
+                    padding: '12px 16px',
+                    boxShadow: '0 10px 15px -3px rgba(0, 0, 0, 0.1), 0 4px 6px -2px rgba(0, 0, 0, 0.05)',
+                },
+            }
+        );
+        processedIds.current.add(n.id);
+    };
+
+    const fetchUnread = async () => {
         if (!user) return;
 
         try {
             const notifications = await notificationService.getNotifications(user.id, true);
-
-            if (notifications && notifications.length > 0) {
-                const sorted = [...notifications].sort(
-                    (a, b) => new Date(b.createdAt).getTime() - new Date(a.createdAt).getTime()
-                );
-
-                for (const n of sorted) {
-                    if (!processedIds.current.has(n.id)) {
-                        toast(
-                            (t) => (
-                                <div
-                                    onClick={() => {
-                                        toast.dismiss(t.id);
-                                        handleToastClick(n);
-                                    }}
-                                    className="flex items-center gap-3 cursor-pointer"
-                                >
-                                    <span className="text-xl group-hover:scale-110 transition-transform">
-                                        {n.type === 'COMMENT' ? '💬' : n.type.includes('LIKE') ? '❤️' : '🔔'}
-                                    </span>
-                                    <div className="flex-1">
-                                        <p className="font-semibold text-gray-900 leading-tight">
-                                            {getToastMessage(n)}
-                                        </p>
-                                        <p className="text-xs text-gray-400 mt-0.5">Click to view</p>
-                                    </div>
-                                </div>
-                            ),
-                            {
-                                id: n.id,
-                                duration: 5000,
-                                position: 'bottom-right',
-                                style: {
-                                    borderRadius: '16px',
-                                    background: '#ffffff',
-                                    color: '#1f2937',
-                                    border: '1px solid #f1f5f9',
-                                    padding: '12px 16px',
-                                    boxShadow: '0 10px 15px -3px rgba(0, 0, 0, 0.1), 0 4px 6px -2px rgba(0, 0, 0, 0.05)',
-                                },
-                            }
-                        );
-                        processedIds.current.add(n.id);
-                    }
-                }
-            }
+            const sorted = [...notifications].sort(
+                (a, b) => new Date(b.createdAt).getTime() - new Date(a.createdAt).getTime()
+            );
+            sorted.forEach(showToast);
         } catch (error) {
-            console.error('Error polling notifications:', error);
+            console.error('Error fetching notifications:', error);
         }
     };
 
@@ -88,9 +86,13 @@ export const NotificationWatcher: React.FC = () => {
             return;
         }
 
-        pollNotifications();
-        const interval = setInterval(pollNotifications, 15000);
-        return () => clearInterval(interval);
+        // Toast what arrived while offline, then follow the event stream;
+        // a reset means events were missed, so fetch the unread list again.
+        fetchUnread();
+        return openEventStream({
+            onNotification: showToast,
+            onReset: fetchUnread,
+        });
     }, [user]);
 
     return null;
@@ -2,6 +2,7 @@ import { useCallback, useEffect, useMemo, useState } from "react";
 import type { ReactNode } from "react";
 import type { User } from "../services/authService";
 import { authService } from "../services/authService";
+import { clearTokens } from "../services/api";
 import { AuthContext, type AuthContextType } from "./authContext";
 
 export const AuthProvider = ({ children }: { children: ReactNode }) => {
@@ -20,7 +21,7 @@ export const AuthProvider = ({ children }: { children: ReactNode }) => {
         const currentUser = await authService.getCurrentUser(); // calls /auth/me
         setUser(currentUser);
       } catch {
-        localStorage.removeItem("token");
+        clearTokens();
         setUser(null);
       } finally {
         setIsLoading(false);
@@ -43,7 +44,7 @@ export const AuthProvider = ({ children }: { children: ReactNode }) => {
   }, []);
 
   const logout = useCallback(() => {
-    authService.logout();
+    void authService.logout();
     setUser(null);
   }, []);
 
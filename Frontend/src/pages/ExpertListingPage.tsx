@@ -1,4 +1,4 @@
-import React, { useState, useEffect } from 'react';
+import React, { useState, useEffect, useRef } from 'react';
 import { useNavigate } from 'react-router-dom';
 import { useTranslation } from 'react-i18next';
 import { ArrowLeft, Calendar, Clock, CheckCircle2, AlertCircle } from 'lucide-react';
@@ -42,6 +42,9 @@ export const ExpertListingPage: React.FC = () => {
     const { user } = useAuth();
     const [isSubmitting, setIsSubmitting] = useState<string | null>(null);
     const [userBookings, setUserBookings] = useState<any[]>([]);
+    // One Idempotency-Key per slot until it is booked, so double clicks and
+    // retries can't book twice.
+    const idempotencyKeys = useRef<Record<string, string>>({});
     const [selectedExpert, setSelectedExpert] = useState<string | null>(null);
     const [selectedDate, setSelectedDate] = useState<Date | null>(null);
     const [selectedSlot, setSelectedSlot] = useState<string | null>(null);
@@ -76,13 +79,16 @@ export const ExpertListingPage: React.FC = () => {
         const [hours, minutes] = selectedSlot.split(':');
         bookingDate.setHours(parseInt(hours), parseInt(minutes), 0, 0);
 
+        const slot = `${expert.id}:${bookingDate.toISOString()}`;
+        idempotencyKeys.current[slot] ??= crypto.randomUUID();
         try {
             await apiClient.post('/api/v1/consultations/book', {
                 userId: user.id,
                 expertId: expert.id,
                 expertName: t(expert.nameKey),
                 scheduledAt: bookingDate.toISOString(),
-            });
+            }, { headers: { 'Idempotency-Key': idempotencyKeys.current[slot] } });
+            delete idempotencyKeys.current[slot];
             alert(t('successBooking', {
                 name: t(expert.nameKey),
                 time: selectedSlot,
@@ -5,6 +5,12 @@ import { ArrowLeft } from 'lucide-react';
 import { useAuth } from '../context/useAuth';
 import apiClient from '../services/api';
 
+// The API only accepts plan codes; the names shown are translated.
+const planTypes: Record<string, string> = {
+  planBasic: 'BASIC',
+  planPro: 'PRO',
+};
+
 const plans = [
   {
     nameKey: 'planFree',
@@ -53,18 +59,23 @@ export const SubscriptionPage: React.FC = () => {
   const navigate = useNavigate();
   const { user } = useAuth();
   const [isSubmitting, setIsSubmitting] = React.useState<string | null>(null);
+  // One Idempotency-Key per plan until it succeeds, so double clicks and
+  // retries can't subscribe twice.
+  const idempotencyKeys = React.useRef<Record<string, string>>({});
 
   const handleSelectPlan = async (planKey: string) => {
     const planName = t(planKey);
     if (planKey === 'planFree' || !user) return;
 
     setIsSubmitting(planKey);
+    idempotencyKeys.current[planKey] ??= crypto.randomUUID();
     try {
       await apiClient.post('/api/v1/subscriptions/subscribe', {
         userId: user.id,
-        planType: planName,
+        planType: planTypes[planKey],
         durationMonths: 1, // Defaulting to 1 month for demo
-      });
+      }, { headers: { 'Idempotency-Key': idempotencyKeys.current[planKey] } });
+      delete idempotencyKeys.current[planKey];
       alert(t('subscriptionSuccess', { plan: planName }));
       navigate('/feed');
     } catch (error: any) {
@@ -2,6 +2,7 @@ import { useState, useRef, useEffect } from "react";
 import { useNavigate, useLocation, Link } from "react-router-dom";
 import { useTranslation } from "react-i18next";
 import { notificationService } from "../services/notificationService";
+import { useAuth } from "../context/useAuth";
 import { motion, AnimatePresence } from "framer-motion";
 
 // shadcn/ui
@@ -30,7 +31,9 @@ export const VerifyEmailPage = () => {
 
     const navigate = useNavigate();
     const location = useLocation();
-    const email = location.state?.email || "";
+    const { user, isAuthenticated } = useAuth();
+    // Codes are checked for the signed-in account; the address is only shown.
+    const email = location.state?.email || user?.email || "";
 
     useEffect(() => {
         // Focus first input on mount
@@ -88,15 +91,15 @@ export const VerifyEmailPage = () => {
             return;
         }
 
-        if (!email) {
-            setError(t('emailMissing'));
-            setTimeout(() => navigate("/register"), 2000);
+        if (!isAuthenticated) {
+            setError(t('signInToVerify'));
+            setTimeout(() => navigate("/login"), 2000);
             return;
         }
 
         setIsLoading(true);
         try {
-            await notificationService.verifyEmail(email, code);
+            await notificationService.verifyEmail(code);
             setSuccess(t('accountVerifiedSuccess'));
             setTimeout(() => navigate("/login"), 2500);
         } catch (err: unknown) {
@@ -110,12 +113,12 @@ export const VerifyEmailPage = () => {
     };
 
     const handleResend = async () => {
-        if (!email || resending) return;
+        if (!isAuthenticated || resending) return;
 
         setResending(true);
         setError("");
         try {
-            await notificationService.resendVerificationEmail(email);
+            await notificationService.resendVerificationEmail();
             setSuccess(t('resendSuccess'));
         } catch (err: unknown) {
             setError(t('resendFailed'));
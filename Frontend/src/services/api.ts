@@ -1,7 +1,27 @@
 import axios from 'axios';
-import type { AxiosInstance } from 'axios';
+import type { AxiosError, AxiosInstance, InternalAxiosRequestConfig } from 'axios';
 
-const API_BASE_URL = import.meta.env.VITE_API_BASE_URL || 'http://localhost:8080';
+export const API_BASE_URL = import.meta.env.VITE_API_BASE_URL || 'http://localhost:8080';
+
+export interface TokenPair {
+  token: string;
+  refreshToken?: string;
+}
+
+// Access tokens are short-lived; the refresh token is single-use and replaced
+// by every refresh, so both are always stored together.
+export const storeTokens = ({ token, refreshToken }: TokenPair) => {
+  localStorage.setItem('token', token);
+  if (refreshToken) {
+    localStorage.setItem('refreshToken', refreshToken);
+  }
+};
+
+export const clearTokens = () => {
+  localStorage.removeItem('token');
+  localStorage.removeItem('refreshToken');
+  localStorage.removeItem('userId');
+};
 
 const apiClient: AxiosInstance = axios.create({
   baseURL: API_BASE_URL,
@@ -19,12 +39,49 @@ apiClient.interceptors.request.use((config) => {
   return config;
 });
 
+// One refresh at a time: requests failing together wait for the same one,
+// since reusing a refresh token ends the session.
+let refreshing: Promise<string> | null = null;
+
+const refreshAccessToken = (): Promise<string> => {
+  if (!refreshing) {
+    const refreshToken = localStorage.getItem('refreshToken');
+    refreshing = (
+      refreshToken
+        ? axios.post<TokenPair>(`${API_BASE_URL}/auth/refresh`, { refreshToken }).then((res) => {
+            storeTokens(res.data);
+            return res.data.token;
+          })
+        : Promise.reject(new Error('no refresh token'))
+    ).finally(() => {
+      refreshing = null;
+    });
+  }
+  return refreshing;
+};
+
+type RetriableRequest = InternalAxiosRequestConfig & { _retried?: boolean };
+
+// A 401 from these means bad credentials or a dead session, not an expired access token.
+const noRefresh = ['/auth/login', '/auth/register', '/auth/refresh', '/auth/logout'];
+
 // Handle response errors
 apiClient.interceptors.response.use(
   (response) => response,
-  (error) => {
+  async (error: AxiosError) => {
+    const original = error.config as RetriableRequest | undefined;
+    if (error.response?.status === 401 && original && !original._retried && !noRefresh.includes(original.url ?? '')) {
+      original._retried = true;
+      try {
+        const token = await refreshAccessToken();
+        original.headers.Authorization = `Bearer ${token}`;
+        return apiClient(original);
+      } catch {
+        // fall through to the login redirect
+      }
+    }
     if (error.response?.status === 401) {
-      localStorage.removeItem('token');
+      clearTokens();
       window.location.href = '/login';
     }
     return Promise.reject(error);
@@ -1,4 +1,4 @@
-import apiClient from "./api";
+import apiClient, { clearTokens, storeTokens } from "./api";
 
 export interface User {
   id: string;
@@ -15,9 +15,21 @@ export interface AuthCredentials {
 
 export interface AuthResponse {
   token: string;
+  refreshToken: string;
+  expiresIn: number; // seconds the access token is valid for
   userId: string;
 }
 
+export interface Session {
+  id: string;
+  device: string;
+  ip: string;
+  createdAt: string;
+  lastSeenAt: string;
+  expiresAt: string;
+  current: boolean;
+}
+
 export type UpdateProfilePayload = {
   username?: string;
   email?: string;
@@ -26,21 +38,43 @@ export type UpdateProfilePayload = {
 export const authService = {
   login: async (credentials: AuthCredentials): Promise<AuthResponse> => {
     const res = await apiClient.post<AuthResponse>("/auth/login", credentials);
-    localStorage.setItem("token", res.data.token);
+    storeTokens(res.data);
     localStorage.setItem("userId", res.data.userId); // Store userId
     return res.data;
   },
 
   register: async (data: { username: string; email: string; password: string }): Promise<AuthResponse> => {
     const res = await apiClient.post<AuthResponse>("/auth/register", data);
-    localStorage.setItem("token", res.data.token);
+    storeTokens(res.data);
     localStorage.setItem("userId", res.data.userId); // Store userId
     return res.data;
   },
 
-  logout: () => {
-    localStorage.removeItem("token");
-    localStorage.removeItem("userId");
+  // Ends the session on the server too, so the tokens stop working everywhere.
+  logout: async () => {
+    try {
+      if (localStorage.getItem("token")) {
+        await apiClient.post("/auth/logout");
+      }
+    } catch {
+      // the session may already be gone; the local tokens are dropped regardless
+    } finally {
+      clearTokens();
+    }
+  },
+
+  logoutAllSessions: async (): Promise<void> => {
+    await apiClient.post("/auth/logout-all");
+    clearTokens();
+  },
+
+  getSessions: async (): Promise<Session[]> => {
+    const res = await apiClient.get<{ sessions: Session[] }>("/auth/sessions");
+    return res.data.sessions;
+  },
+
+  endSession: async (sessionId: string): Promise<void> => {
+    await apiClient.delete(`/auth/sessions/${sessionId}`);
   },
 
   // пока не используем /auth/me, чтобы не ломать редирект
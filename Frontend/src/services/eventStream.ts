@@ -0,0 +1,86 @@
+import apiClient, { API_BASE_URL } from './api';
+import { toNotification, type Notification } from './notificationService';
+
+// The stream is only served under /api/v1.
+const EVENTS_PATH = '/api/v1/events';
+
+const MAX_RETRY_DELAY = 30000;
+
+// Event types sent on the stream; every one carries an id to resume from.
+const EVENT_TYPES = ['ready', 'reset', 'notification', 'post.likes', 'post.comment', 'poll.tally'];
+
+interface EventTicket {
+  ticket: string;
+  expiresAt: string;
+}
+
+export interface EventStreamHandlers {
+  onNotification?: (n: Notification) => void;
+  // Called when events were missed and the client should refetch.
+  onReset?: () => void;
+  onEvent?: (type: string, data: unknown) => void;
+}
+
+// openEventStream keeps the caller's GET /events stream open and returns a
+// function that closes it. EventSource can't send the Authorization header, so
+// every connection first fetches a single-use ticket through apiClient, which
+// refreshes an expired access token, and resumes from the last event seen.
+export const openEventStream = (handlers: EventStreamHandlers): (() => void) => {
+  let source: EventSource | null = null;
+  let retry: ReturnType<typeof setTimeout> | undefined;
+  let lastEventId = '';
+  let delay = 1000;
+  let closed = false;
+
+  const reconnect = () => {
+    source?.close();
+    source = null;
+    if (closed) return;
+    retry = setTimeout(connect, delay);
+    delay = Math.min(delay * 2, MAX_RETRY_DELAY);
+  };
+
+  const onMessage = (type: string) => (e: Event) => {
+    const message = e as MessageEvent<string>;
+    lastEventId = message.lastEventId || lastEventId;
+    const data = JSON.parse(message.data || 'null');
+    if (type === 'notification') {
+      handlers.onNotification?.(toNotification(data));
+    } else if (type === 'reset') {
+      handlers.onReset?.();
+    }
+    handlers.onEvent?.(type, data);
+  };
+
+  async function connect() {
+    let ticket: EventTicket;
+    try {
+      ticket = (await apiClient.post<EventTicket>(`${EVENTS_PATH}/ticket`)).data;
+    } catch {
+      reconnect();
+      return;
+    }
+    if (closed) return;
+
+    const params = new URLSearchParams({ ticket: ticket.ticket });
+    if (lastEventId) {
+      params.set('cursor', lastEventId);
+    }
+    source = new EventSource(`${API_BASE_URL}${EVENTS_PATH}?${params}`);
+    source.onopen = () => {
+      delay = 1000;
+    };
+    // The browser would retry with the same, now used, ticket.
+    source.onerror = reconnect;
+    for (const type of EVENT_TYPES) {
+      source.addEventListener(type, onMessage(type));
+    }
+  }
+
+  connect();
+  return () => {
+    closed = true;
+    clearTimeout(retry);
+    source?.close();
+  };
+};
@@ -20,20 +20,23 @@ export interface EmailVerification {
   verified: boolean;
 }
 
+// toNotification flattens the actor and target the gateway sends in `data`.
+export const toNotification = (n: any): Notification => ({
+  ...n,
+  actorId: n.actorId || n.data?.actor_id || n.data?.actorId,
+  actorUsername: n.actorUsername || n.data?.actor_username || n.data?.actorUsername,
+  postId: n.postId || n.data?.post_id || n.data?.postId,
+  commentId: n.commentId || n.data?.comment_id || n.data?.commentId,
+  metadata: n.metadata || (n.data?.metadata ? JSON.parse(n.data.metadata) : n.metadata)
+});
+
 export const notificationService = {
   getNotifications: async (userId: string, unreadOnly = false): Promise<Notification[]> => {
     const response = await apiClient.get<any[]>('/notifications', {
       params: { userId, unreadOnly },
     });
 
-    return response.data.map(n => ({
-      ...n,
-      actorId: n.actorId || n.data?.actor_id || n.data?.actorId,
-      actorUsername: n.actorUsername || n.data?.actor_username || n.data?.actorUsername,
-      postId: n.postId || n.data?.post_id || n.data?.postId,
-      commentId: n.commentId || n.data?.comment_id || n.data?.commentId,
-      metadata: n.metadata || (n.data?.metadata ? JSON.parse(n.data.metadata) : n.metadata)
-    }));
+    return response.data.map(toNotification);
   },
 
   markAsRead: async (notificationId: string): Promise<Notification> => {
@@ -59,16 +62,17 @@ export const notificationService = {
     await apiClient.post('/notifications/subscribe', subscriptionData);
   },
 
-  verifyEmail: async (email: string, code: string): Promise<any> => {
+  // Verification is for the signed-in account, so only the code is sent.
+  verifyEmail: async (code: string): Promise<any> => {
     const response = await apiClient.post<any>(
       '/auth/verify-email',
-      { email, code }
+      { code }
     );
     return response.data;
   },
 
-  resendVerificationEmail: async (email: string): Promise<void> => {
-    await apiClient.post('/auth/resend-code', { email });
+  resendVerificationEmail: async (): Promise<void> => {
+    await apiClient.post('/auth/resend-code');
   },
 
   sendContactMessage: async (data: {
@@ -1,28 +1,35 @@
 package main
 
 import (
+	"context"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/publisher"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/subscriber"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/postgres/dao"
 	_interface "github.com/KaminurOrynbek/BiznesAsh/internal/usecase/interface"
 	"github.com/KaminurOrynbek/BiznesAsh_lib/adapter/nats"
-	natscfg "github.com/KaminurOrynbek/BiznesAsh_lib/config/nats"
-	postgresCfg "github.com/KaminurOrynbek/BiznesAsh_lib/config/postgres"
-	"github.com/KaminurOrynbek/BiznesAsh_lib/config/service"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/auth"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/config"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/health"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/jwks"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/metrics"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
 	"github.com/jmoiron/sqlx"
-	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
-	"log"
+	"log/slog"
 	"net"
-	"os"
 
 	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/postgres"
 	delivery "github.com/KaminurOrynbek/BiznesAsh/internal/delivery/grpc"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/migration"
 	repo "github.com/KaminurOrynbek/BiznesAsh/internal/repository/impl"
 	usecaseImpl "github.com/KaminurOrynbek/BiznesAsh/internal/usecase/impl"
 	notificationpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/notification"
 
 	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/validate"
 
 	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
 )
@@ -35,59 +42,61 @@ type combinedUsecase struct {
 }
 
 func main() {
-	// Load .env if exists
-	err := godotenv.Load(".env")
-	if err != nil {
-		log.Println(".env file not found, continuing...")
-	}
+	var cfg config.NotificationService
+	config.MustLoad("NotificationService", &cfg)
+	logging.Init("NotificationService")
+
+	shutdownTracing := tracing.MustInit("NotificationService")
+	defer shutdownTracing(context.Background())
 
 	// Init Postgres
-	pgConfig := postgresCfg.LoadPostgresConfig()
-	db, err := sqlx.Connect("postgres", pgConfig.DSN())
+	migration.RunMigrations(cfg.Postgres.DSN())
+	db, err := sqlx.Connect("postgres", cfg.Postgres.DSN())
 	if err != nil {
-		log.Fatalf("Failed to connect to Postgres: %v", err)
+		logging.Fatal("failed to connect to Postgres", logging.Err(err))
 	}
 	defer db.Close()
-	log.Println("Successfully connected to Postgres!")
+	slog.Info("connected to Postgres")
+	metrics.RegisterDB("NotificationService", db.DB)
 
 	// NATS setup
-	natsConfig := natscfg.LoadNatsConfig()
-	natsConn := nats.NewConnection(natsConfig)
+	natsConn := nats.NewConnection(cfg.NATS)
 	defer natsConn.Close()
 
 	notificationDAO := dao.NewNotificationDAO(db)
 	subscriptionDAO := dao.NewSubscriptionDAO(db)
 	verificationDAO := dao.NewVerificationDAO(db)
 
-	serviceConfig := service.LoadServiceConfig()
-	emailSender := postgres.NewEmailSender(serviceConfig)
+	emailSender := postgres.NewEmailSender(cfg.SMTP)
 
 	notificationRepo := repo.NewNotificationRepository(notificationDAO)
 	subscriptionRepo := repo.NewSubscriptionRepository(subscriptionDAO)
 	verificationRepo := repo.NewVerificationRepository(verificationDAO)
 
 	// User service client
-	userSvcAddr := os.Getenv("USER_SERVICE_ADDR")
-	if userSvcAddr == "" {
-		userSvcAddr = "localhost:50051"
-	}
-	userConn, err := grpc.Dial(userSvcAddr, grpc.WithInsecure())
+	userConn, err := grpc.Dial(cfg.UserServiceAddr, grpc.WithInsecure(), tracing.DialOption())
 	if err != nil {
-		log.Fatalf("Failed to connect to UserService: %v", err)
+		logging.Fatal("failed to connect to UserService", logging.Err(err))
 	}
 	defer userConn.Close()
 	userClient := userpb.NewUserServiceClient(userConn)
 
+	// Access tokens are verified with the keys UserService publishes. This
+	// service has no Redis, so tokens revoked before they expire are only
+	// refused by the gateway.
+	tokenKeys := jwks.NewCache(auth.JWKSFetcher(userClient), jwks.DefaultMaxAge)
+
+	// NATS Queue initialization
+	natsQueue := queue.NewNATSQueue(natsConn)
+	notificationPublisher := publisher.NewNotificationPublisher(natsQueue)
+
 	combined := &combinedUsecase{
-		NotificationUsecase: usecaseImpl.NewNotificationUsecase(notificationRepo, userClient, emailSender),
-		VerificationUsecase: usecaseImpl.NewVerificationUsecase(verificationRepo, emailSender),
+		NotificationUsecase: usecaseImpl.NewNotificationUsecase(notificationRepo, userClient, emailSender, notificationPublisher),
+		VerificationUsecase: usecaseImpl.NewVerificationUsecase(verificationRepo, emailSender, notificationPublisher),
 		SubscriptionUsecase: usecaseImpl.NewSubscriptionUsecase(subscriptionRepo),
 		EmailSender:         emailSender,
 	}
 
-	// NATS Queue initialization
-	natsQueue := queue.NewNATSQueue(natsConn)
-
 	// Content Subscriber Setup
 	contentSubscriber := subscriber.NewContentSubscriber(natsQueue, combined.NotificationUsecase)
 
@@ -101,33 +110,36 @@ func main() {
 	_ = contentSubscriber.SubscribeCommentLiked()
 
 	// Subscribe to NATS events
-	subscriber.InitUserSubscribers(natsQueue, combined)
+	subscriber.InitUserSubscribers(natsQueue, combined, cfg.PasswordResetURL)
 
 	// Close NATS connection gracefully
 	defer func() {
 		if err := natsQueue.Close(); err != nil {
-			log.Println("Error closing NATS:", err)
+			slog.Error("error closing NATS", logging.Err(err))
 		}
 	}()
 
-	grpcPort := os.Getenv("GRPC_PORT")
-	if grpcPort == "" {
-		log.Fatal("GRPC_PORT is not set in environment variables")
-	}
-
-	lis, err := net.Listen("tcp", ":"+grpcPort)
+	lis, err := net.Listen("tcp", ":"+cfg.GRPCPort)
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		logging.Fatal("failed to listen", logging.Err(err))
 	}
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		tracing.ServerOption(),
+		grpc.ChainUnaryInterceptor(logging.UnaryServerInterceptor(), metrics.UnaryServerInterceptor(), auth.UnaryServerInterceptor(tokenKeys, nil), policy.UnaryServerInterceptor(policy.Default(), auth.Identity), validate.UnaryServerInterceptor()),
+	)
 
 	// Register NotificationService server
 	notificationpb.RegisterNotificationServiceServer(grpcServer, delivery.NewNotificationDelivery(combined))
+	health.Register(grpcServer, notificationpb.NotificationService_ServiceDesc.ServiceName, health.Postgres(db.DB), health.NATS(natsConn))
+	if missing := policy.Uncovered(grpcServer); len(missing) > 0 {
+		logging.Fatal("gRPC methods without a policy rule", "methods", missing)
+	}
+	metrics.Serve(cfg.MetricsPort)
 
-	log.Printf("NotificationService gRPC server started on port %s", grpcPort)
+	slog.Info("gRPC server listening", "port", cfg.GRPCPort)
 
 	// Start serving gRPC requests
 	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+		logging.Fatal("failed to serve", logging.Err(err))
 	}
 }
@@ -0,0 +1,14 @@
+package payloads
+
+// NotificationCreated describes a stored notification; CreatedAt is RFC 3339.
+type NotificationCreated struct {
+	ID            string `json:"id"`
+	UserID        string `json:"user_id"`
+	Type          string `json:"type"`
+	Message       string `json:"message"`
+	ActorID       string `json:"actor_id,omitempty"`
+	ActorUsername string `json:"actor_username,omitempty"`
+	PostID        string `json:"post_id,omitempty"`
+	CommentID     string `json:"comment_id,omitempty"`
+	CreatedAt     string `json:"created_at"`
+}
@@ -4,5 +4,47 @@ type UserEventPayload struct {
 	UserID string `json:"user_id"`
 	Email  string `json:"email,omitempty"`
 	Role   string `json:"role,omitempty"`
-	Reason string `json:"reason,omitempty"`
+}
+
+// BanReason says why a ban was applied or lifted.
+type BanReason struct {
+	Code    string `json:"code"`
+	Details string `json:"details,omitempty"`
+}
+
+// UserBanEventPayload arrives on user.banned and user.unbanned. ExpiresAt
+// (RFC 3339) is set for suspensions.
+type UserBanEventPayload struct {
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email,omitempty"`
+	Reason    BanReason `json:"reason"`
+	ExpiresAt string    `json:"expires_at,omitempty"`
+	ActorID   string    `json:"actor_id,omitempty"`
+}
+
+// PasswordResetEventPayload arrives on user.password_reset_requested. Token is
+// the plain reset token; ExpiresAt is RFC 3339.
+type PasswordResetEventPayload struct {
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// PasswordChangedEventPayload arrives on user.password_changed. Method is
+// "reset" or "change".
+type PasswordChangedEventPayload struct {
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	Method    string `json:"method"`
+	ChangedAt string `json:"changed_at"`
+}
+
+// EmailVerifiedEventPayload is published on user.email_verified when a user
+// enters their verification code, for UserService to record. VerifiedAt is
+// RFC 3339.
+type EmailVerifiedEventPayload struct {
+	UserID     string `json:"user_id"`
+	Email      string `json:"email"`
+	VerifiedAt string `json:"verified_at"`
 }
@@ -0,0 +1,56 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/payloads"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
+)
+
+const (
+	NotificationCreatedSubject = "notification.created"
+	EmailVerifiedSubject       = "user.email_verified"
+)
+
+type NotificationPublisher struct {
+	queue queue.MessageQueue
+}
+
+func NewNotificationPublisher(q queue.MessageQueue) *NotificationPublisher {
+	return &NotificationPublisher{queue: q}
+}
+
+// PublishNotificationCreated announces a stored notification so the gateway can
+// push it to the recipient's open streams.
+func (p *NotificationPublisher) PublishNotificationCreated(ctx context.Context, payload payloads.NotificationCreated) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to marshal event payload", "subject", NotificationCreatedSubject, logging.Err(err))
+		return err
+	}
+
+	err = tracing.Publish(ctx, p.queue, NotificationCreatedSubject, data)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to publish event", "subject", NotificationCreatedSubject, logging.Err(err))
+	}
+	return err
+}
+
+// PublishEmailVerified tells UserService the user confirmed their address.
+func (p *NotificationPublisher) PublishEmailVerified(ctx context.Context, payload payloads.EmailVerifiedEventPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to marshal event payload", "subject", EmailVerifiedSubject, logging.Err(err))
+		return err
+	}
+
+	err = tracing.Publish(ctx, p.queue, EmailVerifiedSubject, data)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to publish event", "subject", EmailVerifiedSubject, logging.Err(err))
+	}
+	return err
+}
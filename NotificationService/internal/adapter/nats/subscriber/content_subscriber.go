@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/payloads"
 	usecase "github.com/KaminurOrynbek/BiznesAsh/internal/usecase/interface"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
 	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
-	"log"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
+	"log/slog"
 
 	"context"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
@@ -24,103 +26,103 @@ func NewContentSubscriber(q queue.MessageQueue, ns usecase.NotificationUsecase)
 }
 
 func (s *ContentSubscriber) SubscribePostCreated() error {
-	return s.queue.Subscribe("post.created", func(msg []byte) {
+	return tracing.Subscribe(s.queue, "post.created", func(ctx context.Context, msg []byte) {
 		var payload payloads.PostCreated
 		err := json.Unmarshal(msg, &payload)
 		if err != nil {
-			log.Printf("Error unmarshalling PostCreated message: %v", err)
+			slog.ErrorContext(ctx, "failed to parse event", "subject", "post.created", logging.Err(err))
 			return
 		}
-		s.handlePostCreated(payload)
+		s.handlePostCreated(ctx, payload)
 	})
 }
 
 func (s *ContentSubscriber) SubscribePostUpdated() error {
-	return s.queue.Subscribe("post.updated", func(msg []byte) {
+	return tracing.Subscribe(s.queue, "post.updated", func(ctx context.Context, msg []byte) {
 		var payload payloads.PostUpdated
 		err := json.Unmarshal(msg, &payload)
 		if err != nil {
-			log.Printf("Error unmarshalling PostUpdated message: %v", err)
+			slog.ErrorContext(ctx, "failed to parse event", "subject", "post.updated", logging.Err(err))
 			return
 		}
-		s.handlePostUpdated(payload)
+		s.handlePostUpdated(ctx, payload)
 	})
 }
 
 func (s *ContentSubscriber) SubscribeCommentCreated() error {
-	return s.queue.Subscribe("comment.created", func(msg []byte) {
+	return tracing.Subscribe(s.queue, "comment.created", func(ctx context.Context, msg []byte) {
 		var payload payloads.CommentCreated
 		err := json.Unmarshal(msg, &payload)
 		if err != nil {
-			log.Printf("Error unmarshalling CommentCreated message: %v", err)
+			slog.ErrorContext(ctx, "failed to parse event", "subject", "comment.created", logging.Err(err))
 			return
 		}
-		s.handleCommentCreated(payload)
+		s.handleCommentCreated(ctx, payload)
 	})
 }
 
 func (s *ContentSubscriber) SubscribePostReported() error {
-	return s.queue.Subscribe("post.reported", func(msg []byte) {
+	return tracing.Subscribe(s.queue, "post.reported", func(ctx context.Context, msg []byte) {
 		var payload payloads.PostReported
 		err := json.Unmarshal(msg, &payload)
 		if err != nil {
-			log.Printf("Error unmarshalling PostReported message: %v", err)
+			slog.ErrorContext(ctx, "failed to parse event", "subject", "post.reported", logging.Err(err))
 			return
 		}
-		s.handlePostReported(payload)
+		s.handlePostReported(ctx, payload)
 	})
 }
 
 func (s *ContentSubscriber) SubscribePostLiked() error {
-	return s.queue.Subscribe("post.liked", func(msg []byte) {
+	return tracing.Subscribe(s.queue, "post.liked", func(ctx context.Context, msg []byte) {
 		var payload payloads.PostLiked
 		if err := json.Unmarshal(msg, &payload); err != nil {
-			log.Printf("Failed to parse PostLiked: %v", err)
+			slog.ErrorContext(ctx, "failed to parse event", "subject", "post.liked", logging.Err(err))
 			return
 		}
-		s.handlePostLiked(payload)
+		s.handlePostLiked(ctx, payload)
 	})
 }
 
 func (s *ContentSubscriber) SubscribeCommentLiked() error {
-	return s.queue.Subscribe("comment.liked", func(msg []byte) {
+	return tracing.Subscribe(s.queue, "comment.liked", func(ctx context.Context, msg []byte) {
 		var payload payloads.CommentLiked
 		if err := json.Unmarshal(msg, &payload); err != nil {
-			log.Printf("Failed to parse CommentLiked: %v", err)
+			slog.ErrorContext(ctx, "failed to parse event", "subject", "comment.liked", logging.Err(err))
 			return
 		}
-		s.handleCommentLiked(payload)
+		s.handleCommentLiked(ctx, payload)
 	})
 }
 
-func (s *ContentSubscriber) handlePostCreated(payload payloads.PostCreated) {
-	log.Printf("New post created: %s by author: %s", payload.Title, payload.AuthorID)
+func (s *ContentSubscriber) handlePostCreated(ctx context.Context, payload payloads.PostCreated) {
+	slog.InfoContext(ctx, "post created", "post_id", payload.PostID, "author_id", payload.AuthorID)
 	notification := &entity.Notification{
 		UserID:  payload.AuthorID,
 		Message: "A new post was created: " + payload.Title,
 		PostID:  &payload.PostID,
 	}
-	err := s.notificationUsecase.NotifyNewPost(context.Background(), notification)
+	err := s.notificationUsecase.NotifyNewPost(ctx, notification)
 	if err != nil {
-		log.Printf("Error sending new post notification: %v", err)
+		slog.ErrorContext(ctx, "failed to send new post notification", logging.Err(err))
 	}
 }
 
-func (s *ContentSubscriber) handlePostUpdated(payload payloads.PostUpdated) {
-	log.Printf("Post updated: %s", payload.PostID)
+func (s *ContentSubscriber) handlePostUpdated(ctx context.Context, payload payloads.PostUpdated) {
+	slog.InfoContext(ctx, "post updated", "post_id", payload.PostID)
 	notification := &entity.Notification{
 		UserID:  payload.AuthorID,
 		Message: "Your post has been updated.",
 		PostID:  &payload.PostID,
 	}
-	err := s.notificationUsecase.NotifyPostUpdate(context.Background(), notification)
+	err := s.notificationUsecase.NotifyPostUpdate(ctx, notification)
 	if err != nil {
-		log.Printf("Error sending post update notification: %v", err)
+		slog.ErrorContext(ctx, "failed to send post update notification", logging.Err(err))
 	}
 }
 
-func (s *ContentSubscriber) handleCommentCreated(payload payloads.CommentCreated) {
-	log.Printf("New comment created on post: %s by actor: %s (target %s)", payload.PostID, payload.ActorID, payload.TargetUserID)
+func (s *ContentSubscriber) handleCommentCreated(ctx context.Context, payload payloads.CommentCreated) {
+	slog.InfoContext(ctx, "comment created", "post_id", payload.PostID, "actor_id", payload.ActorID, "target_user_id", payload.TargetUserID)
 	notification := &entity.Notification{
 		UserID:    payload.TargetUserID,
 		ActorID:   payload.ActorID,
@@ -131,49 +133,49 @@ func (s *ContentSubscriber) handleCommentCreated(payload payloads.CommentCreated
 			"content": payload.Content,
 		},
 	}
-	err := s.notificationUsecase.SendCommentNotification(context.Background(), notification)
+	err := s.notificationUsecase.SendCommentNotification(ctx, notification)
 	if err != nil {
-		log.Printf("Error sending comment notification: %v", err)
+		slog.ErrorContext(ctx, "failed to send comment notification", logging.Err(err))
 	}
 }
 
-func (s *ContentSubscriber) handlePostReported(payload payloads.PostReported) {
-	log.Printf("Post reported: %s by reporter: %s, reason: %s", payload.PostID, payload.ReporterID, payload.Reason)
+func (s *ContentSubscriber) handlePostReported(ctx context.Context, payload payloads.PostReported) {
+	slog.InfoContext(ctx, "post reported", "post_id", payload.PostID, "reporter_id", payload.ReporterID)
 	notification := &entity.Notification{
 		UserID:  payload.ReporterID,
 		Message: "A post has been reported: " + payload.PostID,
 		PostID:  &payload.PostID,
 	}
-	err := s.notificationUsecase.SendReportNotification(context.Background(), notification)
+	err := s.notificationUsecase.SendReportNotification(ctx, notification)
 	if err != nil {
-		log.Printf("Error sending report notification: %v", err)
+		slog.ErrorContext(ctx, "failed to send report notification", logging.Err(err))
 	}
 }
 
-func (s *ContentSubscriber) handlePostLiked(payload payloads.PostLiked) {
-	log.Printf("Post liked: %s (actor %s, target %s)", payload.PostID, payload.ActorID, payload.TargetUserID)
+func (s *ContentSubscriber) handlePostLiked(ctx context.Context, payload payloads.PostLiked) {
+	slog.InfoContext(ctx, "post liked", "post_id", payload.PostID, "actor_id", payload.ActorID, "target_user_id", payload.TargetUserID)
 	notification := &entity.Notification{
 		UserID:  payload.TargetUserID,
 		ActorID: payload.ActorID,
 		Message: "Your post got a new like!",
 		PostID:  &payload.PostID,
 	}
-	err := s.notificationUsecase.NotifyPostLike(context.Background(), notification)
+	err := s.notificationUsecase.NotifyPostLike(ctx, notification)
 	if err != nil {
-		log.Printf("Failed to notify post like: %v", err)
+		slog.ErrorContext(ctx, "failed to send post like notification", logging.Err(err))
 	}
 }
 
-func (s *ContentSubscriber) handleCommentLiked(payload payloads.CommentLiked) {
-	log.Printf("Comment liked: %s (actor %s, target %s)", payload.CommentID, payload.ActorID, payload.TargetUserID)
+func (s *ContentSubscriber) handleCommentLiked(ctx context.Context, payload payloads.CommentLiked) {
+	slog.InfoContext(ctx, "comment liked", "comment_id", payload.CommentID, "actor_id", payload.ActorID, "target_user_id", payload.TargetUserID)
 	notification := &entity.Notification{
 		UserID:    payload.TargetUserID,
 		ActorID:   payload.ActorID,
 		Message:   "Your comment got a new like!",
 		CommentID: &payload.CommentID,
 	}
-	err := s.notificationUsecase.NotifyCommentLike(context.Background(), notification)
+	err := s.notificationUsecase.NotifyCommentLike(ctx, notification)
 	if err != nil {
-		log.Printf("Failed to notify comment like: %v", err)
+		slog.ErrorContext(ctx, "failed to send comment like notification", logging.Err(err))
 	}
 }
@@ -3,36 +3,38 @@ package subscriber
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"html"
+	"log/slog"
+	"net/url"
 
 	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/payloads"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/usecase/interface"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
 	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
 )
 
-func InitUserSubscribers(q queue.MessageQueue, uc _interface.CombinedUsecase) {
+// InitUserSubscribers handles UserService events. Password reset emails link
+// to resetURL when it is set.
+func InitUserSubscribers(q queue.MessageQueue, uc _interface.CombinedUsecase, resetURL string) {
 	subscribe := func(subject string, handler func(context.Context, payloads.UserEventPayload)) {
-		err := q.Subscribe(subject, func(data []byte) {
+		err := tracing.Subscribe(q, subject, func(ctx context.Context, data []byte) {
 			var payload payloads.UserEventPayload
 			if err := json.Unmarshal(data, &payload); err != nil {
-				log.Printf("❌ Failed to parse payload for %s: %v", subject, err)
+				slog.ErrorContext(ctx, "failed to parse event", "subject", subject, logging.Err(err))
 				return
 			}
-			handler(context.Background(), payload)
+			handler(ctx, payload)
 		})
 		if err != nil {
-			log.Printf("❌ Failed to subscribe to %s: %v", subject, err)
+			slog.Error("failed to subscribe", "subject", subject, logging.Err(err))
 		}
 	}
 
 	// Handle user registration
 	subscribe("user.registered", func(ctx context.Context, payload payloads.UserEventPayload) {
-		_ = uc.SendVerificationEmail(ctx, &entity.Email{
-			To:      payload.Email,
-			Subject: "Please verify your email",
-			Body:    "Welcome! Your verification code will arrive shortly.", 
-		})
+		_ = uc.SendVerificationCode(ctx, payload.UserID, payload.Email)
 		_ = uc.SendEmail(ctx, &entity.Email{
 			To:      payload.Email,
 			Subject: "Welcome to BiznesAsh!",
@@ -73,11 +75,116 @@ func InitUserSubscribers(q queue.MessageQueue, uc _interface.CombinedUsecase) {
 	})
 
 	// Handle bans
-	subscribe("user.banned", func(ctx context.Context, payload payloads.UserEventPayload) {
+	subscribeBan := func(subject string, handler func(context.Context, payloads.UserBanEventPayload)) {
+		err := tracing.Subscribe(q, subject, func(ctx context.Context, data []byte) {
+			var payload payloads.UserBanEventPayload
+			if err := json.Unmarshal(data, &payload); err != nil {
+				slog.ErrorContext(ctx, "failed to parse event", "subject", subject, logging.Err(err))
+				return
+			}
+			handler(ctx, payload)
+		})
+		if err != nil {
+			slog.Error("failed to subscribe", "subject", subject, logging.Err(err))
+		}
+	}
+
+	subscribeBan("user.banned", func(ctx context.Context, payload payloads.UserBanEventPayload) {
+		subject, body := "Account Banned", "Your account has been banned."
+		if payload.ExpiresAt != "" {
+			subject, body = "Account Suspended", "Your account has been suspended until "+payload.ExpiresAt+"."
+		}
+		body += "\n\nReason: " + banReasonText(payload.Reason.Code)
+		if payload.Reason.Details != "" {
+			body += "\n" + payload.Reason.Details
+		}
+		_ = uc.SendEmail(ctx, &entity.Email{
+			To:      payload.Email,
+			Subject: subject,
+			Body:    body,
+		})
+	})
+
+	subscribeBan("user.unbanned", func(ctx context.Context, payload payloads.UserBanEventPayload) {
+		body := "Your account has been restored and you can sign in again."
+		if payload.Reason.Details != "" {
+			body += "\n\n" + payload.Reason.Details
+		}
 		_ = uc.SendEmail(ctx, &entity.Email{
 			To:      payload.Email,
-			Subject: "Account Banned",
-			Body:    "Your account has been banned for the following reason: " + payload.Reason,
+			Subject: "Account Restored",
+			Body:    body,
 		})
 	})
+
+	// Handle password resets
+	err := tracing.Subscribe(q, "user.password_reset_requested", func(ctx context.Context, data []byte) {
+		var payload payloads.PasswordResetEventPayload
+		if err := json.Unmarshal(data, &payload); err != nil {
+			slog.ErrorContext(ctx, "failed to parse event", "subject", "user.password_reset_requested", logging.Err(err))
+			return
+		}
+		_ = uc.SendEmail(ctx, &entity.Email{
+			To:      payload.Email,
+			Subject: "Reset your password",
+			Body:    passwordResetEmailHTML(resetURL, payload),
+		})
+	})
+	if err != nil {
+		slog.Error("failed to subscribe", "subject", "user.password_reset_requested", logging.Err(err))
+	}
+
+	err = tracing.Subscribe(q, "user.password_changed", func(ctx context.Context, data []byte) {
+		var payload payloads.PasswordChangedEventPayload
+		if err := json.Unmarshal(data, &payload); err != nil {
+			slog.ErrorContext(ctx, "failed to parse event", "subject", "user.password_changed", logging.Err(err))
+			return
+		}
+		how := "changed"
+		if payload.Method == "reset" {
+			how = "reset through a reset email"
+		}
+		_ = uc.SendEmail(ctx, &entity.Email{
+			To:      payload.Email,
+			Subject: "Your password was changed",
+			Body: "<p>The password of your BiznesAsh account was " + how + " at " + html.EscapeString(payload.ChangedAt) + ". " +
+				"You have been signed out on every device.</p>" +
+				"<p>If this wasn't you, reset your password right away and contact support.</p>",
+		})
+	})
+	if err != nil {
+		slog.Error("failed to subscribe", "subject", "user.password_changed", logging.Err(err))
+	}
+}
+
+// banReasonText turns a ban reason code into words for the email.
+func banReasonText(code string) string {
+	switch code {
+	case "spam":
+		return "Spam"
+	case "harassment":
+		return "Harassment"
+	case "inappropriate_content":
+		return "Inappropriate content"
+	case "fraud":
+		return "Fraud"
+	case "impersonation":
+		return "Impersonation"
+	}
+	return "Violation of the community rules"
+}
+
+// passwordResetEmailHTML builds the reset email: a link to resetURL carrying
+// the token, or just the token when no URL is configured.
+func passwordResetEmailHTML(resetURL string, payload payloads.PasswordResetEventPayload) string {
+	body := "<p>We received a request to reset the password of your BiznesAsh account.</p>"
+	if resetURL != "" {
+		link := resetURL + "?token=" + url.QueryEscape(payload.Token)
+		body += `<p><a href="` + html.EscapeString(link) + `">Choose a new password</a></p>`
+	} else {
+		body += "<p>Your reset token: <code>" + html.EscapeString(payload.Token) + "</code></p>"
+	}
+	body += "<p>It works once and expires at " + html.EscapeString(payload.ExpiresAt) + ". " +
+		"If you didn't ask to reset your password, ignore this email; your password stays the same.</p>"
+	return body
 }
@@ -16,9 +16,20 @@ func NewVerificationDAO(db *sqlx.DB) *VerificationDAO {
 	return &VerificationDAO{db: db}
 }
 
+// Save stores v as the user's code, replacing the codes sent before.
 func (dao *VerificationDAO) Save(ctx context.Context, v *model.Verification) error {
-	query := `INSERT INTO verifications (user_id, email, code, expires_at, is_used)
-	          VALUES (:user_id, :email, :code, :expires_at, :is_used)`
+	tx, err := dao.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM verifications WHERE user_id = $1`, v.UserID); err != nil {
+		return err
+	}
+
+	query := `INSERT INTO verifications (user_id, email, code, expires_at, is_used, attempts)
+	          VALUES (:user_id, :email, :code, :expires_at, :is_used, :attempts)`
 
 	insert := struct {
 		UserID    string    `db:"user_id"`
@@ -26,22 +37,20 @@ func (dao *VerificationDAO) Save(ctx context.Context, v *model.Verification) err
 		Code      string    `db:"code"`
 		ExpiresAt time.Time `db:"expires_at"`
 		IsUsed    bool      `db:"is_used"`
+		Attempts  int       `db:"attempts"`
 	}{
 		UserID:    v.UserID,
 		Email:     v.Email,
 		Code:      v.Code,
 		ExpiresAt: v.ExpiresAt,
 		IsUsed:    v.IsUsed,
+		Attempts:  v.Attempts,
 	}
 
-	_, err := dao.db.NamedExecContext(ctx, query, insert)
-	return err
-}
-
-func (dao *VerificationDAO) UpdateCode(ctx context.Context, userID, newCode string) error {
-	query := `UPDATE verifications SET code = $1, expires_at = now() + interval '10 minutes', is_used = false WHERE user_id = $2`
-	_, err := dao.db.ExecContext(ctx, query, newCode, userID)
-	return err
+	if _, err := tx.NamedExecContext(ctx, query, insert); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func (dao *VerificationDAO) MarkUsed(ctx context.Context, userID string) error {
@@ -50,9 +59,11 @@ func (dao *VerificationDAO) MarkUsed(ctx context.Context, userID string) error {
 	return err
 }
 
+// GetByUserID returns the latest code sent to the user.
 func (dao *VerificationDAO) GetByUserID(ctx context.Context, userID string) (*model.Verification, error) {
 	var v model.Verification
-	query := `SELECT id, user_id, email, code, expires_at, is_used FROM verifications WHERE user_id = $1`
+	query := `SELECT id, user_id, email, code, expires_at, is_used, attempts FROM verifications
+	          WHERE user_id = $1 ORDER BY id DESC LIMIT 1`
 	err := dao.db.GetContext(ctx, &v, query, userID)
 	if err != nil {
 		return nil, err
@@ -60,9 +71,11 @@ func (dao *VerificationDAO) GetByUserID(ctx context.Context, userID string) (*mo
 	return &v, nil
 }
 
-func (dao *VerificationDAO) CheckValid(ctx context.Context, userID, code string) (bool, error) {
-	query := `SELECT COUNT(*) FROM verifications WHERE user_id = $1 AND code = $2 AND is_used = false AND expires_at > now()`
-	var count int
-	err := dao.db.GetContext(ctx, &count, query, userID, code)
-	return count > 0, err
+// IncrementAttempts counts a wrong code entered for the verification and
+// returns the new count.
+func (dao *VerificationDAO) IncrementAttempts(ctx context.Context, id string) (int, error) {
+	var attempts int
+	query := `UPDATE verifications SET attempts = attempts + 1 WHERE id = $1 RETURNING attempts`
+	err := dao.db.GetContext(ctx, &attempts, query, id)
+	return attempts, err
 }
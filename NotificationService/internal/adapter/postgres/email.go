@@ -5,27 +5,27 @@ import (
 	"fmt"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/usecase/interface"
-	"github.com/KaminurOrynbek/BiznesAsh_lib/config/service"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/config"
 	"net/smtp"
 )
 
 type smtpEmailSender struct {
-	cfg *service.Config
+	cfg config.SMTP
 }
 
-func NewEmailSender(cfg *service.Config) _interface.EmailSender {
+func NewEmailSender(cfg config.SMTP) _interface.EmailSender {
 	return &smtpEmailSender{
 		cfg: cfg,
 	}
 }
 
 func (s *smtpEmailSender) SendEmail(ctx context.Context, email *entity.Email) error {
-	auth := smtp.PlainAuth("", s.cfg.SMTPUsername, s.cfg.SMTPPassword, s.cfg.SMTPHost)
+	auth := smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)
 
-	addr := fmt.Sprintf("%s:%s", s.cfg.SMTPHost, s.cfg.SMTPPort)
+	addr := fmt.Sprintf("%s:%s", s.cfg.Host, s.cfg.Port)
 
 	msg := []byte(
-		"From: " + s.cfg.SMTPUsername + "\n" +
+		"From: " + s.cfg.Username + "\n" +
 			"To: " + email.To + "\n" +
 			"Subject: " + email.Subject + "\n" +
 			"MIME-Version: 1.0\n" +
@@ -33,7 +33,7 @@ func (s *smtpEmailSender) SendEmail(ctx context.Context, email *entity.Email) er
 			email.Body,
 	)
 
-	err := smtp.SendMail(addr, auth, s.cfg.SMTPUsername, []string{email.To}, msg)
+	err := smtp.SendMail(addr, auth, s.cfg.Username, []string{email.To}, msg)
 	if err != nil {
 		return fmt.Errorf("failed to send email: %w", err)
 	}
@@ -12,6 +12,7 @@ type Verification struct {
 	Code      string    `db:"code"`
 	ExpiresAt time.Time `db:"expires_at"`
 	IsUsed    bool      `db:"is_used"`
+	Attempts  int       `db:"attempts"`
 }
 
 func (Verification) TableName() string {
@@ -26,6 +27,7 @@ func (v *Verification) ToEntity() *entity.Verification {
 		Code:      v.Code,
 		ExpiresAt: v.ExpiresAt,
 		IsUsed:    v.IsUsed,
+		Attempts:  v.Attempts,
 	}
 }
 
@@ -37,5 +39,6 @@ func FromEntityVerification(e *entity.Verification) *Verification {
 		Code:      e.Code,
 		ExpiresAt: e.ExpiresAt,
 		IsUsed:    e.IsUsed,
+		Attempts:  e.Attempts,
 	}
 }
@@ -6,8 +6,9 @@ import (
 	"encoding/json"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
 	_interface "github.com/KaminurOrynbek/BiznesAsh/internal/usecase/interface"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/auth"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
 	notificationpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/notification"
-	"log"
 	"time"
 )
 
@@ -30,7 +31,7 @@ func (d *NotificationDelivery) SendWelcomeEmail(ctx context.Context, req *notifi
 	}
 	err := d.usecase.SendEmail(ctx, email)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to send welcome email")
 	}
 	return &notificationpb.NotificationResponse{Success: true, Message: "Welcome Email Sent"}, nil
 }
@@ -45,7 +46,7 @@ func (d *NotificationDelivery) SendCommentNotification(ctx context.Context, req
 	}
 	err := d.usecase.SendCommentNotification(ctx, notification)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to send comment notification")
 	}
 	return &notificationpb.NotificationResponse{Success: true, Message: "Comment Notification Sent"}, nil
 }
@@ -59,7 +60,7 @@ func (d *NotificationDelivery) SendReportNotification(ctx context.Context, req *
 	}
 	err := d.usecase.SendReportNotification(ctx, notification)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to send report notification")
 	}
 	return &notificationpb.NotificationResponse{Success: true, Message: "Report Notification Sent"}, nil
 }
@@ -72,7 +73,7 @@ func (d *NotificationDelivery) NotifyNewPost(ctx context.Context, req *notificat
 	}
 	err := d.usecase.NotifyNewPost(ctx, notification)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to notify new post")
 	}
 	return &notificationpb.NotificationResponse{Success: true, Message: "New Post Notification Sent"}, nil
 }
@@ -86,52 +87,40 @@ func (d *NotificationDelivery) NotifyPostUpdate(ctx context.Context, req *notifi
 	}
 	err := d.usecase.NotifyPostUpdate(ctx, notification)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to notify post update")
 	}
 	return &notificationpb.NotificationResponse{Success: true, Message: "Post Update Notification Sent"}, nil
 }
 
 func (d *NotificationDelivery) NotifySystemMessage(ctx context.Context, req *notificationpb.SystemMessageRequest) (*notificationpb.NotificationResponse, error) {
-	msg := req.GetMessage()
-
-	// Check if this is a contact request JSON
-	var contactReq struct {
-		Type    string `json:"type"`
-		Name    string `json:"name"`
-		Email   string `json:"email"`
-		Subject string `json:"subject"`
-		Content string `json:"content"`
-	}
-
-	if err := json.Unmarshal([]byte(msg), &contactReq); err == nil && contactReq.Type == "CONTACT_REQUEST" {
-		err := d.usecase.NotifyContactRequest(ctx, contactReq.Name, contactReq.Email, contactReq.Subject, contactReq.Content)
-		if err != nil {
-			return nil, err
-		}
-		return &notificationpb.NotificationResponse{Success: true, Message: "Contact Request Processed"}, nil
-	}
-
 	notification := &entity.Notification{
 		UserID:  req.GetUserId(),
-		Message: msg,
+		Message: req.GetMessage(),
 		Type:    "SYSTEM",
 	}
 	err := d.usecase.NotifySystemMessage(ctx, notification)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to send system message")
 	}
 	return &notificationpb.NotificationResponse{Success: true, Message: "System Message Sent"}, nil
 }
 
-func (d *NotificationDelivery) SendVerificationEmail(ctx context.Context, req *notificationpb.EmailRequest) (*notificationpb.NotificationResponse, error) {
-	email := &entity.Email{
-		To:      req.GetEmail(),
-		Subject: req.GetSubject(),
-		Body:    req.GetBody(),
+// SendContactRequest forwards a message left through the public contact form.
+func (d *NotificationDelivery) SendContactRequest(ctx context.Context, req *notificationpb.ContactRequest) (*notificationpb.NotificationResponse, error) {
+	err := d.usecase.NotifyContactRequest(ctx, req.GetName(), req.GetEmail(), req.GetSubject(), req.GetMessage())
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to send contact request")
 	}
-	err := d.usecase.SendVerificationEmail(ctx, email)
+	return &notificationpb.NotificationResponse{Success: true, Message: "Contact Request Processed"}, nil
+}
+
+// SendVerificationEmail sends the caller a new code, like ResendCode. The
+// address in the request is ignored: codes go to the address the account
+// registered with.
+func (d *NotificationDelivery) SendVerificationEmail(ctx context.Context, req *notificationpb.EmailRequest) (*notificationpb.NotificationResponse, error) {
+	err := d.usecase.ResendCode(ctx, auth.Identity(ctx).UserID)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to send verification email")
 	}
 	return &notificationpb.NotificationResponse{Success: true, Message: "Verification Email Sent"}, nil
 }
@@ -139,7 +128,7 @@ func (d *NotificationDelivery) SendVerificationEmail(ctx context.Context, req *n
 func (d *NotificationDelivery) SubscribeToUpdates(ctx context.Context, req *notificationpb.UserID) (*notificationpb.NotificationResponse, error) {
 	err := d.usecase.Subscribe(ctx, req.GetUserId(), []string{})
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to subscribe")
 	}
 	return &notificationpb.NotificationResponse{Success: true, Message: "Subscribed to updates"}, nil
 }
@@ -147,7 +136,7 @@ func (d *NotificationDelivery) SubscribeToUpdates(ctx context.Context, req *noti
 func (d *NotificationDelivery) UnsubscribeFromUpdates(ctx context.Context, req *notificationpb.UserID) (*notificationpb.NotificationResponse, error) {
 	err := d.usecase.Unsubscribe(ctx, req.GetUserId(), "")
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to unsubscribe")
 	}
 	return &notificationpb.NotificationResponse{Success: true, Message: "Unsubscribed from updates"}, nil
 }
@@ -155,7 +144,7 @@ func (d *NotificationDelivery) UnsubscribeFromUpdates(ctx context.Context, req *
 func (d *NotificationDelivery) GetSubscriptions(ctx context.Context, req *notificationpb.UserID) (*notificationpb.SubscriptionsResponse, error) {
 	subs, err := d.usecase.GetSubscriptions(ctx, req.GetUserId())
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to get subscriptions")
 	}
 	return &notificationpb.SubscriptionsResponse{Subscriptions: subs}, nil
 }
@@ -192,8 +181,7 @@ func (s *NotificationDelivery) NotifyCommentLike(ctx context.Context, req *notif
 func (s *NotificationDelivery) GetNotifications(ctx context.Context, req *notificationpb.GetNotificationsRequest) (*notificationpb.GetNotificationsResponse, error) {
 	notifications, total, err := s.usecase.GetNotifications(ctx, req.GetUserId(), int(req.GetPage()), int(req.GetLimit()))
 	if err != nil {
-		log.Printf("[ERROR] NotificationDelivery.GetNotifications: %v", err)
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to get notifications")
 	}
 
 	pbNotifications := make([]*notificationpb.Notification, len(notifications))
@@ -224,18 +212,20 @@ func (s *NotificationDelivery) GetNotifications(ctx context.Context, req *notifi
 	}, nil
 }
 
+// VerifyCode checks a code sent to the caller. The caller comes from the
+// verified access token, so codes can only be tried against one's own account.
 func (s *NotificationDelivery) VerifyCode(ctx context.Context, req *notificationpb.VerifyCodeRequest) (*notificationpb.NotificationResponse, error) {
-	err := s.usecase.VerifyCode(ctx, req.GetEmail(), req.GetCode())
+	err := s.usecase.VerifyCode(ctx, auth.Identity(ctx).UserID, req.GetCode())
 	if err != nil {
-		return &notificationpb.NotificationResponse{Success: false, Message: err.Error()}, nil
+		return nil, grpcerr.Wrap(err, "failed to verify code")
 	}
 	return &notificationpb.NotificationResponse{Success: true, Message: "Email verified successfully"}, nil
 }
 
 func (s *NotificationDelivery) ResendCode(ctx context.Context, req *notificationpb.ResendCodeRequest) (*notificationpb.NotificationResponse, error) {
-	err := s.usecase.ResendCode(ctx, req.GetEmail())
+	err := s.usecase.ResendCode(ctx, auth.Identity(ctx).UserID)
 	if err != nil {
-		return &notificationpb.NotificationResponse{Success: false, Message: err.Error()}, nil
+		return nil, grpcerr.Wrap(err, "failed to resend code")
 	}
 	return &notificationpb.NotificationResponse{Success: true, Message: "Verification code resent"}, nil
 }
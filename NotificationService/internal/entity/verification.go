@@ -9,4 +9,5 @@ type Verification struct {
 	Code      string //verification code
 	ExpiresAt time.Time
 	IsUsed    bool //// whether the code was already used
+	Attempts  int  // wrong codes entered so far
 }
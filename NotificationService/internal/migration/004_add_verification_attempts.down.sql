@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_verifications_user_id;
+ALTER TABLE verifications DROP COLUMN IF EXISTS attempts;
@@ -0,0 +1,3 @@
+ALTER TABLE verifications ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_verifications_user_id ON verifications(user_id);
@@ -1,34 +1,60 @@
-package config
+package migration
 
 import (
-	"fmt"
-	"log"
-	"os"
-	"time"
+	"errors"
+	"log/slog"
 
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/jmoiron/sqlx"
-	_ "github.com/lib/pq" // postgres driver
 )
 
-func ConnectAndMigrate() *sqlx.DB {
-	dbURL := os.Getenv("DATABASE_URL")
-	if dbURL == "" {
-		log.Fatal("DATABASE_URL not set in environment variables")
+// baselineVersion is the schema databases created before migrations were
+// versioned already have: the tables from 001 to 003.
+const baselineVersion = 3
+
+func RunMigrations(dsn string) {
+	db, err := sqlx.Open("postgres", dsn)
+	if err != nil {
+		logging.Fatal("failed to connect to DB", logging.Err(err))
 	}
 
-	// Connect using sqlx
-	db, err := sqlx.Connect("postgres", dbURL)
+	driver, err := postgres.WithInstance(db.DB, &postgres.Config{})
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		logging.Fatal("failed to create migration driver", logging.Err(err))
 	}
 
-	// Set database pool settings
-	db.SetMaxIdleConns(10)
-	db.SetMaxOpenConns(100)
-	db.SetConnMaxLifetime(time.Hour)
+	m, err := migrate.NewWithDatabaseInstance(
+		"file://internal/migration",
+		"postgres",
+		driver,
+	)
+	if err != nil {
+		logging.Fatal("migration setup failed", logging.Err(err))
+	}
 
-	fmt.Println("Database connected successfully ✅")
+	// A verifications table without a recorded version predates versioning;
+	// record 001 to 003 as applied so Up starts with the changes after them.
+	if _, _, err := m.Version(); errors.Is(err, migrate.ErrNilVersion) {
+		var exists bool
+		if err := db.Get(&exists, "SELECT to_regclass('public.verifications') IS NOT NULL"); err != nil {
+			logging.Fatal("failed to inspect schema", logging.Err(err))
+		}
+		if exists {
+			slog.Info("adopting existing schema", "version", baselineVersion)
+			if err := m.Force(baselineVersion); err != nil {
+				logging.Fatal("failed to record baseline migration", logging.Err(err))
+			}
+		}
+	} else if err != nil {
+		logging.Fatal("failed to read migration version", logging.Err(err))
+	}
+
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		logging.Fatal("migration failed", logging.Err(err))
+	}
 
-	// Migrations — later we'll run real SQL files separately if needed
-	return db
+	slog.Info("database migrated")
 }
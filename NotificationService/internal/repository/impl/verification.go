@@ -20,11 +20,7 @@ func (r *verificationRepositoryImpl) SaveVerificationCode(ctx context.Context, v
 	return r.dao.Save(ctx, model.FromEntityVerification(v))
 }
 
-func (r *verificationRepositoryImpl) VerifyCode(ctx context.Context, userID, code string) (bool, error) {
-	return r.dao.CheckValid(ctx, userID, code)
-}
-
-func (r *verificationRepositoryImpl) GetVerificationCode(ctx context.Context, userID string) (*entity.Verification, error) {
+func (r *verificationRepositoryImpl) GetVerificationByUserID(ctx context.Context, userID string) (*entity.Verification, error) {
 	m, err := r.dao.GetByUserID(ctx, userID)
 	if err != nil {
 		return nil, err
@@ -32,10 +28,10 @@ func (r *verificationRepositoryImpl) GetVerificationCode(ctx context.Context, us
 	return m.ToEntity(), nil
 }
 
-func (r *verificationRepositoryImpl) UpdateVerificationStatus(ctx context.Context, userID string) error {
-	return r.dao.MarkUsed(ctx, userID)
+func (r *verificationRepositoryImpl) RecordFailedAttempt(ctx context.Context, id string) (int, error) {
+	return r.dao.IncrementAttempts(ctx, id)
 }
 
-func (r *verificationRepositoryImpl) UpdateVerificationCode(ctx context.Context, userID string, newCode string) error {
-	return r.dao.UpdateCode(ctx, userID, newCode)
+func (r *verificationRepositoryImpl) UpdateVerificationStatus(ctx context.Context, userID string) error {
+	return r.dao.MarkUsed(ctx, userID)
 }
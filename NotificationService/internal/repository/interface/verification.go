@@ -6,9 +6,11 @@ import (
 )
 
 type VerificationRepository interface {
+	// SaveVerificationCode stores the user's code, replacing older ones.
 	SaveVerificationCode(ctx context.Context, verification *entity.Verification) error
-	VerifyCode(ctx context.Context, userID, code string) (bool, error)
-	GetVerificationCode(ctx context.Context, userID string) (*entity.Verification, error)
+	// GetVerificationByUserID returns the latest code sent to the user.
+	GetVerificationByUserID(ctx context.Context, userID string) (*entity.Verification, error)
+	// RecordFailedAttempt counts a wrong code and returns the attempts so far.
+	RecordFailedAttempt(ctx context.Context, id string) (int, error)
 	UpdateVerificationStatus(ctx context.Context, userID string) error
-	UpdateVerificationCode(ctx context.Context, userID string, newCode string) error
 }
@@ -3,12 +3,16 @@ package impl
 import (
 	"context"
 	"fmt"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/payloads"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/publisher"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
 	_interface "github.com/KaminurOrynbek/BiznesAsh/internal/repository/interface"
 	usecase "github.com/KaminurOrynbek/BiznesAsh/internal/usecase/interface"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
 	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
 	"github.com/google/uuid"
-	"log"
+	"log/slog"
 	"os"
 	"time"
 )
@@ -17,13 +21,15 @@ type notificationUsecase struct {
 	repo       _interface.NotificationRepository
 	userClient userpb.UserServiceClient
 	sender     usecase.EmailSender
+	publisher  *publisher.NotificationPublisher
 }
 
-func NewNotificationUsecase(repo _interface.NotificationRepository, userClient userpb.UserServiceClient, sender usecase.EmailSender) *notificationUsecase {
+func NewNotificationUsecase(repo _interface.NotificationRepository, userClient userpb.UserServiceClient, sender usecase.EmailSender, notificationPublisher *publisher.NotificationPublisher) *notificationUsecase {
 	return &notificationUsecase{
 		repo:       repo,
 		userClient: userClient,
 		sender:     sender,
+		publisher:  notificationPublisher,
 	}
 }
 
@@ -88,7 +94,7 @@ func (u *notificationUsecase) NotifyContactRequest(ctx context.Context, name, em
 		Body:    mailBody,
 	})
 	if err != nil {
-		log.Printf("[ERROR] Failed to send contact email: %v", err)
+		slog.ErrorContext(ctx, "failed to send contact email", logging.Err(err))
 		// We still try to save as notification below
 	}
 
@@ -102,28 +108,37 @@ func (u *notificationUsecase) NotifyContactRequest(ctx context.Context, name, em
 func (u *notificationUsecase) saveTypedNotification(ctx context.Context, n *entity.Notification, typ string) error {
 	// Allow self-notifications for now (as requested by user)
 	if n.ActorID != "" && n.ActorID == n.UserID {
-		log.Printf("[INFO] Processing self-notification for user %s", n.UserID)
-	}
-
-	// 1. Fetch actor username if missing
-	if n.ActorID != "" && n.ActorUsername == "" {
-		log.Printf("[DEBUG] Fetching username for actor %s", n.ActorID)
-		resp, err := u.userClient.GetUser(ctx, &userpb.GetUserRequest{UserId: n.ActorID})
-		if err == nil && resp != nil {
-			n.ActorUsername = resp.Username
-			log.Printf("[DEBUG] Found actor username: %s", n.ActorUsername)
-		} else {
-			log.Printf("[DEBUG] Failed to fetch actor username: %v", err)
-		}
+		slog.DebugContext(ctx, "processing self-notification", "user_id", n.UserID)
 	}
 
-	// Validate user exists
-	exists, err := u.repo.UserExists(ctx, n.UserID)
+	// 1. Resolve the recipient and the actor in one round trip
+	ids := []string{n.UserID}
+	if n.ActorID != "" {
+		ids = append(ids, n.ActorID)
+	}
+	users := make(map[string]*userpb.UserResponse)
+	resp, err := u.userClient.GetUsersByIDs(ctx, &userpb.GetUsersByIDsRequest{UserIds: ids})
 	if err != nil {
-		return fmt.Errorf("failed to verify user: %w", err)
+		slog.WarnContext(ctx, "failed to fetch notification users", "user_ids", ids, logging.Err(err))
+	}
+	for _, user := range resp.GetUsers() {
+		users[user.GetUserId()] = user
+	}
+
+	if actor, ok := users[n.ActorID]; ok && n.ActorUsername == "" {
+		n.ActorUsername = actor.GetUsername()
+	}
+
+	// Validate user exists; fall back to the local check if UserService was unreachable
+	exists := users[n.UserID] != nil
+	if err != nil {
+		exists, err = u.repo.UserExists(ctx, n.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to verify user: %w", err)
+		}
 	}
 	if !exists {
-		return fmt.Errorf("user with ID %s does not exist", n.UserID)
+		return fmt.Errorf("user with ID %s: %w", n.UserID, grpcerr.ErrNotFound)
 	}
 
 	//Validate post exists
@@ -133,7 +148,7 @@ func (u *notificationUsecase) saveTypedNotification(ctx context.Context, n *enti
 			return fmt.Errorf("failed to verify post: %w", err)
 		}
 		if !exists {
-			return fmt.Errorf("post with ID %s does not exist", n.PostID)
+			return fmt.Errorf("post with ID %s: %w", *n.PostID, grpcerr.ErrNotFound)
 		}
 	}
 
@@ -145,7 +160,29 @@ func (u *notificationUsecase) saveTypedNotification(ctx context.Context, n *enti
 	}
 	n.Type = typ
 	n.IsRead = false
-	return u.repo.SaveNotification(ctx, n)
+	if err := u.repo.SaveNotification(ctx, n); err != nil {
+		return err
+	}
+
+	_ = u.publisher.PublishNotificationCreated(ctx, payloads.NotificationCreated{
+		ID:            n.ID,
+		UserID:        n.UserID,
+		Type:          n.Type,
+		Message:       n.Message,
+		ActorID:       n.ActorID,
+		ActorUsername: n.ActorUsername,
+		PostID:        deref(n.PostID),
+		CommentID:     deref(n.CommentID),
+		CreatedAt:     n.CreatedAt.Format(time.RFC3339),
+	})
+	return nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
 }
 
 func (u *notificationUsecase) GetWelcomeEmailHTML() string {
@@ -2,34 +2,57 @@ package impl
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
 	"fmt"
-	"math/rand"
+	"math/big"
 	"time"
 
+	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/payloads"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/publisher"
 	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
 	repo "github.com/KaminurOrynbek/BiznesAsh/internal/repository/interface"
 	usecase "github.com/KaminurOrynbek/BiznesAsh/internal/usecase/interface"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
 )
 
+const (
+	verificationCodeTTL = 10 * time.Minute
+	// maxVerifyAttempts is how many wrong codes burn a code; the user then
+	// has to request a new one.
+	maxVerifyAttempts = 5
+)
+
+var errInvalidCode = fmt.Errorf("invalid or expired verification code: %w", grpcerr.ErrInvalidArgument)
+
 type verificationUsecaseImpl struct {
 	repo        repo.VerificationRepository
 	emailSender usecase.EmailSender
+	publisher   *publisher.NotificationPublisher
 }
 
-func NewVerificationUsecase(repo repo.VerificationRepository, sender usecase.EmailSender) usecase.VerificationUsecase {
+func NewVerificationUsecase(repo repo.VerificationRepository, sender usecase.EmailSender, publisher *publisher.NotificationPublisher) usecase.VerificationUsecase {
 	return &verificationUsecaseImpl{
 		repo:        repo,
 		emailSender: sender,
+		publisher:   publisher,
 	}
 }
 
-func (u *verificationUsecaseImpl) SendVerificationEmail(ctx context.Context, email *entity.Email) error {
-	code := generateVerificationCode()
+// SendVerificationCode emails a new code for the user's address, replacing
+// any code sent before.
+func (u *verificationUsecaseImpl) SendVerificationCode(ctx context.Context, userID, email string) error {
+	code, err := generateVerificationCode()
+	if err != nil {
+		return err
+	}
 	verification := &entity.Verification{
-		UserID:    email.To,
-		Email:     email.To,
+		UserID:    userID,
+		Email:     email,
 		Code:      code,
-		ExpiresAt: time.Now().Add(10 * time.Minute),
+		ExpiresAt: time.Now().Add(verificationCodeTTL),
 		IsUsed:    false,
 	}
 
@@ -37,44 +60,91 @@ func (u *verificationUsecaseImpl) SendVerificationEmail(ctx context.Context, ema
 		return err
 	}
 
-	email.Body = "Please verify your account with this code: " + code
-	return u.emailSender.SendEmail(ctx, email)
+	return u.emailSender.SendEmail(ctx, &entity.Email{
+		To:      email,
+		Subject: "Please verify your email",
+		Body:    "Please verify your account with this code: " + code + ". It expires in 10 minutes.",
+	})
 }
 
-func (u *verificationUsecaseImpl) VerifyCode(ctx context.Context, email, code string) error {
-	isValid, err := u.repo.VerifyCode(ctx, email, code)
+// VerifyCode checks the code sent to the user and tells UserService their
+// address is verified. After maxVerifyAttempts wrong codes the code stops
+// working. Entering the right code again after success repeats the event, in
+// case UserService missed it.
+func (u *verificationUsecaseImpl) VerifyCode(ctx context.Context, userID, code string) error {
+	v, err := u.repo.GetVerificationByUserID(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errInvalidCode
+	}
 	if err != nil {
 		return err
 	}
-	if !isValid {
-		return fmt.Errorf("invalid or expired verification code")
+
+	matches := subtle.ConstantTimeCompare([]byte(v.Code), []byte(code)) == 1
+	if v.IsUsed {
+		if matches {
+			return u.publishVerified(ctx, v)
+		}
+		return fmt.Errorf("email is already verified: %w", grpcerr.ErrFailedPrecondition)
+	}
+	if time.Now().After(v.ExpiresAt) {
+		return errInvalidCode
+	}
+	if v.Attempts >= maxVerifyAttempts {
+		return fmt.Errorf("too many wrong codes, request a new one: %w", grpcerr.ErrFailedPrecondition)
+	}
+	if !matches {
+		attempts, err := u.repo.RecordFailedAttempt(ctx, v.ID)
+		if err != nil {
+			return err
+		}
+		if attempts >= maxVerifyAttempts {
+			return fmt.Errorf("too many wrong codes, request a new one: %w", grpcerr.ErrFailedPrecondition)
+		}
+		return errInvalidCode
 	}
 
-	return u.repo.UpdateVerificationStatus(ctx, email)
+	// Publish first: if marking the code used fails the user can retry, while
+	// an event lost after marking it would leave the account unverified.
+	if err := u.publishVerified(ctx, v); err != nil {
+		return err
+	}
+	return u.repo.UpdateVerificationStatus(ctx, v.UserID)
 }
 
-func (u *verificationUsecaseImpl) ResendCode(ctx context.Context, email string) error {
-	code := generateVerificationCode()
-	verification := &entity.Verification{
-		UserID:    email,
-		Email:     email,
-		Code:      code,
-		ExpiresAt: time.Now().Add(10 * time.Minute),
-		IsUsed:    false,
+// ResendCode sends the user a new code to the address still waiting for
+// verification.
+func (u *verificationUsecaseImpl) ResendCode(ctx context.Context, userID string) error {
+	v, err := u.repo.GetVerificationByUserID(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("no verification code was sent to this account: %w", grpcerr.ErrNotFound)
 	}
-
-	if err := u.repo.SaveVerificationCode(ctx, verification); err != nil {
+	if err != nil {
 		return err
 	}
+	if v.IsUsed {
+		return fmt.Errorf("email is already verified: %w", grpcerr.ErrFailedPrecondition)
+	}
+	return u.SendVerificationCode(ctx, v.UserID, v.Email)
+}
 
-	return u.emailSender.SendEmail(ctx, &entity.Email{
-		To:      email,
-		Subject: "Please verify your email",
-		Body:    "Please verify your account with this code: " + code,
+func (u *verificationUsecaseImpl) publishVerified(ctx context.Context, v *entity.Verification) error {
+	err := u.publisher.PublishEmailVerified(ctx, payloads.EmailVerifiedEventPayload{
+		UserID:     v.UserID,
+		Email:      v.Email,
+		VerifiedAt: time.Now().UTC().Format(time.RFC3339),
 	})
+	if err != nil {
+		return fmt.Errorf("failed to record verification: %w", err)
+	}
+	return nil
 }
 
-func generateVerificationCode() string {
-	rand.Seed(time.Now().UnixNano())
-	return fmt.Sprintf("%06d", rand.Intn(1000000))
+// generateVerificationCode returns a uniformly random 6-digit code.
+func generateVerificationCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate verification code: %w", err)
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
 }
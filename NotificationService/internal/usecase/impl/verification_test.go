@@ -0,0 +1,177 @@
+package impl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/internal/adapter/nats/publisher"
+	"github.com/KaminurOrynbek/BiznesAsh/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
+)
+
+const (
+	testEmail = "alice@example.com"
+	rightCode = "123456"
+	wrongCode = "654321"
+)
+
+// memoryVerifications holds at most one code, like the table holds one per user.
+type memoryVerifications struct {
+	v *entity.Verification
+}
+
+func (r *memoryVerifications) SaveVerificationCode(_ context.Context, v *entity.Verification) error {
+	r.v = v
+	return nil
+}
+
+func (r *memoryVerifications) GetVerificationByUserID(_ context.Context, userID string) (*entity.Verification, error) {
+	if r.v == nil || r.v.UserID != userID {
+		return nil, sql.ErrNoRows
+	}
+	v := *r.v
+	return &v, nil
+}
+
+func (r *memoryVerifications) RecordFailedAttempt(_ context.Context, _ string) (int, error) {
+	r.v.Attempts++
+	return r.v.Attempts, nil
+}
+
+func (r *memoryVerifications) UpdateVerificationStatus(_ context.Context, _ string) error {
+	r.v.IsUsed = true
+	return nil
+}
+
+// recordingQueue counts the messages published through it.
+type recordingQueue struct {
+	queue.MessageQueue
+	published []string
+}
+
+func (q *recordingQueue) PublishMsg(msg *queue.Message) error {
+	q.published = append(q.published, msg.Subject)
+	return nil
+}
+
+func TestVerifyCodeAttempts(t *testing.T) {
+	pending := func(attempts int) *entity.Verification {
+		return &entity.Verification{ID: "v1", UserID: "u1", Email: testEmail, Code: rightCode, ExpiresAt: time.Now().Add(time.Minute), Attempts: attempts}
+	}
+
+	tests := []struct {
+		name          string
+		stored        *entity.Verification
+		userID        string
+		codes         []string
+		wantErrs      []error // one per code; nil for success
+		wantAttempts  int
+		wantUsed      bool
+		wantPublished int
+	}{
+		{
+			name:          "right code verifies",
+			stored:        pending(0),
+			codes:         []string{rightCode},
+			wantErrs:      []error{nil},
+			wantUsed:      true,
+			wantPublished: 1,
+		},
+		{
+			name:         "wrong code is counted",
+			stored:       pending(0),
+			codes:        []string{wrongCode, wrongCode},
+			wantErrs:     []error{grpcerr.ErrInvalidArgument, grpcerr.ErrInvalidArgument},
+			wantAttempts: 2,
+		},
+		{
+			name:          "right code after wrong ones still verifies",
+			stored:        pending(maxVerifyAttempts - 2),
+			codes:         []string{wrongCode, rightCode},
+			wantErrs:      []error{grpcerr.ErrInvalidArgument, nil},
+			wantAttempts:  maxVerifyAttempts - 1,
+			wantUsed:      true,
+			wantPublished: 1,
+		},
+		{
+			name:         "last allowed wrong code burns the code",
+			stored:       pending(maxVerifyAttempts - 1),
+			codes:        []string{wrongCode, rightCode},
+			wantErrs:     []error{grpcerr.ErrFailedPrecondition, grpcerr.ErrFailedPrecondition},
+			wantAttempts: maxVerifyAttempts,
+		},
+		{
+			name:         "burned code is not counted further",
+			stored:       pending(maxVerifyAttempts),
+			codes:        []string{wrongCode},
+			wantErrs:     []error{grpcerr.ErrFailedPrecondition},
+			wantAttempts: maxVerifyAttempts,
+		},
+		{
+			name: "expired code is rejected without counting",
+			stored: &entity.Verification{
+				ID: "v1", UserID: "u1", Email: testEmail, Code: rightCode, ExpiresAt: time.Now().Add(-time.Minute),
+			},
+			codes:    []string{rightCode, wrongCode},
+			wantErrs: []error{grpcerr.ErrInvalidArgument, grpcerr.ErrInvalidArgument},
+		},
+		{
+			name: "used code repeats the event for the right code only",
+			stored: &entity.Verification{
+				ID: "v1", UserID: "u1", Email: testEmail, Code: rightCode, ExpiresAt: time.Now().Add(-time.Minute), IsUsed: true,
+			},
+			codes:         []string{rightCode, wrongCode},
+			wantErrs:      []error{nil, grpcerr.ErrFailedPrecondition},
+			wantUsed:      true,
+			wantPublished: 1,
+		},
+		{
+			name:     "user without a code is rejected",
+			codes:    []string{rightCode},
+			wantErrs: []error{grpcerr.ErrInvalidArgument},
+		},
+		{
+			name:     "another user's code is rejected without counting",
+			stored:   pending(0),
+			userID:   "u2",
+			codes:    []string{rightCode, wrongCode},
+			wantErrs: []error{grpcerr.ErrInvalidArgument, grpcerr.ErrInvalidArgument},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryVerifications{v: tt.stored}
+			q := &recordingQueue{}
+			u := NewVerificationUsecase(repo, nil, publisher.NewNotificationPublisher(q))
+			userID := tt.userID
+			if userID == "" {
+				userID = "u1"
+			}
+
+			for i, code := range tt.codes {
+				err := u.VerifyCode(context.Background(), userID, code)
+				if want := tt.wantErrs[i]; (want == nil) != (err == nil) || !errors.Is(err, want) {
+					t.Fatalf("attempt %d with %s: err = %v, want %v", i, code, err, want)
+				}
+			}
+
+			if repo.v == nil {
+				return
+			}
+			if repo.v.Attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", repo.v.Attempts, tt.wantAttempts)
+			}
+			if repo.v.IsUsed != tt.wantUsed {
+				t.Errorf("used = %t, want %t", repo.v.IsUsed, tt.wantUsed)
+			}
+			if len(q.published) != tt.wantPublished {
+				t.Errorf("published %d events, want %d", len(q.published), tt.wantPublished)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/KaminurOrynbek/BiznesAsh/PaymentService/internal/usecase"
 	pb "github.com/KaminurOrynbek/BiznesAsh/PaymentService/proto"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
 )
 
 type PaymentServer struct {
@@ -17,9 +18,9 @@ func NewPaymentServer(u *usecase.PaymentUsecase) *PaymentServer {
 }
 
 func (s *PaymentServer) ProcessPayment(ctx context.Context, req *pb.ProcessPaymentRequest) (*pb.PaymentResponse, error) {
-	tx, err := s.usecase.ProcessPayment(ctx, req.GetUserId(), req.GetAmount(), req.GetCurrency(), req.GetReferenceType(), req.GetReferenceId())
+	tx, err := s.usecase.ProcessPayment(ctx, req.GetUserId(), req.GetAmount(), req.GetCurrency(), req.GetReferenceType(), req.GetReferenceId(), req.GetIdempotencyKey())
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to process payment")
 	}
 	return &pb.PaymentResponse{
 		Id:        tx.ID,
@@ -31,7 +32,7 @@ func (s *PaymentServer) ProcessPayment(ctx context.Context, req *pb.ProcessPayme
 func (s *PaymentServer) GetTransactionHistory(ctx context.Context, req *pb.GetHistoryRequest) (*pb.HistoryResponse, error) {
 	txs, err := s.usecase.GetHistory(ctx, req.GetUserId())
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to get transaction history")
 	}
 
 	var resp pb.HistoryResponse
@@ -44,3 +45,19 @@ func (s *PaymentServer) GetTransactionHistory(ctx context.Context, req *pb.GetHi
 	}
 	return &resp, nil
 }
+
+func (s *PaymentServer) GetPaymentStats(ctx context.Context, req *pb.PaymentStatsRequest) (*pb.PaymentStatsResponse, error) {
+	stats, err := s.usecase.GetStats(ctx)
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to get payment stats")
+	}
+
+	resp := &pb.PaymentStatsResponse{
+		ByStatus:          make(map[string]int32, len(stats.ByStatus)),
+		RevenueByCurrency: stats.RevenueByCurrency,
+	}
+	for status, n := range stats.ByStatus {
+		resp.ByStatus[status] = int32(n)
+	}
+	return resp, nil
+}
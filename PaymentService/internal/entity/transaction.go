@@ -13,4 +13,14 @@ type Transaction struct {
 	ReferenceID   string    `db:"reference_id"`
 	Status        string    `db:"status"` // PENDING, SUCCESS, FAILED
 	CreatedAt     time.Time `db:"created_at"`
+	// IdempotencyKey is the client's key for the charge, unique per user; nil
+	// when none was sent.
+	IdempotencyKey *string `db:"idempotency_key"`
+}
+
+// PaymentStats counts transactions and sums successful payments for the admin
+// dashboard.
+type PaymentStats struct {
+	ByStatus          map[string]int
+	RevenueByCurrency map[string]float64
 }
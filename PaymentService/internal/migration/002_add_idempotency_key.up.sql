@@ -0,0 +1,5 @@
+ALTER TABLE payment_transactions ADD COLUMN IF NOT EXISTS idempotency_key VARCHAR(255);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payment_transactions_idempotency_key
+    ON payment_transactions(user_id, idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
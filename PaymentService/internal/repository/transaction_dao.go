@@ -3,7 +3,9 @@ package repository
 import (
 	"context"
 	"fmt"
+
 	"github.com/KaminurOrynbek/BiznesAsh/PaymentService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
 	"github.com/jmoiron/sqlx"
 )
 
@@ -15,18 +17,35 @@ func NewTransactionDAO(db *sqlx.DB) *TransactionDAO {
 	return &TransactionDAO{db: db}
 }
 
+// Create inserts tx. It returns grpcerr.ErrAlreadyExists when the user already
+// has a transaction with tx's idempotency key.
 func (d *TransactionDAO) Create(ctx context.Context, tx *entity.Transaction) error {
 	query := `
-		INSERT INTO payment_transactions (id, user_id, amount, currency, reference_type, reference_id, status, created_at)
-		VALUES (:id, :user_id, :amount, :currency, :reference_type, :reference_id, :status, :created_at)
+		INSERT INTO payment_transactions (id, user_id, amount, currency, reference_type, reference_id, status, created_at, idempotency_key)
+		VALUES (:id, :user_id, :amount, :currency, :reference_type, :reference_id, :status, :created_at, :idempotency_key)
+		ON CONFLICT (user_id, idempotency_key) WHERE idempotency_key IS NOT NULL DO NOTHING
 	`
-	_, err := d.db.NamedExecContext(ctx, query, tx)
+	res, err := d.db.NamedExecContext(ctx, query, tx)
 	if err != nil {
-		return fmt.Errorf("failed to create transaction: %v", err)
+		return fmt.Errorf("failed to create transaction: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("transaction with this idempotency key: %w", grpcerr.ErrAlreadyExists)
 	}
 	return nil
 }
 
+// GetByIdempotencyKey returns sql.ErrNoRows when the user has no transaction
+// with key.
+func (d *TransactionDAO) GetByIdempotencyKey(ctx context.Context, userID, key string) (*entity.Transaction, error) {
+	var tx entity.Transaction
+	query := `SELECT * FROM payment_transactions WHERE user_id = $1 AND idempotency_key = $2`
+	if err := d.db.GetContext(ctx, &tx, query, userID, key); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
 func (d *TransactionDAO) GetByUserID(ctx context.Context, userID string) ([]*entity.Transaction, error) {
 	var txs []*entity.Transaction
 	query := `SELECT * FROM payment_transactions WHERE user_id = $1 ORDER BY created_at DESC`
@@ -36,3 +55,29 @@ func (d *TransactionDAO) GetByUserID(ctx context.Context, userID string) ([]*ent
 	}
 	return txs, nil
 }
+
+func (d *TransactionDAO) Stats(ctx context.Context) (*entity.PaymentStats, error) {
+	var rows []struct {
+		Status   string  `db:"status"`
+		Currency string  `db:"currency"`
+		Count    int     `db:"count"`
+		Amount   float64 `db:"amount"`
+	}
+	query := `
+		SELECT status, currency, COUNT(*) AS count, COALESCE(SUM(amount), 0) AS amount
+		FROM payment_transactions
+		GROUP BY status, currency
+	`
+	if err := d.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, fmt.Errorf("failed to count transactions: %w", err)
+	}
+
+	stats := &entity.PaymentStats{ByStatus: map[string]int{}, RevenueByCurrency: map[string]float64{}}
+	for _, r := range rows {
+		stats.ByStatus[r.Status] += r.Count
+		if r.Status == "SUCCESS" {
+			stats.RevenueByCurrency[r.Currency] += r.Amount
+		}
+	}
+	return stats, nil
+}
@@ -2,15 +2,22 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/KaminurOrynbek/BiznesAsh/PaymentService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
 	"github.com/google/uuid"
 )
 
 type TransactionRepo interface {
 	Create(ctx context.Context, tx *entity.Transaction) error
+	GetByIdempotencyKey(ctx context.Context, userID, key string) (*entity.Transaction, error)
 	GetByUserID(ctx context.Context, userID string) ([]*entity.Transaction, error)
+	Stats(ctx context.Context) (*entity.PaymentStats, error)
 }
 
 type PaymentUsecase struct {
@@ -21,7 +28,17 @@ func NewPaymentUsecase(repo TransactionRepo) *PaymentUsecase {
 	return &PaymentUsecase{repo: repo}
 }
 
-func (u *PaymentUsecase) ProcessPayment(ctx context.Context, userID string, amount float64, currency, refType, refID string) (*entity.Transaction, error) {
+// ProcessPayment charges the user. With an idempotency key, a retry returns the
+// transaction the first attempt created, and reusing the key for a different
+// charge fails with ErrFailedPrecondition.
+func (u *PaymentUsecase) ProcessPayment(ctx context.Context, userID string, amount float64, currency, refType, refID, idempotencyKey string) (*entity.Transaction, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("userId is required: %w", grpcerr.ErrInvalidArgument)
+	}
+	if amount <= 0 {
+		return nil, fmt.Errorf("amount must be positive: %w", grpcerr.ErrInvalidArgument)
+	}
+
 	tx := &entity.Transaction{
 		ID:            uuid.New().String(),
 		UserID:        userID,
@@ -32,15 +49,48 @@ func (u *PaymentUsecase) ProcessPayment(ctx context.Context, userID string, amou
 		Status:        "SUCCESS", // Mocked as success for now
 		CreatedAt:     time.Now(),
 	}
+	if idempotencyKey == "" {
+		if err := u.repo.Create(ctx, tx); err != nil {
+			return nil, err
+		}
+		return tx, nil
+	}
 
-	err := u.repo.Create(ctx, tx)
+	tx.IdempotencyKey = &idempotencyKey
+	existing, err := u.repo.GetByIdempotencyKey(ctx, userID, idempotencyKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = u.repo.Create(ctx, tx)
+		if err == nil {
+			return tx, nil
+		}
+		if !errors.Is(err, grpcerr.ErrAlreadyExists) {
+			return nil, err
+		}
+		// A concurrent retry won the insert; answer with its transaction.
+		existing, err = u.repo.GetByIdempotencyKey(ctx, userID, idempotencyKey)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if !sameCharge(existing, tx) {
+		return nil, fmt.Errorf("idempotency key was already used for a different payment: %w", grpcerr.ErrFailedPrecondition)
+	}
+	return existing, nil
+}
 
-	return tx, nil
+// sameCharge reports whether a and b charge the same amount for the same thing.
+// Amounts are compared in cents, as stored.
+func sameCharge(a, b *entity.Transaction) bool {
+	return math.Round(a.Amount*100) == math.Round(b.Amount*100) &&
+		a.Currency == b.Currency &&
+		a.ReferenceType == b.ReferenceType &&
+		a.ReferenceID == b.ReferenceID
 }
 
 func (u *PaymentUsecase) GetHistory(ctx context.Context, userID string) ([]*entity.Transaction, error) {
 	return u.repo.GetByUserID(ctx, userID)
 }
+
+func (u *PaymentUsecase) GetStats(ctx context.Context) (*entity.PaymentStats, error) {
+	return u.repo.Stats(ctx)
+}
@@ -28,8 +28,11 @@ type ProcessPaymentRequest struct {
 	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
 	ReferenceType string                 `protobuf:"bytes,4,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"` // SUBSCRIPTION, CONSULTATION
 	ReferenceId   string                 `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Optional. A retry with the same user_id and key returns the original
+	// transaction instead of charging again.
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ProcessPaymentRequest) Reset() {
@@ -97,6 +100,13 @@ func (x *ProcessPaymentRequest) GetReferenceId() string {
 	return ""
 }
 
+func (x *ProcessPaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type PaymentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -245,17 +255,106 @@ func (x *HistoryResponse) GetTransactions() []*PaymentResponse {
 	return nil
 }
 
+type PaymentStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentStatsRequest) Reset() {
+	*x = PaymentStatsRequest{}
+	mi := &file_proto_payment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentStatsRequest) ProtoMessage() {}
+
+func (x *PaymentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentStatsRequest.ProtoReflect.Descriptor instead.
+func (*PaymentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{4}
+}
+
+type PaymentStatsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ByStatus          map[string]int32       `protobuf:"bytes,1,rep,name=by_status,json=byStatus,proto3" json:"by_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`                               // SUCCESS, FAILED, PENDING
+	RevenueByCurrency map[string]float64     `protobuf:"bytes,2,rep,name=revenue_by_currency,json=revenueByCurrency,proto3" json:"revenue_by_currency,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // successful payments only
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PaymentStatsResponse) Reset() {
+	*x = PaymentStatsResponse{}
+	mi := &file_proto_payment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentStatsResponse) ProtoMessage() {}
+
+func (x *PaymentStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_payment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentStatsResponse.ProtoReflect.Descriptor instead.
+func (*PaymentStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_payment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PaymentStatsResponse) GetByStatus() map[string]int32 {
+	if x != nil {
+		return x.ByStatus
+	}
+	return nil
+}
+
+func (x *PaymentStatsResponse) GetRevenueByCurrency() map[string]float64 {
+	if x != nil {
+		return x.RevenueByCurrency
+	}
+	return nil
+}
+
 var File_proto_payment_proto protoreflect.FileDescriptor
 
 const file_proto_payment_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/payment.proto\x12\apayment\"\xae\x01\n" +
+	"\x13proto/payment.proto\x12\apayment\"\xd7\x01\n" +
 	"\x15ProcessPaymentRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
 	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12%\n" +
 	"\x0ereference_type\x18\x04 \x01(\tR\rreferenceType\x12!\n" +
-	"\freference_id\x18\x05 \x01(\tR\vreferenceId\"X\n" +
+	"\freference_id\x18\x05 \x01(\tR\vreferenceId\x12'\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tR\x0eidempotencyKey\"X\n" +
 	"\x0fPaymentResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
@@ -264,10 +363,21 @@ const file_proto_payment_proto_rawDesc = "" +
 	"\x11GetHistoryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"O\n" +
 	"\x0fHistoryResponse\x12<\n" +
-	"\ftransactions\x18\x01 \x03(\v2\x18.payment.PaymentResponseR\ftransactions2\xab\x01\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x18.payment.PaymentResponseR\ftransactions\"\x15\n" +
+	"\x13PaymentStatsRequest\"\xc9\x02\n" +
+	"\x14PaymentStatsResponse\x12H\n" +
+	"\tby_status\x18\x01 \x03(\v2+.payment.PaymentStatsResponse.ByStatusEntryR\bbyStatus\x12d\n" +
+	"\x13revenue_by_currency\x18\x02 \x03(\v24.payment.PaymentStatsResponse.RevenueByCurrencyEntryR\x11revenueByCurrency\x1a;\n" +
+	"\rByStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aD\n" +
+	"\x16RevenueByCurrencyEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x012\xfb\x01\n" +
 	"\x0ePaymentService\x12J\n" +
 	"\x0eProcessPayment\x12\x1e.payment.ProcessPaymentRequest\x1a\x18.payment.PaymentResponse\x12M\n" +
-	"\x15GetTransactionHistory\x12\x1a.payment.GetHistoryRequest\x1a\x18.payment.HistoryResponseB:Z8github.com/KaminurOrynbek/BiznesAsh/PaymentService/protob\x06proto3"
+	"\x15GetTransactionHistory\x12\x1a.payment.GetHistoryRequest\x1a\x18.payment.HistoryResponse\x12N\n" +
+	"\x0fGetPaymentStats\x12\x1c.payment.PaymentStatsRequest\x1a\x1d.payment.PaymentStatsResponseB:Z8github.com/KaminurOrynbek/BiznesAsh/PaymentService/protob\x06proto3"
 
 var (
 	file_proto_payment_proto_rawDescOnce sync.Once
@@ -281,24 +391,32 @@ func file_proto_payment_proto_rawDescGZIP() []byte {
 	return file_proto_payment_proto_rawDescData
 }
 
-var file_proto_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_proto_payment_proto_goTypes = []any{
 	(*ProcessPaymentRequest)(nil), // 0: payment.ProcessPaymentRequest
 	(*PaymentResponse)(nil),       // 1: payment.PaymentResponse
 	(*GetHistoryRequest)(nil),     // 2: payment.GetHistoryRequest
 	(*HistoryResponse)(nil),       // 3: payment.HistoryResponse
+	(*PaymentStatsRequest)(nil),   // 4: payment.PaymentStatsRequest
+	(*PaymentStatsResponse)(nil),  // 5: payment.PaymentStatsResponse
+	nil,                           // 6: payment.PaymentStatsResponse.ByStatusEntry
+	nil,                           // 7: payment.PaymentStatsResponse.RevenueByCurrencyEntry
 }
 var file_proto_payment_proto_depIdxs = []int32{
 	1, // 0: payment.HistoryResponse.transactions:type_name -> payment.PaymentResponse
-	0, // 1: payment.PaymentService.ProcessPayment:input_type -> payment.ProcessPaymentRequest
-	2, // 2: payment.PaymentService.GetTransactionHistory:input_type -> payment.GetHistoryRequest
-	1, // 3: payment.PaymentService.ProcessPayment:output_type -> payment.PaymentResponse
-	3, // 4: payment.PaymentService.GetTransactionHistory:output_type -> payment.HistoryResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6, // 1: payment.PaymentStatsResponse.by_status:type_name -> payment.PaymentStatsResponse.ByStatusEntry
+	7, // 2: payment.PaymentStatsResponse.revenue_by_currency:type_name -> payment.PaymentStatsResponse.RevenueByCurrencyEntry
+	0, // 3: payment.PaymentService.ProcessPayment:input_type -> payment.ProcessPaymentRequest
+	2, // 4: payment.PaymentService.GetTransactionHistory:input_type -> payment.GetHistoryRequest
+	4, // 5: payment.PaymentService.GetPaymentStats:input_type -> payment.PaymentStatsRequest
+	1, // 6: payment.PaymentService.ProcessPayment:output_type -> payment.PaymentResponse
+	3, // 7: payment.PaymentService.GetTransactionHistory:output_type -> payment.HistoryResponse
+	5, // 8: payment.PaymentService.GetPaymentStats:output_type -> payment.PaymentStatsResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_proto_payment_proto_init() }
@@ -312,7 +430,7 @@ func file_proto_payment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_payment_proto_rawDesc), len(file_proto_payment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
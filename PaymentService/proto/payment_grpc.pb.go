@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	PaymentService_ProcessPayment_FullMethodName        = "/payment.PaymentService/ProcessPayment"
 	PaymentService_GetTransactionHistory_FullMethodName = "/payment.PaymentService/GetTransactionHistory"
+	PaymentService_GetPaymentStats_FullMethodName       = "/payment.PaymentService/GetPaymentStats"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -29,6 +30,7 @@ const (
 type PaymentServiceClient interface {
 	ProcessPayment(ctx context.Context, in *ProcessPaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
 	GetTransactionHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
+	GetPaymentStats(ctx context.Context, in *PaymentStatsRequest, opts ...grpc.CallOption) (*PaymentStatsResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -59,12 +61,23 @@ func (c *paymentServiceClient) GetTransactionHistory(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *paymentServiceClient) GetPaymentStats(ctx context.Context, in *PaymentStatsRequest, opts ...grpc.CallOption) (*PaymentStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentStatsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetPaymentStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
 type PaymentServiceServer interface {
 	ProcessPayment(context.Context, *ProcessPaymentRequest) (*PaymentResponse, error)
 	GetTransactionHistory(context.Context, *GetHistoryRequest) (*HistoryResponse, error)
+	GetPaymentStats(context.Context, *PaymentStatsRequest) (*PaymentStatsResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -81,6 +94,9 @@ func (UnimplementedPaymentServiceServer) ProcessPayment(context.Context, *Proces
 func (UnimplementedPaymentServiceServer) GetTransactionHistory(context.Context, *GetHistoryRequest) (*HistoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetTransactionHistory not implemented")
 }
+func (UnimplementedPaymentServiceServer) GetPaymentStats(context.Context, *PaymentStatsRequest) (*PaymentStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPaymentStats not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -138,6 +154,24 @@ func _PaymentService_GetTransactionHistory_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_GetPaymentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetPaymentStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetPaymentStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetPaymentStats(ctx, req.(*PaymentStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +187,10 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactionHistory",
 			Handler:    _PaymentService_GetTransactionHistory_Handler,
 		},
+		{
+			MethodName: "GetPaymentStats",
+			Handler:    _PaymentService_GetPaymentStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/payment.proto",
@@ -6,6 +6,7 @@ import (
 	"github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/internal/entity"
 	"github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/internal/usecase"
 	pb "github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/proto"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
 )
 
 type SubscriptionServer struct {
@@ -20,7 +21,7 @@ func NewSubscriptionServer(u *usecase.SubscriptionUsecase) *SubscriptionServer {
 func (s *SubscriptionServer) GetSubscription(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.SubscriptionResponse, error) {
 	sub, err := s.usecase.GetSubscription(ctx, req.GetUserId())
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to get subscription")
 	}
 	return toProto(sub), nil
 }
@@ -28,7 +29,7 @@ func (s *SubscriptionServer) GetSubscription(ctx context.Context, req *pb.GetSub
 func (s *SubscriptionServer) UpdateSubscription(ctx context.Context, req *pb.UpdateSubscriptionRequest) (*pb.SubscriptionResponse, error) {
 	sub, err := s.usecase.UpdateSubscription(ctx, req.GetUserId(), req.GetPlanType(), int(req.GetDurationMonths()))
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to update subscription")
 	}
 	return toProto(sub), nil
 }
@@ -36,7 +37,7 @@ func (s *SubscriptionServer) UpdateSubscription(ctx context.Context, req *pb.Upd
 func (s *SubscriptionServer) ListSubscriptions(ctx context.Context, req *pb.Empty) (*pb.ListSubscriptionsResponse, error) {
 	subs, err := s.usecase.ListSubscriptions(ctx)
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to list subscriptions")
 	}
 
 	var resp pb.ListSubscriptionsResponse
@@ -49,7 +50,7 @@ func (s *SubscriptionServer) ListSubscriptions(ctx context.Context, req *pb.Empt
 func (s *SubscriptionServer) CancelSubscription(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.SubscriptionResponse, error) {
 	sub, err := s.usecase.CancelSubscription(ctx, req.GetId())
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to cancel subscription")
 	}
 	return toProto(sub), nil
 }
@@ -57,7 +58,7 @@ func (s *SubscriptionServer) CancelSubscription(ctx context.Context, req *pb.Can
 func (s *SubscriptionServer) GetSubscriptionHistory(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.ListSubscriptionsResponse, error) {
 	subs, err := s.usecase.GetSubscriptionHistory(ctx, req.GetUserId())
 	if err != nil {
-		return nil, err
+		return nil, grpcerr.Wrap(err, "failed to get subscription history")
 	}
 
 	var resp pb.ListSubscriptionsResponse
@@ -67,6 +68,25 @@ func (s *SubscriptionServer) GetSubscriptionHistory(ctx context.Context, req *pb
 	return &resp, nil
 }
 
+func (s *SubscriptionServer) GetSubscriptionStats(ctx context.Context, req *pb.Empty) (*pb.SubscriptionStatsResponse, error) {
+	stats, err := s.usecase.GetSubscriptionStats(ctx)
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to get subscription stats")
+	}
+	return &pb.SubscriptionStatsResponse{
+		ByStatus:     toCounts(stats.ByStatus),
+		ActiveByPlan: toCounts(stats.ActiveByPlan),
+	}, nil
+}
+
+func toCounts(m map[string]int) map[string]int32 {
+	out := make(map[string]int32, len(m))
+	for k, v := range m {
+		out[k] = int32(v)
+	}
+	return out
+}
+
 func toProto(sub *entity.Subscription) *pb.SubscriptionResponse {
 	return &pb.SubscriptionResponse{
 		Id:       sub.ID,
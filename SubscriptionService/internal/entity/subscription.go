@@ -13,3 +13,9 @@ type Subscription struct {
 	EndsAt    time.Time `db:"ends_at"`
 	UpdatedAt time.Time `db:"updated_at"`
 }
+
+// SubscriptionStats counts subscriptions for the admin dashboard.
+type SubscriptionStats struct {
+	ByStatus     map[string]int
+	ActiveByPlan map[string]int
+}
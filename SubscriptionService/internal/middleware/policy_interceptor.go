@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/auth"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"google.golang.org/grpc"
+)
+
+type SubscriptionGetter interface {
+	GetByID(ctx context.Context, id string) (*entity.Subscription, error)
+}
+
+// PolicyInterceptor enforces the shared role policy on the caller
+// authenticated by auth.UnaryServerInterceptor.
+func PolicyInterceptor(subscriptions SubscriptionGetter) grpc.UnaryServerInterceptor {
+	p := policy.Default()
+
+	p.RegisterOwner(policy.OwnerSubscription, func(ctx context.Context, id policy.Identity, req interface{}) (bool, error) {
+		r, ok := req.(interface{ GetId() string })
+		if !ok {
+			return false, nil
+		}
+		sub, err := subscriptions.GetByID(ctx, r.GetId())
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, policy.ErrNotFound
+		}
+		if err != nil {
+			return false, err
+		}
+		return sub.UserID == id.UserID, nil
+	})
+
+	return policy.UnaryServerInterceptor(p, auth.Identity)
+}
@@ -22,7 +22,7 @@ func (d *SubscriptionDAO) Create(ctx context.Context, sub *entity.Subscription)
 	`
 	_, err := d.db.NamedExecContext(ctx, query, sub)
 	if err != nil {
-		return fmt.Errorf("failed to create subscription: %v", err)
+		return fmt.Errorf("failed to create subscription: %w", err)
 	}
 	return nil
 }
@@ -65,7 +65,7 @@ func (d *SubscriptionDAO) Update(ctx context.Context, sub *entity.Subscription)
 	`
 	_, err := d.db.NamedExecContext(ctx, query, sub)
 	if err != nil {
-		return fmt.Errorf("failed to update subscription: %v", err)
+		return fmt.Errorf("failed to update subscription: %w", err)
 	}
 	return nil
 }
@@ -79,3 +79,24 @@ func (d *SubscriptionDAO) List(ctx context.Context) ([]*entity.Subscription, err
 	}
 	return subs, nil
 }
+
+func (d *SubscriptionDAO) Stats(ctx context.Context) (*entity.SubscriptionStats, error) {
+	var rows []struct {
+		Status   string `db:"status"`
+		PlanType string `db:"plan_type"`
+		Count    int    `db:"count"`
+	}
+	query := `SELECT status, plan_type, COUNT(*) AS count FROM subscription_plans GROUP BY status, plan_type`
+	if err := d.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, fmt.Errorf("failed to count subscriptions: %w", err)
+	}
+
+	stats := &entity.SubscriptionStats{ByStatus: map[string]int{}, ActiveByPlan: map[string]int{}}
+	for _, r := range rows {
+		stats.ByStatus[r.Status] += r.Count
+		if r.Status == "ACTIVE" {
+			stats.ActiveByPlan[r.PlanType] += r.Count
+		}
+	}
+	return stats, nil
+}
@@ -2,9 +2,11 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
 	"github.com/google/uuid"
 )
 
@@ -15,6 +17,7 @@ type SubscriptionRepo interface {
 	GetByUserIDHistory(ctx context.Context, userID string) ([]*entity.Subscription, error)
 	Update(ctx context.Context, sub *entity.Subscription) error
 	List(ctx context.Context) ([]*entity.Subscription, error)
+	Stats(ctx context.Context) (*entity.SubscriptionStats, error)
 }
 
 type SubscriptionUsecase struct {
@@ -30,6 +33,13 @@ func (u *SubscriptionUsecase) GetSubscription(ctx context.Context, userID string
 }
 
 func (u *SubscriptionUsecase) UpdateSubscription(ctx context.Context, userID, planType string, durationMonths int) (*entity.Subscription, error) {
+	if planType == "" {
+		return nil, fmt.Errorf("planType is required: %w", grpcerr.ErrInvalidArgument)
+	}
+	if durationMonths <= 0 {
+		return nil, fmt.Errorf("durationMonths must be positive: %w", grpcerr.ErrInvalidArgument)
+	}
+
 	existing, err := u.repo.GetByUserID(ctx, userID)
 
 	now := time.Now()
@@ -84,3 +94,7 @@ func (u *SubscriptionUsecase) GetSubscriptionHistory(ctx context.Context, userID
 func (u *SubscriptionUsecase) ListSubscriptions(ctx context.Context) ([]*entity.Subscription, error) {
 	return u.repo.List(ctx)
 }
+
+func (u *SubscriptionUsecase) GetSubscriptionStats(ctx context.Context) (*entity.SubscriptionStats, error) {
+	return u.repo.Stats(ctx)
+}
@@ -333,6 +333,58 @@ func (x *ListSubscriptionsResponse) GetSubscriptions() []*SubscriptionResponse {
 	return nil
 }
 
+type SubscriptionStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ByStatus      map[string]int32       `protobuf:"bytes,1,rep,name=by_status,json=byStatus,proto3" json:"by_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`               // ACTIVE, CANCELED, EXPIRED
+	ActiveByPlan  map[string]int32       `protobuf:"bytes,2,rep,name=active_by_plan,json=activeByPlan,proto3" json:"active_by_plan,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // BASIC, PRO
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionStatsResponse) Reset() {
+	*x = SubscriptionStatsResponse{}
+	mi := &file_proto_subscription_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionStatsResponse) ProtoMessage() {}
+
+func (x *SubscriptionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_subscription_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionStatsResponse.ProtoReflect.Descriptor instead.
+func (*SubscriptionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_subscription_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SubscriptionStatsResponse) GetByStatus() map[string]int32 {
+	if x != nil {
+		return x.ByStatus
+	}
+	return nil
+}
+
+func (x *SubscriptionStatsResponse) GetActiveByPlan() map[string]int32 {
+	if x != nil {
+		return x.ActiveByPlan
+	}
+	return nil
+}
+
 var File_proto_subscription_proto protoreflect.FileDescriptor
 
 const file_proto_subscription_proto_rawDesc = "" +
@@ -355,13 +407,23 @@ const file_proto_subscription_proto_rawDesc = "" +
 	"\tstarts_at\x18\x05 \x01(\tR\bstartsAt\x12\x17\n" +
 	"\aends_at\x18\x06 \x01(\tR\x06endsAt\"e\n" +
 	"\x19ListSubscriptionsResponse\x12H\n" +
-	"\rsubscriptions\x18\x01 \x03(\v2\".subscription.SubscriptionResponseR\rsubscriptions2\xf4\x03\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\".subscription.SubscriptionResponseR\rsubscriptions\"\xce\x02\n" +
+	"\x19SubscriptionStatsResponse\x12R\n" +
+	"\tby_status\x18\x01 \x03(\v25.subscription.SubscriptionStatsResponse.ByStatusEntryR\bbyStatus\x12_\n" +
+	"\x0eactive_by_plan\x18\x02 \x03(\v29.subscription.SubscriptionStatsResponse.ActiveByPlanEntryR\factiveByPlan\x1a;\n" +
+	"\rByStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a?\n" +
+	"\x11ActiveByPlanEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x012\xca\x04\n" +
 	"\x13SubscriptionService\x12[\n" +
 	"\x0fGetSubscription\x12$.subscription.GetSubscriptionRequest\x1a\".subscription.SubscriptionResponse\x12a\n" +
 	"\x12UpdateSubscription\x12'.subscription.UpdateSubscriptionRequest\x1a\".subscription.SubscriptionResponse\x12a\n" +
 	"\x12CancelSubscription\x12'.subscription.CancelSubscriptionRequest\x1a\".subscription.SubscriptionResponse\x12g\n" +
 	"\x16GetSubscriptionHistory\x12$.subscription.GetSubscriptionRequest\x1a'.subscription.ListSubscriptionsResponse\x12Q\n" +
-	"\x11ListSubscriptions\x12\x13.subscription.Empty\x1a'.subscription.ListSubscriptionsResponseB?Z=github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/protob\x06proto3"
+	"\x11ListSubscriptions\x12\x13.subscription.Empty\x1a'.subscription.ListSubscriptionsResponse\x12T\n" +
+	"\x14GetSubscriptionStats\x12\x13.subscription.Empty\x1a'.subscription.SubscriptionStatsResponseB?Z=github.com/KaminurOrynbek/BiznesAsh/SubscriptionService/protob\x06proto3"
 
 var (
 	file_proto_subscription_proto_rawDescOnce sync.Once
@@ -375,7 +437,7 @@ func file_proto_subscription_proto_rawDescGZIP() []byte {
 	return file_proto_subscription_proto_rawDescData
 }
 
-var file_proto_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proto_subscription_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_proto_subscription_proto_goTypes = []any{
 	(*CancelSubscriptionRequest)(nil), // 0: subscription.CancelSubscriptionRequest
 	(*Empty)(nil),                     // 1: subscription.Empty
@@ -383,24 +445,31 @@ var file_proto_subscription_proto_goTypes = []any{
 	(*UpdateSubscriptionRequest)(nil), // 3: subscription.UpdateSubscriptionRequest
 	(*SubscriptionResponse)(nil),      // 4: subscription.SubscriptionResponse
 	(*ListSubscriptionsResponse)(nil), // 5: subscription.ListSubscriptionsResponse
+	(*SubscriptionStatsResponse)(nil), // 6: subscription.SubscriptionStatsResponse
+	nil,                               // 7: subscription.SubscriptionStatsResponse.ByStatusEntry
+	nil,                               // 8: subscription.SubscriptionStatsResponse.ActiveByPlanEntry
 }
 var file_proto_subscription_proto_depIdxs = []int32{
 	4, // 0: subscription.ListSubscriptionsResponse.subscriptions:type_name -> subscription.SubscriptionResponse
-	2, // 1: subscription.SubscriptionService.GetSubscription:input_type -> subscription.GetSubscriptionRequest
-	3, // 2: subscription.SubscriptionService.UpdateSubscription:input_type -> subscription.UpdateSubscriptionRequest
-	0, // 3: subscription.SubscriptionService.CancelSubscription:input_type -> subscription.CancelSubscriptionRequest
-	2, // 4: subscription.SubscriptionService.GetSubscriptionHistory:input_type -> subscription.GetSubscriptionRequest
-	1, // 5: subscription.SubscriptionService.ListSubscriptions:input_type -> subscription.Empty
-	4, // 6: subscription.SubscriptionService.GetSubscription:output_type -> subscription.SubscriptionResponse
-	4, // 7: subscription.SubscriptionService.UpdateSubscription:output_type -> subscription.SubscriptionResponse
-	4, // 8: subscription.SubscriptionService.CancelSubscription:output_type -> subscription.SubscriptionResponse
-	5, // 9: subscription.SubscriptionService.GetSubscriptionHistory:output_type -> subscription.ListSubscriptionsResponse
-	5, // 10: subscription.SubscriptionService.ListSubscriptions:output_type -> subscription.ListSubscriptionsResponse
-	6, // [6:11] is the sub-list for method output_type
-	1, // [1:6] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	7, // 1: subscription.SubscriptionStatsResponse.by_status:type_name -> subscription.SubscriptionStatsResponse.ByStatusEntry
+	8, // 2: subscription.SubscriptionStatsResponse.active_by_plan:type_name -> subscription.SubscriptionStatsResponse.ActiveByPlanEntry
+	2, // 3: subscription.SubscriptionService.GetSubscription:input_type -> subscription.GetSubscriptionRequest
+	3, // 4: subscription.SubscriptionService.UpdateSubscription:input_type -> subscription.UpdateSubscriptionRequest
+	0, // 5: subscription.SubscriptionService.CancelSubscription:input_type -> subscription.CancelSubscriptionRequest
+	2, // 6: subscription.SubscriptionService.GetSubscriptionHistory:input_type -> subscription.GetSubscriptionRequest
+	1, // 7: subscription.SubscriptionService.ListSubscriptions:input_type -> subscription.Empty
+	1, // 8: subscription.SubscriptionService.GetSubscriptionStats:input_type -> subscription.Empty
+	4, // 9: subscription.SubscriptionService.GetSubscription:output_type -> subscription.SubscriptionResponse
+	4, // 10: subscription.SubscriptionService.UpdateSubscription:output_type -> subscription.SubscriptionResponse
+	4, // 11: subscription.SubscriptionService.CancelSubscription:output_type -> subscription.SubscriptionResponse
+	5, // 12: subscription.SubscriptionService.GetSubscriptionHistory:output_type -> subscription.ListSubscriptionsResponse
+	5, // 13: subscription.SubscriptionService.ListSubscriptions:output_type -> subscription.ListSubscriptionsResponse
+	6, // 14: subscription.SubscriptionService.GetSubscriptionStats:output_type -> subscription.SubscriptionStatsResponse
+	9, // [9:15] is the sub-list for method output_type
+	3, // [3:9] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_proto_subscription_proto_init() }
@@ -414,7 +483,7 @@ func file_proto_subscription_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_subscription_proto_rawDesc), len(file_proto_subscription_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,7 @@ const (
 	SubscriptionService_CancelSubscription_FullMethodName     = "/subscription.SubscriptionService/CancelSubscription"
 	SubscriptionService_GetSubscriptionHistory_FullMethodName = "/subscription.SubscriptionService/GetSubscriptionHistory"
 	SubscriptionService_ListSubscriptions_FullMethodName      = "/subscription.SubscriptionService/ListSubscriptions"
+	SubscriptionService_GetSubscriptionStats_FullMethodName   = "/subscription.SubscriptionService/GetSubscriptionStats"
 )
 
 // SubscriptionServiceClient is the client API for SubscriptionService service.
@@ -35,6 +36,7 @@ type SubscriptionServiceClient interface {
 	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
 	GetSubscriptionHistory(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
 	ListSubscriptions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
+	GetSubscriptionStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SubscriptionStatsResponse, error)
 }
 
 type subscriptionServiceClient struct {
@@ -95,6 +97,16 @@ func (c *subscriptionServiceClient) ListSubscriptions(ctx context.Context, in *E
 	return out, nil
 }
 
+func (c *subscriptionServiceClient) GetSubscriptionStats(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SubscriptionStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionStatsResponse)
+	err := c.cc.Invoke(ctx, SubscriptionService_GetSubscriptionStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubscriptionServiceServer is the server API for SubscriptionService service.
 // All implementations must embed UnimplementedSubscriptionServiceServer
 // for forward compatibility.
@@ -104,6 +116,7 @@ type SubscriptionServiceServer interface {
 	CancelSubscription(context.Context, *CancelSubscriptionRequest) (*SubscriptionResponse, error)
 	GetSubscriptionHistory(context.Context, *GetSubscriptionRequest) (*ListSubscriptionsResponse, error)
 	ListSubscriptions(context.Context, *Empty) (*ListSubscriptionsResponse, error)
+	GetSubscriptionStats(context.Context, *Empty) (*SubscriptionStatsResponse, error)
 	mustEmbedUnimplementedSubscriptionServiceServer()
 }
 
@@ -129,6 +142,9 @@ func (UnimplementedSubscriptionServiceServer) GetSubscriptionHistory(context.Con
 func (UnimplementedSubscriptionServiceServer) ListSubscriptions(context.Context, *Empty) (*ListSubscriptionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListSubscriptions not implemented")
 }
+func (UnimplementedSubscriptionServiceServer) GetSubscriptionStats(context.Context, *Empty) (*SubscriptionStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSubscriptionStats not implemented")
+}
 func (UnimplementedSubscriptionServiceServer) mustEmbedUnimplementedSubscriptionServiceServer() {}
 func (UnimplementedSubscriptionServiceServer) testEmbeddedByValue()                             {}
 
@@ -240,6 +256,24 @@ func _SubscriptionService_ListSubscriptions_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriptionService_GetSubscriptionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).GetSubscriptionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_GetSubscriptionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).GetSubscriptionStats(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SubscriptionService_ServiceDesc is the grpc.ServiceDesc for SubscriptionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +301,10 @@ var SubscriptionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSubscriptions",
 			Handler:    _SubscriptionService_ListSubscriptions_Handler,
 		},
+		{
+			MethodName: "GetSubscriptionStats",
+			Handler:    _SubscriptionService_GetSubscriptionStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/subscription.proto",
@@ -0,0 +1,64 @@
+// Command jwtkey writes a new access token signing key into the key directory
+// read by UserService (JWT_KEYS_DIR). Its id is the creation time, so unless
+// JWT_SIGNING_KEY_ID pins another key, UserService signs with the newest key
+// once it rereads the directory.
+//
+//	go run ./cmd/jwtkey -dir keys -alg EdDSA
+package main
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func main() {
+	dir := flag.String("dir", "keys", "key directory")
+	alg := flag.String("alg", "EdDSA", "EdDSA or RS256")
+	flag.Parse()
+
+	var private crypto.PrivateKey
+	var err error
+	switch *alg {
+	case "EdDSA":
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	case "RS256":
+		private, err = rsa.GenerateKey(rand.Reader, 3072)
+	default:
+		log.Fatalf("unsupported alg %q, want EdDSA or RS256", *alg)
+	}
+	if err != nil {
+		log.Fatalf("failed to generate key: %v", err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		log.Fatalf("failed to encode key: %v", err)
+	}
+	if err := os.MkdirAll(*dir, 0o700); err != nil {
+		log.Fatalf("failed to create %s: %v", *dir, err)
+	}
+
+	kid := time.Now().UTC().Format("20060102-150405")
+	path := filepath.Join(*dir, kid+".pem")
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		log.Fatalf("failed to create key file: %v", err)
+	}
+	if err := pem.Encode(f, &pem.Block{Type: "PRIVATE KEY", Bytes: der}); err != nil {
+		log.Fatalf("failed to write %s: %v", path, err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatalf("failed to write %s: %v", path, err)
+	}
+	fmt.Println(kid)
+}
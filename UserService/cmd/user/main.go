@@ -1,93 +1,150 @@
 package main
 
 import (
-	"log"
+	"context"
+	"log/slog"
 	"net"
-	"os"
+	"time"
 
 	"google.golang.org/grpc/reflection"
 
 	"github.com/jmoiron/sqlx"
-	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
 	gogrpc "google.golang.org/grpc"
 
 	pb "github.com/KaminurOrynbek/BiznesAsh/UserService/auto-proto/user"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/nats/publisher"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/nats/subscriber"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/postgres/dao"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/delivery/grpc"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/middleware"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/migration"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/token"
 	usecase "github.com/KaminurOrynbek/BiznesAsh/UserService/internal/usecase/Impl"
 	"github.com/KaminurOrynbek/BiznesAsh_lib/adapter/nats"
-	natscfg "github.com/KaminurOrynbek/BiznesAsh_lib/config/nats"
-	postgresCfg "github.com/KaminurOrynbek/BiznesAsh_lib/config/postgres"
+	redisclient "github.com/KaminurOrynbek/BiznesAsh_lib/adapter/redis"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/config"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/denylist"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/health"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/metrics"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
 	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/validate"
 )
 
+// keyReloadInterval is how often the signing key directory is reread, so
+// rotated keys are picked up without a restart.
+const keyReloadInterval = time.Minute
+
 func main() {
+	var cfg config.UserService
+	config.MustLoad("UserService", &cfg)
+	logging.Init("UserService")
 
-	_ = godotenv.Load(".env")
-	
-	// Try to load .env file, but don't fail if it doesn't exist
-	if err := godotenv.Load(); err != nil {
-		// Only log if it's not a "file not found" error
-		if !os.IsNotExist(err) {
-			log.Printf("Warning: Error loading .env file: %v", err)
-		}
-	}
+	shutdownTracing := tracing.MustInit("UserService")
+	defer shutdownTracing(context.Background())
 
 	// Init Postgres
-	pgConfig := postgresCfg.LoadPostgresConfig()
-	db, err := sqlx.Connect("postgres", pgConfig.DSN())
+	db, err := sqlx.Connect("postgres", cfg.Postgres.DSN())
 
 	if err != nil {
-		log.Fatalf("Failed to connect to Postgres: %v", err)
+		logging.Fatal("failed to connect to Postgres", logging.Err(err))
 	}
 	defer db.Close()
-	log.Println("Successfully connected to Postgres!")
+	slog.Info("connected to Postgres")
+	metrics.RegisterDB("UserService", db.DB)
 
-	
+	// Run migrations
+	migration.RunMigrations(cfg.Postgres.DSN())
 
-	// Init user repo
+	// Init user, session and password reset repos
 	userRepo := dao.NewUserDAO(db)
+	sessionRepo := dao.NewSessionDAO(db)
+	resetRepo := dao.NewPasswordResetDAO(db)
+
+	// Revoked access tokens
+	redisClient := redisclient.NewRedisClient(cfg.Redis.Addr, cfg.Redis.Password, cfg.Redis.DB)
+	revoked := newDenylist(redisClient)
+
+	// Access token signing keys
+	keys := newKeyStore(cfg.JWT, cfg.Sessions.AccessTTL)
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+	go keys.Watch(watchCtx, keyReloadInterval)
 
 	// NATS setup
-	natsConfig := natscfg.LoadNatsConfig()
-	natsConn := nats.NewConnection(natsConfig)
+	natsConn := nats.NewConnection(cfg.NATS)
 	defer natsConn.Close()
 
 	// Wrap NATS into queue-compatible interface
 	msgQueue := queue.NewNATSQueue(natsConn)
 
-	// Create publisher and usecase
+	// Create publisher, usecase and event subscribers
 	userPublisher := publisher.NewUserPublisher(msgQueue)
-	userUsecase := usecase.NewUserUsecase(userRepo, userPublisher)
-
-	// Get GRPC_PORT from environment
-	grpcPort := os.Getenv("GRPC_PORT")
-	if grpcPort == "" {
-		log.Fatal("GRPC_PORT is not set in environment variables")
-	}
+	userUsecase := usecase.NewUserUsecase(userRepo, sessionRepo, resetRepo, keys, revoked, userPublisher, cfg.Sessions)
+	subscriber.InitUserSubscribers(msgQueue, userUsecase)
 
 	// Create gRPC server
 	userServer := grpc.NewUserServer(userUsecase)
+	authz := policy.Default()
 	grpcServer := gogrpc.NewServer(
-		gogrpc.UnaryInterceptor(middleware.AuthInterceptor),
+		tracing.ServerOption(),
+		gogrpc.ChainUnaryInterceptor(logging.UnaryServerInterceptor(), metrics.UnaryServerInterceptor(), middleware.AuthInterceptor(keys, revoked, userUsecase, authz), middleware.PolicyInterceptor(authz), validate.UnaryServerInterceptor()),
 	)
 
 	// Register gRPC service
 	pb.RegisterUserServiceServer(grpcServer, userServer)
+	health.Register(grpcServer, pb.UserService_ServiceDesc.ServiceName, health.Postgres(db.DB), health.Redis(redisClient), health.NATS(natsConn))
+	if missing := policy.Uncovered(grpcServer); len(missing) > 0 {
+		logging.Fatal("gRPC methods without a policy rule", "methods", missing)
+	}
 
 	reflection.Register(grpcServer)
+	metrics.Serve(cfg.MetricsPort)
 
 	// Start listener
-	listener, err := net.Listen("tcp", ":"+grpcPort)
+	listener, err := net.Listen("tcp", ":"+cfg.GRPCPort)
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		logging.Fatal("failed to listen", logging.Err(err))
 	}
 
-	log.Printf("gRPC server listening on port %s", grpcPort)
+	slog.Info("gRPC server listening", "port", cfg.GRPCPort)
 	if err := grpcServer.Serve(listener); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+		logging.Fatal("failed to serve", logging.Err(err))
+	}
+}
+
+// newDenylist shares revoked tokens through Redis when it is reachable at
+// startup. Otherwise they are kept in memory, which only this process honours,
+// so local runs work without Redis; the health check still reports it missing.
+func newDenylist(redisClient *redisclient.RedisClient) denylist.Denylist {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if err := redisClient.Ping(ctx); err != nil {
+		slog.Warn("Redis unavailable, keeping the token denylist in memory", logging.Err(err))
+		return denylist.NewMemory()
+	}
+	slog.Info("connected to Redis")
+	return denylist.NewRedis(redisClient.Client)
+}
+
+// newKeyStore loads the signing keys from cfg.KeysDir. Without a directory it
+// generates a key for this process only, which other replicas won't accept.
+// Removed keys stay valid for verification for one access token lifetime.
+func newKeyStore(cfg config.JWT, accessTTL time.Duration) *token.KeyStore {
+	if cfg.KeysDir == "" {
+		slog.Warn("JWT_DEV_KEY set, signing access tokens with a key generated for this process")
+		keys, err := token.NewEphemeralKeyStore()
+		if err != nil {
+			logging.Fatal("failed to generate signing key", logging.Err(err))
+		}
+		return keys
+	}
+	keys, err := token.LoadKeyStore(cfg.KeysDir, cfg.SigningKeyID, accessTTL)
+	if err != nil {
+		logging.Fatal("failed to load signing keys", "dir", cfg.KeysDir, logging.Err(err))
 	}
+	return keys
 }
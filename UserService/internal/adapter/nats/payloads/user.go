@@ -4,5 +4,50 @@ type UserEventPayload struct {
 	UserID string `json:"user_id"`
 	Email  string `json:"email,omitempty"`
 	Role   string `json:"role,omitempty"`
-	Reason string `json:"reason,omitempty"`
+}
+
+// BanReason says why a ban was applied or lifted. Code is one of the reasons
+// in entity/enum; Details is the staff member's note.
+type BanReason struct {
+	Code    string `json:"code"`
+	Details string `json:"details,omitempty"`
+}
+
+// UserBanEventPayload is published on user.banned and user.unbanned. ExpiresAt
+// (RFC 3339) is set for suspensions; ActorID is empty when UserService lifted
+// an expired suspension itself.
+type UserBanEventPayload struct {
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email,omitempty"`
+	Reason    BanReason `json:"reason"`
+	ExpiresAt string    `json:"expires_at,omitempty"`
+	ActorID   string    `json:"actor_id,omitempty"`
+}
+
+// PasswordResetEventPayload is published on user.password_reset_requested for
+// NotificationService to email. Token is the plain reset token, so the event
+// must not be logged; ExpiresAt is RFC 3339.
+type PasswordResetEventPayload struct {
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// PasswordChangedEventPayload is published on user.password_changed. Method is
+// "reset" when the password was set through a reset email and "change" when
+// the user changed it while signed in.
+type PasswordChangedEventPayload struct {
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	Method    string `json:"method"`
+	ChangedAt string `json:"changed_at"`
+}
+
+// EmailVerifiedEventPayload arrives on user.email_verified from
+// NotificationService once the user enters their code. VerifiedAt is RFC 3339.
+type EmailVerifiedEventPayload struct {
+	UserID     string `json:"user_id"`
+	Email      string `json:"email"`
+	VerifiedAt string `json:"verified_at"`
 }
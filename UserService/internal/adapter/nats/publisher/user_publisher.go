@@ -1,10 +1,13 @@
 package publisher
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/nats/payloads"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
 	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
-	"log"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
+	"log/slog"
 )
 
 const (
@@ -14,6 +17,9 @@ const (
 	UserPromotedToAdminSubject     = "user.promoted_to_admin"
 	UserDemotedSubject             = "user.demoted"
 	UserBannedSubject              = "user.banned"
+	UserUnbannedSubject            = "user.unbanned"
+	PasswordResetRequestedSubject  = "user.password_reset_requested"
+	PasswordChangedSubject         = "user.password_changed"
 )
 
 type UserPublisher struct {
@@ -24,35 +30,47 @@ func NewUserPublisher(q queue.MessageQueue) *UserPublisher {
 	return &UserPublisher{queue: q}
 }
 
-func (p *UserPublisher) publish(subject string, payload any) error {
+func (p *UserPublisher) publish(ctx context.Context, subject string, payload any) error {
 	data, err := json.Marshal(payload)
 	if err != nil {
-		log.Printf("Failed to marshal event payload: %v", err)
+		slog.ErrorContext(ctx, "failed to marshal event payload", "subject", subject, logging.Err(err))
 		return err
 	}
-	return p.queue.Publish(subject, data)
+	return tracing.Publish(ctx, p.queue, subject, data)
 }
 
-func (p *UserPublisher) PublishUserRegistered(payload payloads.UserEventPayload) error {
-	return p.publish(UserRegisteredSubject, payload)
+func (p *UserPublisher) PublishUserRegistered(ctx context.Context, payload payloads.UserEventPayload) error {
+	return p.publish(ctx, UserRegisteredSubject, payload)
 }
 
-func (p *UserPublisher) PublishUserDeleted(payload payloads.UserEventPayload) error {
-	return p.publish(UserDeletedSubject, payload)
+func (p *UserPublisher) PublishUserDeleted(ctx context.Context, payload payloads.UserEventPayload) error {
+	return p.publish(ctx, UserDeletedSubject, payload)
 }
 
-func (p *UserPublisher) PublishUserPromotedToModerator(payload payloads.UserEventPayload) error {
-	return p.publish(UserPromotedToModeratorSubject, payload)
+func (p *UserPublisher) PublishUserPromotedToModerator(ctx context.Context, payload payloads.UserEventPayload) error {
+	return p.publish(ctx, UserPromotedToModeratorSubject, payload)
 }
 
-func (p *UserPublisher) PublishUserPromotedToAdmin(payload payloads.UserEventPayload) error {
-	return p.publish(UserPromotedToAdminSubject, payload)
+func (p *UserPublisher) PublishUserPromotedToAdmin(ctx context.Context, payload payloads.UserEventPayload) error {
+	return p.publish(ctx, UserPromotedToAdminSubject, payload)
 }
 
-func (p *UserPublisher) PublishUserDemoted(payload payloads.UserEventPayload) error {
-	return p.publish(UserDemotedSubject, payload)
+func (p *UserPublisher) PublishUserDemoted(ctx context.Context, payload payloads.UserEventPayload) error {
+	return p.publish(ctx, UserDemotedSubject, payload)
 }
 
-func (p *UserPublisher) PublishUserBanned(payload payloads.UserEventPayload) error {
-	return p.publish(UserBannedSubject, payload)
+func (p *UserPublisher) PublishUserBanned(ctx context.Context, payload payloads.UserBanEventPayload) error {
+	return p.publish(ctx, UserBannedSubject, payload)
+}
+
+func (p *UserPublisher) PublishUserUnbanned(ctx context.Context, payload payloads.UserBanEventPayload) error {
+	return p.publish(ctx, UserUnbannedSubject, payload)
+}
+
+func (p *UserPublisher) PublishPasswordResetRequested(ctx context.Context, payload payloads.PasswordResetEventPayload) error {
+	return p.publish(ctx, PasswordResetRequestedSubject, payload)
+}
+
+func (p *UserPublisher) PublishPasswordChanged(ctx context.Context, payload payloads.PasswordChangedEventPayload) error {
+	return p.publish(ctx, PasswordChangedSubject, payload)
 }
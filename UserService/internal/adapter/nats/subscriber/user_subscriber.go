@@ -0,0 +1,41 @@
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/nats/payloads"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
+)
+
+const EmailVerifiedSubject = "user.email_verified"
+
+// EmailVerifier records verified email addresses.
+type EmailVerifier interface {
+	MarkEmailVerified(ctx context.Context, userID, email string, at time.Time) error
+}
+
+// InitUserSubscribers handles the user events other services publish.
+func InitUserSubscribers(q queue.MessageQueue, verifier EmailVerifier) {
+	err := tracing.Subscribe(q, EmailVerifiedSubject, func(ctx context.Context, data []byte) {
+		var payload payloads.EmailVerifiedEventPayload
+		if err := json.Unmarshal(data, &payload); err != nil {
+			slog.ErrorContext(ctx, "failed to parse event", "subject", EmailVerifiedSubject, logging.Err(err))
+			return
+		}
+		at, err := time.Parse(time.RFC3339, payload.VerifiedAt)
+		if err != nil {
+			at = time.Now()
+		}
+		if err := verifier.MarkEmailVerified(ctx, payload.UserID, payload.Email, at); err != nil {
+			slog.ErrorContext(ctx, "failed to mark email verified", "user_id", payload.UserID, logging.Err(err))
+		}
+	})
+	if err != nil {
+		slog.Error("failed to subscribe", "subject", EmailVerifiedSubject, logging.Err(err))
+	}
+}
@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/postgres/model"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+	"github.com/jmoiron/sqlx"
+)
+
+type PasswordResetDAO struct {
+	db *sqlx.DB
+}
+
+func NewPasswordResetDAO(db *sqlx.DB) *PasswordResetDAO {
+	return &PasswordResetDAO{db: db}
+}
+
+func (d *PasswordResetDAO) CreatePasswordReset(ctx context.Context, reset *entity.PasswordReset) error {
+	tx, err := d.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create password reset: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `DELETE FROM password_resets WHERE user_id = $1 OR expires_at <= NOW()`
+	if _, err := tx.ExecContext(ctx, query, reset.UserID); err != nil {
+		return fmt.Errorf("failed to clear password resets: %w", err)
+	}
+	query = `
+        INSERT INTO password_resets (token_hash, user_id, created_at, expires_at)
+        VALUES (:token_hash, :user_id, :created_at, :expires_at)
+    `
+	if _, err := tx.NamedExecContext(ctx, query, model.ToPasswordResetDB(reset)); err != nil {
+		return fmt.Errorf("failed to create password reset: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to create password reset: %w", err)
+	}
+	return nil
+}
+
+func (d *PasswordResetDAO) ConsumePasswordReset(ctx context.Context, hash string) (*entity.PasswordReset, error) {
+	var dtoReset model.PasswordResetDB
+	query := `DELETE FROM password_resets WHERE token_hash = $1 RETURNING *`
+	if err := d.db.GetContext(ctx, &dtoReset, query, hash); err != nil {
+		return nil, fmt.Errorf("failed to consume password reset: %w", err)
+	}
+	return model.ToEntityPasswordReset(&dtoReset), nil
+}
+
+func (d *PasswordResetDAO) DeleteUserPasswordResets(ctx context.Context, userID string) error {
+	query := `DELETE FROM password_resets WHERE user_id = $1`
+	if _, err := d.db.ExecContext(ctx, query, userID); err != nil {
+		return fmt.Errorf("failed to delete password resets: %w", err)
+	}
+	return nil
+}
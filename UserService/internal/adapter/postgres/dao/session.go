@@ -0,0 +1,107 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/postgres/model"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+	"github.com/jmoiron/sqlx"
+)
+
+type SessionDAO struct {
+	db *sqlx.DB
+}
+
+func NewSessionDAO(db *sqlx.DB) *SessionDAO {
+	return &SessionDAO{db: db}
+}
+
+func (d *SessionDAO) CreateSession(ctx context.Context, session *entity.Session) error {
+	query := `
+        INSERT INTO sessions (id, user_id, refresh_token_hash, previous_token_hash, access_token_id,
+                              device, ip, created_at, last_seen_at, expires_at)
+        VALUES (:id, :user_id, :refresh_token_hash, :previous_token_hash, :access_token_id,
+                :device, :ip, :created_at, :last_seen_at, :expires_at)
+    `
+	if _, err := d.db.NamedExecContext(ctx, query, model.ToSessionDB(session)); err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+	return nil
+}
+
+func (d *SessionDAO) GetSessionByTokenHash(ctx context.Context, hash string) (*entity.Session, error) {
+	var dtoSession model.SessionDB
+	query := `SELECT * FROM sessions WHERE refresh_token_hash = $1 OR previous_token_hash = $1 LIMIT 1`
+	if err := d.db.GetContext(ctx, &dtoSession, query, hash); err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	return model.ToEntitySession(&dtoSession), nil
+}
+
+func (d *SessionDAO) RotateSession(ctx context.Context, session *entity.Session, presentedHash string) (bool, error) {
+	query := `
+        UPDATE sessions
+        SET refresh_token_hash = $1, previous_token_hash = $2, access_token_id = $3,
+            ip = $4, last_seen_at = $5, expires_at = $6
+        WHERE id = $7 AND refresh_token_hash = $2 AND revoked_at IS NULL
+    `
+	res, err := d.db.ExecContext(ctx, query,
+		session.RefreshTokenHash, presentedHash, session.AccessTokenID,
+		session.IP, session.LastSeenAt, session.ExpiresAt, session.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to rotate session: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to rotate session: %w", err)
+	}
+	return n == 1, nil
+}
+
+func (d *SessionDAO) ListSessions(ctx context.Context, userID string) ([]*entity.Session, error) {
+	var dtoSessions []model.SessionDB
+	query := `
+        SELECT * FROM sessions
+        WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+        ORDER BY last_seen_at DESC
+    `
+	if err := d.db.SelectContext(ctx, &dtoSessions, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	return toEntitySessions(dtoSessions), nil
+}
+
+func (d *SessionDAO) RevokeSession(ctx context.Context, userID, sessionID string) (*entity.Session, error) {
+	var dtoSession model.SessionDB
+	query := `
+        UPDATE sessions SET revoked_at = NOW()
+        WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+        RETURNING *
+    `
+	if err := d.db.GetContext(ctx, &dtoSession, query, sessionID, userID); err != nil {
+		return nil, fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return model.ToEntitySession(&dtoSession), nil
+}
+
+func (d *SessionDAO) RevokeUserSessions(ctx context.Context, userID string) ([]*entity.Session, error) {
+	var dtoSessions []model.SessionDB
+	query := `
+        UPDATE sessions SET revoked_at = NOW()
+        WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+        RETURNING *
+    `
+	if err := d.db.SelectContext(ctx, &dtoSessions, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return toEntitySessions(dtoSessions), nil
+}
+
+func toEntitySessions(dtoSessions []model.SessionDB) []*entity.Session {
+	sessions := make([]*entity.Session, len(dtoSessions))
+	for i := range dtoSessions {
+		sessions[i] = model.ToEntitySession(&dtoSessions[i])
+	}
+	return sessions
+}
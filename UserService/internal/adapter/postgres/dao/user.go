@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/postgres/model"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity/enum"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 )
@@ -20,40 +23,67 @@ func NewUserDAO(db *sqlx.DB) *UserDAO {
 func (d *UserDAO) CreateUser(ctx context.Context, user *entity.User) (*entity.User, error) {
 	dtoUser := model.ToUserDB(user)
 	query := `
-        INSERT INTO users (id, email, username, password, role, bio, banned, created_at, updated_at)
-        VALUES (:id, :email, :username, :password, :role, :bio, :banned, :created_at, :updated_at)
+        INSERT INTO users (id, email, username, password, role, bio, status, ban_reason, ban_details,
+                           banned_by, banned_until, email_verified_at, created_at, updated_at)
+        VALUES (:id, :email, :username, :password, :role, :bio, :status, :ban_reason, :ban_details,
+                :banned_by, :banned_until, :email_verified_at, :created_at, :updated_at)
     `
 	_, err := d.db.NamedExecContext(ctx, query, dtoUser)
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" { // Unique violation
-			if pqErr.Constraint == "users_email_key" {
-				return nil, fmt.Errorf("email %s already exists", user.Email)
-			}
-			if pqErr.Constraint == "users_username_key" {
-				return nil, fmt.Errorf("username %s already exists", user.Username)
-			}
+		if dupErr := duplicateUserError(err, user); dupErr != nil {
+			return nil, dupErr
 		}
-		return nil, fmt.Errorf("failed to create user: %v", err)
+		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 	return user, nil
 }
 
+// duplicateUserError reports which unique column a write collided with, or nil.
+func duplicateUserError(err error, user *entity.User) error {
+	pqErr, ok := err.(*pq.Error)
+	if !ok || pqErr.Code != "23505" { // Unique violation
+		return nil
+	}
+	switch pqErr.Constraint {
+	case "users_email_key":
+		return fmt.Errorf("email %s: %w", user.Email, grpcerr.ErrAlreadyExists)
+	case "users_username_key":
+		return fmt.Errorf("username %s: %w", user.Username, grpcerr.ErrAlreadyExists)
+	}
+	return nil
+}
+
 func (d *UserDAO) GetUserByID(ctx context.Context, id string) (*entity.User, error) {
 	var dtoUser model.UserDB
 	query := `SELECT * FROM users WHERE id = $1`
 	err := d.db.GetContext(ctx, &dtoUser, query, id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user by id: %v", err)
+		return nil, fmt.Errorf("failed to get user by id: %w", err)
 	}
 	return model.ToEntityUser(&dtoUser), nil
 }
 
+func (d *UserDAO) GetUsersByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
+	var dtoUsers []model.UserDB
+	query := `SELECT * FROM users WHERE id = ANY($1)`
+	err := d.db.SelectContext(ctx, &dtoUsers, query, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users by ids: %w", err)
+	}
+
+	users := make([]*entity.User, len(dtoUsers))
+	for i := range dtoUsers {
+		users[i] = model.ToEntityUser(&dtoUsers[i])
+	}
+	return users, nil
+}
+
 func (d *UserDAO) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
 	var dtoUser model.UserDB
 	query := `SELECT * FROM users WHERE email = $1`
 	err := d.db.GetContext(ctx, &dtoUser, query, email)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user by email: %v", err)
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
 	}
 	return model.ToEntityUser(&dtoUser), nil
 }
@@ -63,12 +93,17 @@ func (d *UserDAO) UpdateUser(ctx context.Context, user *entity.User) error {
 	query := `
         UPDATE users
         SET email = :email, username = :username, password = :password, role = :role,
-            bio = :bio, banned = :banned, updated_at = :updated_at
+            bio = :bio, status = :status, ban_reason = :ban_reason, ban_details = :ban_details,
+            banned_by = :banned_by, banned_until = :banned_until, email_verified_at = :email_verified_at,
+            updated_at = :updated_at
         WHERE id = :id
     `
 	_, err := d.db.NamedExecContext(ctx, query, dtoUser)
 	if err != nil {
-		return fmt.Errorf("failed to update user: %v", err)
+		if dupErr := duplicateUserError(err, user); dupErr != nil {
+			return dupErr
+		}
+		return fmt.Errorf("failed to update user: %w", err)
 	}
 	return nil
 }
@@ -77,50 +112,106 @@ func (d *UserDAO) DeleteUser(ctx context.Context, id string) error {
 	query := `DELETE FROM users WHERE id = $1`
 	_, err := d.db.ExecContext(ctx, query, id)
 	if err != nil {
-		return fmt.Errorf("failed to delete user: %v", err)
+		return fmt.Errorf("failed to delete user: %w", err)
 	}
 	return nil
 }
-func (d *UserDAO) ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error) {
-	var dtoUsers []model.UserDB
-	// Convert RepoInterfaces.UserFilter to entity.UserFilter
-	entityFilter := entity.UserFilter{
-		Email:    filter.Email,
-		Username: filter.Username,
-		Role:     filter.Role,
-		Banned:   filter.Banned,
-		Limit:    filter.Limit,
-		Offset:   filter.Offset,
-	}
-
-	// Build the query using entityFilter
-	query := `SELECT * FROM users WHERE 1=1`
+
+// ListUsers returns one page of the users matching filter, newest first, and
+// how many match in total.
+func (d *UserDAO) ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, int, error) {
+	where := ` WHERE 1=1`
 	args := []interface{}{}
-	if entityFilter.Email != "" {
-		query += ` AND email ILIKE $` + fmt.Sprint(len(args)+1)
-		args = append(args, "%"+entityFilter.Email+"%")
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return "$" + fmt.Sprint(len(args))
+	}
+	if filter.SearchQuery != "" {
+		p := arg("%" + filter.SearchQuery + "%")
+		where += ` AND (email ILIKE ` + p + ` OR username ILIKE ` + p + `)`
+	}
+	if filter.Email != "" {
+		where += ` AND email ILIKE ` + arg("%"+filter.Email+"%")
+	}
+	if filter.Username != "" {
+		where += ` AND username ILIKE ` + arg("%"+filter.Username+"%")
+	}
+	if filter.Role != "" {
+		where += ` AND role = ` + arg(filter.Role)
+	}
+	switch enum.AccountStatus(filter.Status) {
+	case "":
+	case enum.StatusActive:
+		where += ` AND (status = 'active' OR (status = 'suspended' AND banned_until <= NOW() AND email_verified_at IS NOT NULL))`
+	case enum.StatusUnverified:
+		where += ` AND (status = 'unverified' OR (status = 'suspended' AND banned_until <= NOW() AND email_verified_at IS NULL))`
+	case enum.StatusSuspended:
+		where += ` AND status = 'suspended' AND banned_until > NOW()`
+	default:
+		where += ` AND status = ` + arg(filter.Status)
 	}
-	// (Other conditions here)
 
-	// Execute the query
-	err := d.db.SelectContext(ctx, &dtoUsers, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list users: %v", err)
+	var total int
+	if err := d.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM users`+where, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	query := `SELECT * FROM users` + where + ` ORDER BY created_at DESC, id`
+	if filter.Limit > 0 {
+		query += ` LIMIT ` + arg(filter.Limit) + ` OFFSET ` + arg(filter.Offset)
+	}
+	var dtoUsers []model.UserDB
+	if err := d.db.SelectContext(ctx, &dtoUsers, query, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to list users: %w", err)
 	}
 
-	// Convert DTOs to entities
 	users := make([]*entity.User, len(dtoUsers))
-	for i, dtoUser := range dtoUsers {
-		users[i] = model.ToEntityUser(&dtoUser)
+	for i := range dtoUsers {
+		users[i] = model.ToEntityUser(&dtoUsers[i])
 	}
-	return users, nil
+	return users, total, nil
+}
+
+// effectiveStatus is the status column with suspensions that have run out
+// counted as active, or unverified; they are only reset when the user next
+// signs in.
+const effectiveStatus = `CASE WHEN status = 'suspended' AND banned_until <= NOW()
+    THEN CASE WHEN email_verified_at IS NULL THEN 'unverified' ELSE 'active' END
+    ELSE status END`
+
+// Stats counts users by role and account status.
+func (d *UserDAO) Stats(ctx context.Context) (*entity.UserStats, error) {
+	var rows []struct {
+		Role   string `db:"role"`
+		Status string `db:"status"`
+		Count  int    `db:"count"`
+	}
+	query := `SELECT role, ` + effectiveStatus + ` AS status, COUNT(*) AS count FROM users GROUP BY 1, 2`
+	if err := d.db.SelectContext(ctx, &rows, query); err != nil {
+		return nil, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	stats := &entity.UserStats{
+		ByRole:   make(map[policy.Role]int),
+		ByStatus: make(map[enum.AccountStatus]int),
+	}
+	for _, r := range rows {
+		status := enum.AccountStatus(r.Status)
+		stats.Total += r.Count
+		stats.ByRole[policy.Role(r.Role)] += r.Count
+		stats.ByStatus[status] += r.Count
+		if status == enum.StatusBanned || status == enum.StatusSuspended {
+			stats.Banned += r.Count
+		}
+	}
+	return stats, nil
 }
 
 func (d *UserDAO) BanUser(ctx context.Context, id string) error {
-	query := `UPDATE users SET banned = true, updated_at = CURRENT_TIMESTAMP WHERE id = $1`
+	query := `UPDATE users SET status = 'banned', updated_at = CURRENT_TIMESTAMP WHERE id = $1`
 	_, err := d.db.ExecContext(ctx, query, id)
 	if err != nil {
-		return fmt.Errorf("failed to ban user: %v", err)
+		return fmt.Errorf("failed to ban user: %w", err)
 	}
 	return nil
 }
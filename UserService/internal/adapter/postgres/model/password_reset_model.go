@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+)
+
+// PasswordResetDB maps a row of the password_resets table.
+type PasswordResetDB struct {
+	TokenHash string    `db:"token_hash"`
+	UserID    string    `db:"user_id"`
+	CreatedAt time.Time `db:"created_at"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+func ToPasswordResetDB(r *entity.PasswordReset) *PasswordResetDB {
+	return &PasswordResetDB{
+		TokenHash: r.TokenHash,
+		UserID:    r.UserID,
+		CreatedAt: r.CreatedAt,
+		ExpiresAt: r.ExpiresAt,
+	}
+}
+
+func ToEntityPasswordReset(r *PasswordResetDB) *entity.PasswordReset {
+	return &entity.PasswordReset{
+		TokenHash: r.TokenHash,
+		UserID:    r.UserID,
+		CreatedAt: r.CreatedAt,
+		ExpiresAt: r.ExpiresAt,
+	}
+}
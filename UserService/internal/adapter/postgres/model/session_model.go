@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+)
+
+// SessionDB maps a row of the sessions table.
+type SessionDB struct {
+	ID                string     `db:"id"`
+	UserID            string     `db:"user_id"`
+	RefreshTokenHash  string     `db:"refresh_token_hash"`
+	PreviousTokenHash string     `db:"previous_token_hash"`
+	AccessTokenID     string     `db:"access_token_id"`
+	Device            string     `db:"device"`
+	IP                string     `db:"ip"`
+	CreatedAt         time.Time  `db:"created_at"`
+	LastSeenAt        time.Time  `db:"last_seen_at"`
+	ExpiresAt         time.Time  `db:"expires_at"`
+	RevokedAt         *time.Time `db:"revoked_at"`
+}
+
+func ToSessionDB(s *entity.Session) *SessionDB {
+	return &SessionDB{
+		ID:                s.ID,
+		UserID:            s.UserID,
+		RefreshTokenHash:  s.RefreshTokenHash,
+		PreviousTokenHash: s.PreviousTokenHash,
+		AccessTokenID:     s.AccessTokenID,
+		Device:            s.Device,
+		IP:                s.IP,
+		CreatedAt:         s.CreatedAt,
+		LastSeenAt:        s.LastSeenAt,
+		ExpiresAt:         s.ExpiresAt,
+		RevokedAt:         s.RevokedAt,
+	}
+}
+
+func ToEntitySession(s *SessionDB) *entity.Session {
+	return &entity.Session{
+		ID:                s.ID,
+		UserID:            s.UserID,
+		RefreshTokenHash:  s.RefreshTokenHash,
+		PreviousTokenHash: s.PreviousTokenHash,
+		AccessTokenID:     s.AccessTokenID,
+		Device:            s.Device,
+		IP:                s.IP,
+		CreatedAt:         s.CreatedAt,
+		LastSeenAt:        s.LastSeenAt,
+		ExpiresAt:         s.ExpiresAt,
+		RevokedAt:         s.RevokedAt,
+	}
+}
@@ -5,47 +5,63 @@ import (
 
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity/enum"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
 )
 
 // UserDTO используется для маппинга данных с базой данных
 type UserDB struct {
-	ID        string    `db:"id"`
-	Email     string    `db:"email"`
-	Username  string    `db:"username"`
-	Password  string    `db:"password"`
-	Role      string    `db:"role"`
-	Bio       string    `db:"bio"`
-	Banned    bool      `db:"banned"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID              string     `db:"id"`
+	Email           string     `db:"email"`
+	Username        string     `db:"username"`
+	Password        string     `db:"password"`
+	Role            string     `db:"role"`
+	Bio             string     `db:"bio"`
+	Status          string     `db:"status"`
+	BanReason       string     `db:"ban_reason"`
+	BanDetails      string     `db:"ban_details"`
+	BannedBy        string     `db:"banned_by"`
+	BannedUntil     *time.Time `db:"banned_until"`
+	EmailVerifiedAt *time.Time `db:"email_verified_at"`
+	CreatedAt       time.Time  `db:"created_at"`
+	UpdatedAt       time.Time  `db:"updated_at"`
 }
 
 // ToUserDTO конвертирует entity.User в UserDTO
 func ToUserDB(user *entity.User) *UserDB {
 	return &UserDB{
-		ID:        user.ID,
-		Email:     user.Email,
-		Username:  user.Username,
-		Password:  user.Password,
-		Role:      string(user.Role),
-		Bio:       user.Bio,
-		Banned:    user.Banned,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:              user.ID,
+		Email:           user.Email,
+		Username:        user.Username,
+		Password:        user.Password,
+		Role:            string(user.Role),
+		Bio:             user.Bio,
+		Status:          string(user.Status),
+		BanReason:       user.BanReason,
+		BanDetails:      user.BanDetails,
+		BannedBy:        user.BannedBy,
+		BannedUntil:     user.BannedUntil,
+		EmailVerifiedAt: user.EmailVerifiedAt,
+		CreatedAt:       user.CreatedAt,
+		UpdatedAt:       user.UpdatedAt,
 	}
 }
 
 // ToEntityUser конвертирует UserDTO в entity.User
 func ToEntityUser(dto *UserDB) *entity.User {
 	return &entity.User{
-		ID:        dto.ID,
-		Email:     dto.Email,
-		Username:  dto.Username,
-		Password:  dto.Password,
-		Role:      enum.Role(dto.Role), // Преобразуем string в enum.Role
-		Bio:       dto.Bio,
-		Banned:    dto.Banned,
-		CreatedAt: dto.CreatedAt,
-		UpdatedAt: dto.UpdatedAt,
+		ID:              dto.ID,
+		Email:           dto.Email,
+		Username:        dto.Username,
+		Password:        dto.Password,
+		Role:            policy.Role(dto.Role), // Преобразуем string в policy.Role
+		Bio:             dto.Bio,
+		Status:          enum.AccountStatus(dto.Status),
+		BanReason:       dto.BanReason,
+		BanDetails:      dto.BanDetails,
+		BannedBy:        dto.BannedBy,
+		BannedUntil:     dto.BannedUntil,
+		EmailVerifiedAt: dto.EmailVerifiedAt,
+		CreatedAt:       dto.CreatedAt,
+		UpdatedAt:       dto.UpdatedAt,
 	}
 }
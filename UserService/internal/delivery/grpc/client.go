@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"net"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Metadata the gateway forwards about the end client, which a session records.
+const (
+	metadataClientIP  = "x-client-ip"
+	metadataUserAgent = "x-client-user-agent"
+)
+
+// clientInfo describes the client behind the call: what the gateway forwarded,
+// or the peer address for direct gRPC callers.
+func clientInfo(ctx context.Context) entity.ClientInfo {
+	var client entity.ClientInfo
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(metadataClientIP); len(v) > 0 {
+			client.IP = v[0]
+		}
+		if v := md.Get(metadataUserAgent); len(v) > 0 {
+			client.Device = v[0]
+		}
+	}
+	if client.IP == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			client.IP = p.Addr.String()
+			if host, _, err := net.SplitHostPort(client.IP); err == nil {
+				client.IP = host
+			}
+		}
+	}
+	return client
+}
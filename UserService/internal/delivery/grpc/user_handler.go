@@ -2,13 +2,24 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/KaminurOrynbek/BiznesAsh/UserService/auto-proto/user"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/middleware"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/usecase/Usecase_Interfaces"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/auth"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// ListUsers paging defaults.
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
 type UserServer struct {
 	pb.UnimplementedUserServiceServer
 	userUsecase Usecase_Interfaces.UserUsecase
@@ -19,47 +30,188 @@ func NewUserServer(userUsecase Usecase_Interfaces.UserUsecase) *UserServer {
 }
 
 func (s *UserServer) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
-	user, err := s.userUsecase.Register(req.Email, req.Username, req.Password)
+	user, err := s.userUsecase.Register(ctx, req.Email, req.Username, req.Password)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to register: %v", err)
+		return nil, grpcerr.Wrap(err, "failed to register")
 	}
 
 	// После успешной регистрации сразу выполняем вход для генерации токена
-	_, token, err := s.userUsecase.Login(req.Email, req.Password)
+	_, tokens, err := s.userUsecase.Login(ctx, req.Email, req.Password, clientInfo(ctx))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to generate token after registration: %v", err)
+		return nil, grpcerr.Wrap(err, "failed to generate token after registration")
 	}
 
 	return &pb.RegisterResponse{
-		UserId:   user.ID,
-		Username: user.Username,
-		Email:    user.Email,
-		Role:     string(user.Role),
-		Token:    token,
+		UserId:       user.ID,
+		Username:     user.Username,
+		Email:        user.Email,
+		Role:         string(user.Role),
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    int32(tokens.ExpiresIn.Seconds()),
 	}, nil
 }
 
 func (s *UserServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
-	user, token, err := s.userUsecase.Login(req.Email, req.Password)
+	user, tokens, err := s.userUsecase.Login(ctx, req.Email, req.Password, clientInfo(ctx))
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "failed to login: %v", err)
+		return nil, grpcerr.Wrap(err, "failed to login")
 	}
 
-	return &pb.LoginResponse{
-		UserId: user.ID,
-		Token:  token,
+	return toLoginResponse(user, tokens), nil
+}
+
+func (s *UserServer) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.LoginResponse, error) {
+	user, tokens, err := s.userUsecase.RefreshToken(ctx, req.RefreshToken, clientInfo(ctx))
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to refresh token")
+	}
+
+	return toLoginResponse(user, tokens), nil
+}
+
+// Logout ends the session named in the request, or the caller's current one.
+func (s *UserServer) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	userID := auth.Identity(ctx).UserID
+	if userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	sessionID := req.GetSessionId()
+	if sessionID == "" {
+		sessionID = middleware.SessionID(ctx)
+	}
+	if sessionID == "" {
+		// Tokens issued before sessions existed don't name one
+		return nil, status.Errorf(codes.InvalidArgument, "sessionId is required")
+	}
+
+	if err := s.userUsecase.Logout(ctx, userID, sessionID); err != nil {
+		return nil, grpcerr.Wrap(err, "failed to logout")
+	}
+
+	return &pb.LogoutResponse{
+		Success:         true,
+		Message:         "Logged out",
+		SessionsRevoked: 1,
 	}, nil
 }
 
+func (s *UserServer) LogoutAllSessions(ctx context.Context, _ *pb.Empty) (*pb.LogoutResponse, error) {
+	userID := auth.Identity(ctx).UserID
+	if userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	n, err := s.userUsecase.LogoutAllSessions(ctx, userID)
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to logout")
+	}
+
+	return &pb.LogoutResponse{
+		Success:         true,
+		Message:         "Logged out of all sessions",
+		SessionsRevoked: int32(n),
+	}, nil
+}
+
+func (s *UserServer) ListSessions(ctx context.Context, _ *pb.Empty) (*pb.SessionsResponse, error) {
+	userID := auth.Identity(ctx).UserID
+	if userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	current := middleware.SessionID(ctx)
+
+	sessions, err := s.userUsecase.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to list sessions")
+	}
+
+	response := &pb.SessionsResponse{}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, &pb.Session{
+			Id:         session.ID,
+			Device:     session.Device,
+			Ip:         session.IP,
+			CreatedAt:  session.CreatedAt.UTC().Format(time.RFC3339),
+			LastSeenAt: session.LastSeenAt.UTC().Format(time.RFC3339),
+			ExpiresAt:  session.ExpiresAt.UTC().Format(time.RFC3339),
+			Current:    session.ID == current,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *UserServer) RequestPasswordReset(ctx context.Context, req *pb.PasswordResetRequest) (*pb.PasswordResponse, error) {
+	if err := s.userUsecase.RequestPasswordReset(ctx, req.GetEmail()); err != nil {
+		return nil, grpcerr.Wrap(err, "failed to request password reset")
+	}
+
+	return &pb.PasswordResponse{
+		Success: true,
+		Message: "If the address belongs to an account, a reset link has been sent to it",
+	}, nil
+}
+
+func (s *UserServer) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.PasswordResponse, error) {
+	n, err := s.userUsecase.ResetPassword(ctx, req.GetToken(), req.GetNewPassword())
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to reset password")
+	}
+
+	return &pb.PasswordResponse{
+		Success:         true,
+		Message:         "Password reset, please log in again",
+		SessionsRevoked: int32(n),
+	}, nil
+}
+
+func (s *UserServer) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.PasswordResponse, error) {
+	userID := auth.Identity(ctx).UserID
+	if userID == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	n, err := s.userUsecase.ChangePassword(ctx, userID, req.GetCurrentPassword(), req.GetNewPassword())
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to change password")
+	}
+
+	return &pb.PasswordResponse{
+		Success:         true,
+		Message:         "Password changed, please log in again",
+		SessionsRevoked: int32(n),
+	}, nil
+}
+
+func (s *UserServer) GetJWKS(_ context.Context, _ *pb.Empty) (*pb.JWKSResponse, error) {
+	set := s.userUsecase.JWKS()
+	response := &pb.JWKSResponse{Keys: make([]*pb.JWK, 0, len(set.Keys))}
+	for _, k := range set.Keys {
+		response.Keys = append(response.Keys, &pb.JWK{
+			Kty: k.Kty,
+			Kid: k.Kid,
+			Alg: k.Alg,
+			Use: k.Use,
+			Crv: k.Crv,
+			X:   k.X,
+			N:   k.N,
+			E:   k.E,
+		})
+	}
+	return response, nil
+}
+
 func (s *UserServer) Authorize(ctx context.Context, req *pb.TokenRequest) (*pb.AuthorizationResponse, error) {
-	success, message, err := s.userUsecase.Authorize(req.Token)
+	success, message, err := s.userUsecase.Authorize(ctx, req.Token)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to authorize: %v", err)
 	}
 
 	// Extract user_id from context (set by AuthInterceptor)
-	userID, ok := ctx.Value("userId").(string)
-	if !ok {
+	userID := auth.Identity(ctx).UserID
+	if userID == "" {
 		return nil, status.Errorf(codes.Internal, "failed to get user_id from context")
 	}
 
@@ -71,69 +223,60 @@ func (s *UserServer) Authorize(ctx context.Context, req *pb.TokenRequest) (*pb.A
 }
 
 func (s *UserServer) GetCurrentUser(ctx context.Context, _ *pb.Empty) (*pb.UserResponse, error) {
-	userID, ok := ctx.Value("userId").(string)
-	if !ok {
+	userID := auth.Identity(ctx).UserID
+	if userID == "" {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
 
-	user, err := s.userUsecase.GetCurrentUser(userID)
+	user, err := s.userUsecase.GetCurrentUser(ctx, userID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		return nil, grpcerr.Wrap(err, "failed to get current user")
 	}
 
-	return &pb.UserResponse{
-		UserId:   user.ID,
-		Email:    user.Email,
-		Username: user.Username,
-		Role:     string(user.Role),
-		Bio:      user.Bio,
-	}, nil
+	return toUserResponse(user), nil
 }
 
 func (s *UserServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.UserResponse, error) {
-	user, err := s.userUsecase.GetUser(req.UserId)
+	user, err := s.userUsecase.GetUser(ctx, req.UserId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		return nil, grpcerr.Wrap(err, "failed to get user")
 	}
 
-	return &pb.UserResponse{
-		UserId:   user.ID,
-		Email:    user.Email,
-		Username: user.Username,
-		Role:     string(user.Role),
-		Bio:      user.Bio,
-	}, nil
+	return toUserResponse(user), nil
+}
+
+func (s *UserServer) GetUsersByIDs(ctx context.Context, req *pb.GetUsersByIDsRequest) (*pb.UsersListResponse, error) {
+	users, err := s.userUsecase.GetUsersByIDs(ctx, req.UserIds)
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to get users")
+	}
+
+	response := &pb.UsersListResponse{}
+	for _, u := range users {
+		response.Users = append(response.Users, toUserResponse(u))
+	}
+
+	return response, nil
 }
 
 func (s *UserServer) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UserResponse, error) {
-	userID, ok := ctx.Value("userId").(string)
-	if !ok {
+	userID := auth.Identity(ctx).UserID
+	if userID == "" {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
 
-	user, err := s.userUsecase.UpdateProfile(userID, req.Username, req.Bio)
+	user, err := s.userUsecase.UpdateProfile(ctx, userID, req.Username, req.Bio)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update profile: %v", err)
+		return nil, grpcerr.Wrap(err, "failed to update profile")
 	}
 
-	return &pb.UserResponse{
-		UserId:   user.ID,
-		Email:    user.Email,
-		Username: user.Username,
-		Role:     string(user.Role),
-		Bio:      user.Bio,
-	}, nil
+	return toUserResponse(user), nil
 }
 
 func (s *UserServer) PromoteToModerator(ctx context.Context, req *pb.RoleChangeRequest) (*pb.RoleChangeResponse, error) {
-	currentUserID, ok := ctx.Value("userId").(string)
-	if !ok {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
-	}
-
-	_, err := s.userUsecase.PromoteToModerator(currentUserID, req.UserId)
+	_, err := s.userUsecase.PromoteToModerator(ctx, req.UserId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to promote to moderator: %v", err)
+		return nil, grpcerr.Wrap(err, "failed to promote to moderator")
 	}
 
 	return &pb.RoleChangeResponse{
@@ -143,14 +286,9 @@ func (s *UserServer) PromoteToModerator(ctx context.Context, req *pb.RoleChangeR
 }
 
 func (s *UserServer) PromoteToAdmin(ctx context.Context, req *pb.RoleChangeRequest) (*pb.RoleChangeResponse, error) {
-	currentUserID, ok := ctx.Value("userId").(string)
-	if !ok {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
-	}
-
-	_, err := s.userUsecase.PromoteToAdmin(currentUserID, req.UserId)
+	_, err := s.userUsecase.PromoteToAdmin(ctx, req.UserId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to promote to admin: %v", err)
+		return nil, grpcerr.Wrap(err, "failed to promote to admin")
 	}
 
 	return &pb.RoleChangeResponse{
@@ -159,15 +297,22 @@ func (s *UserServer) PromoteToAdmin(ctx context.Context, req *pb.RoleChangeReque
 	}, nil
 }
 
-func (s *UserServer) DemoteToUser(ctx context.Context, req *pb.RoleChangeRequest) (*pb.RoleChangeResponse, error) {
-	currentUserID, ok := ctx.Value("userId").(string)
-	if !ok {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+func (s *UserServer) PromoteToExpert(ctx context.Context, req *pb.RoleChangeRequest) (*pb.RoleChangeResponse, error) {
+	_, err := s.userUsecase.PromoteToExpert(ctx, req.UserId)
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to promote to expert")
 	}
 
-	_, err := s.userUsecase.DemoteToUser(currentUserID, req.UserId)
+	return &pb.RoleChangeResponse{
+		Success: true,
+		Message: "User promoted to expert",
+	}, nil
+}
+
+func (s *UserServer) DemoteToUser(ctx context.Context, req *pb.RoleChangeRequest) (*pb.RoleChangeResponse, error) {
+	_, err := s.userUsecase.DemoteToUser(ctx, req.UserId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to demote to user: %v", err)
+		return nil, grpcerr.Wrap(err, "failed to demote to user")
 	}
 
 	return &pb.RoleChangeResponse{
@@ -177,14 +322,9 @@ func (s *UserServer) DemoteToUser(ctx context.Context, req *pb.RoleChangeRequest
 }
 
 func (s *UserServer) DeleteAccount(ctx context.Context, req *pb.UserID) (*pb.DeleteResponse, error) {
-	currentUserID, ok := ctx.Value("userId").(string)
-	if !ok {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
-	}
-
-	err := s.userUsecase.DeleteAccount(currentUserID, req.UserId)
+	err := s.userUsecase.DeleteAccount(ctx, req.UserId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete account: %v", err)
+		return nil, grpcerr.Wrap(err, "failed to delete account")
 	}
 
 	return &pb.DeleteResponse{
@@ -194,38 +334,143 @@ func (s *UserServer) DeleteAccount(ctx context.Context, req *pb.UserID) (*pb.Del
 }
 
 func (s *UserServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.UsersListResponse, error) {
-	users, err := s.userUsecase.ListUsers(req.SearchQuery)
+	page, limit := int(req.GetPage()), int(req.GetLimit())
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	filter := entity.UserFilter{
+		SearchQuery: req.GetSearchQuery(),
+		Role:        req.GetRole(),
+		Status:      req.GetStatus(),
+		Limit:       limit,
+		Offset:      (page - 1) * limit,
+	}
+
+	users, total, err := s.userUsecase.ListUsers(ctx, filter)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+		return nil, grpcerr.Wrap(err, "failed to list users")
 	}
 
-	response := &pb.UsersListResponse{}
+	response := &pb.UsersListResponse{
+		Total:      int32(total),
+		Page:       int32(page),
+		TotalPages: int32((total + limit - 1) / limit),
+	}
 	for _, u := range users {
-		response.Users = append(response.Users, &pb.UserResponse{
-			UserId:   u.ID,
-			Email:    u.Email,
-			Username: u.Username,
-			Role:     string(u.Role),
-			Bio:      u.Bio,
-		})
+		response.Users = append(response.Users, toUserResponse(u))
 	}
 
 	return response, nil
 }
 
-func (s *UserServer) BanUser(ctx context.Context, req *pb.UserID) (*pb.BanUserResponse, error) {
-	currentUserID, ok := ctx.Value("userId").(string)
-	if !ok {
-		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+func (s *UserServer) BanUser(ctx context.Context, req *pb.BanUserRequest) (*pb.BanUserResponse, error) {
+	actorID := auth.Identity(ctx).UserID
+	ban := entity.Ban{
+		Reason:  req.GetReason(),
+		Details: req.GetDetails(),
+		ActorID: actorID,
+	}
+	if req.GetExpiresAt() != "" {
+		until, err := time.Parse(time.RFC3339, req.GetExpiresAt())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "expiresAt must be an RFC 3339 timestamp")
+		}
+		ban.Until = &until
 	}
 
-	err := s.userUsecase.BanUser(currentUserID, req.UserId)
+	err := s.userUsecase.BanUser(ctx, req.GetUserId(), ban)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to ban user: %v", err)
+		return nil, grpcerr.Wrap(err, "failed to ban user")
 	}
 
+	message := "User banned successfully"
+	if ban.Until != nil {
+		message = "User suspended until " + ban.Until.UTC().Format(time.RFC3339)
+	}
 	return &pb.BanUserResponse{
 		Success: true,
-		Message: "User banned successfully",
+		Message: message,
 	}, nil
 }
+
+func (s *UserServer) UnbanUser(ctx context.Context, req *pb.UnbanUserRequest) (*pb.BanUserResponse, error) {
+	actorID := auth.Identity(ctx).UserID
+	err := s.userUsecase.UnbanUser(ctx, req.GetUserId(), req.GetReason(), req.GetDetails(), actorID)
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to unban user")
+	}
+
+	return &pb.BanUserResponse{
+		Success: true,
+		Message: "User unbanned successfully",
+	}, nil
+}
+
+func (s *UserServer) GetUserStats(ctx context.Context, _ *pb.Empty) (*pb.UserStatsResponse, error) {
+	stats, err := s.userUsecase.GetUserStats(ctx)
+	if err != nil {
+		return nil, grpcerr.Wrap(err, "failed to get user stats")
+	}
+
+	byRole := make(map[string]int32, len(stats.ByRole))
+	for role, n := range stats.ByRole {
+		byRole[string(role)] = int32(n)
+	}
+	byStatus := make(map[string]int32, len(stats.ByStatus))
+	for accountStatus, n := range stats.ByStatus {
+		byStatus[string(accountStatus)] = int32(n)
+	}
+	return &pb.UserStatsResponse{
+		Total:    int32(stats.Total),
+		Banned:   int32(stats.Banned),
+		ByRole:   byRole,
+		ByStatus: byStatus,
+	}, nil
+}
+
+func toLoginResponse(u *entity.User, tokens *entity.Tokens) *pb.LoginResponse {
+	return &pb.LoginResponse{
+		UserId:       u.ID,
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresIn:    int32(tokens.ExpiresIn.Seconds()),
+	}
+}
+
+// toUserResponse reports a suspension that has run out as lifted, even before
+// the user's next sign-in resets it.
+func toUserResponse(u *entity.User) *pb.UserResponse {
+	now := time.Now()
+	res := &pb.UserResponse{
+		UserId:    u.ID,
+		Email:     u.Email,
+		Username:  u.Username,
+		Role:      string(u.Role),
+		Bio:       u.Bio,
+		CreatedAt: u.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt: u.UpdatedAt.UTC().Format(time.RFC3339),
+		Banned:    u.Restricted(now),
+		Status:    string(u.Status),
+	}
+	if u.EmailVerifiedAt != nil {
+		res.EmailVerifiedAt = u.EmailVerifiedAt.UTC().Format(time.RFC3339)
+	}
+	if u.SuspensionOver(now) {
+		res.Status = string(u.UnrestrictedStatus())
+		return res
+	}
+	if res.Banned {
+		res.BanReason = u.BanReason
+		res.BanDetails = u.BanDetails
+		if u.BannedUntil != nil {
+			res.BannedUntil = u.BannedUntil.UTC().Format(time.RFC3339)
+		}
+	}
+	return res
+}
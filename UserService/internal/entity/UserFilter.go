@@ -4,7 +4,7 @@ type UserFilter struct {
 	Email       string
 	Username    string
 	Role        string
-	Banned      *bool
+	Status      string
 	Limit       int
 	Offset      int
 	SearchQuery string
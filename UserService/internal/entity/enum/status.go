@@ -0,0 +1,32 @@
+package enum
+
+// AccountStatus is where a user is in the account lifecycle. New accounts
+// start unverified; staff move them to suspended (a ban with an expiry) or
+// banned and back to active.
+type AccountStatus string
+
+const (
+	StatusActive     AccountStatus = "active"
+	StatusUnverified AccountStatus = "unverified"
+	StatusSuspended  AccountStatus = "suspended"
+	StatusBanned     AccountStatus = "banned"
+)
+
+// Reasons a user is banned or suspended.
+const (
+	BanReasonSpam                 = "spam"
+	BanReasonHarassment           = "harassment"
+	BanReasonInappropriateContent = "inappropriate_content"
+	BanReasonFraud                = "fraud"
+	BanReasonImpersonation        = "impersonation"
+	BanReasonOther                = "other"
+)
+
+// Reasons a ban ends. BanLiftedExpired is only set by UserService itself, when a
+// suspension runs out.
+const (
+	BanLiftedAppealAccepted = "appeal_accepted"
+	BanLiftedIssuedInError  = "issued_in_error"
+	BanLiftedOther          = "other"
+	BanLiftedExpired        = "expired"
+)
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// PasswordReset is an emailed password reset token. Like refresh tokens it is
+// only stored as a hash.
+type PasswordReset struct {
+	TokenHash string
+	UserID    string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
@@ -0,0 +1,35 @@
+package entity
+
+import "time"
+
+// Session is one signed-in device. Its refresh token is only stored as a hash;
+// the previous hash is kept to recognise a rotated token being replayed.
+type Session struct {
+	ID                string
+	UserID            string
+	RefreshTokenHash  string
+	PreviousTokenHash string
+	// AccessTokenID is the jti of the last access token issued for the
+	// session, denylisted when the session is revoked.
+	AccessTokenID string
+	Device        string
+	IP            string
+	CreatedAt     time.Time
+	LastSeenAt    time.Time
+	ExpiresAt     time.Time
+	RevokedAt     *time.Time
+}
+
+// ClientInfo describes the client a login or refresh came from.
+type ClientInfo struct {
+	Device string
+	IP     string
+}
+
+// Tokens are handed out by a login or a refresh.
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Duration
+	SessionID    string
+}
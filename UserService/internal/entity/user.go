@@ -2,17 +2,65 @@ package entity
 
 import (
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity/enum"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
 	"time"
 )
 
 type User struct {
-	ID        string
-	Email     string
-	Username  string
-	Password  string
-	Role      enum.Role
-	Bio       string
-	Banned    bool
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID       string
+	Email    string
+	Username string
+	Password string
+	Role     policy.Role
+	Bio      string
+	Status   enum.AccountStatus
+	// BanReason, BanDetails and BannedBy describe the current ban or
+	// suspension; BannedUntil is when a suspension ends.
+	BanReason   string
+	BanDetails  string
+	BannedBy    string
+	BannedUntil *time.Time
+	// EmailVerifiedAt is when the user confirmed their email address; nil
+	// until they do.
+	EmailVerifiedAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Ban is a ban being applied. A nil Until bans permanently.
+type Ban struct {
+	Reason  string
+	Details string
+	ActorID string
+	Until   *time.Time
+}
+
+// Restricted reports whether the user is banned, or suspended at now.
+func (u *User) Restricted(now time.Time) bool {
+	switch u.Status {
+	case enum.StatusBanned:
+		return true
+	case enum.StatusSuspended:
+		return u.BannedUntil == nil || now.Before(*u.BannedUntil)
+	}
+	return false
+}
+
+// EmailVerified reports whether the user has confirmed their email address.
+func (u *User) EmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// UnrestrictedStatus is the status the user has when not banned or suspended.
+func (u *User) UnrestrictedStatus() enum.AccountStatus {
+	if u.EmailVerified() {
+		return enum.StatusActive
+	}
+	return enum.StatusUnverified
+}
+
+// SuspensionOver reports whether the user is suspended and the suspension ran
+// out before now.
+func (u *User) SuspensionOver(now time.Time) bool {
+	return u.Status == enum.StatusSuspended && u.BannedUntil != nil && !now.Before(*u.BannedUntil)
 }
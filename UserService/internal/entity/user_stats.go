@@ -0,0 +1,15 @@
+package entity
+
+import (
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity/enum"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+)
+
+// UserStats summarises the user base for the admin dashboard. Banned counts
+// banned and currently suspended users.
+type UserStats struct {
+	Total    int
+	Banned   int
+	ByRole   map[policy.Role]int
+	ByStatus map[enum.AccountStatus]int
+}
@@ -2,30 +2,42 @@ package middleware
 
 import (
 	"context"
-	"log"
-	"os"
+	"log/slog"
 	"strings"
 
-	"github.com/dgrijalva/jwt-go"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/token"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/auth"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/denylist"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
-func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	// Log the method name for debugging
-	// log.Printf("AuthInterceptor: method=%s", info.FullMethod) // Uncommented only if user wants verbose logs, but let's uncomment it now for debugging
-	log.Printf("AuthInterceptor: method=%s", info.FullMethod)
+// AccountChecker reports whether a user may still use their account.
+type AccountChecker interface {
+	CheckAccount(ctx context.Context, userID string) error
+}
 
-	// Пропускаем аутентификацию для публичных методов (relax check to suffix to avoid package name issues)
-	if strings.HasSuffix(info.FullMethod, "/Register") ||
-		strings.HasSuffix(info.FullMethod, "/Login") ||
-		strings.HasSuffix(info.FullMethod, "/GetUser") ||
-		strings.HasSuffix(info.FullMethod, "/ListUsers") {
-		return handler(ctx, req)
+// AuthInterceptor verifies the caller's access token against keys and makes
+// the caller available to auth.Identity and their session to SessionID.
+// Tokens on the denylist are refused; if the denylist can't be reached they
+// are accepted until they expire. Tokens of deleted, banned and suspended
+// accounts are refused by accounts. Methods authz marks public are served
+// without a token.
+func AuthInterceptor(keys *token.KeyStore, revoked denylist.Denylist, accounts AccountChecker, authz *policy.Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if authz.Public(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		return authenticate(ctx, req, handler, keys, revoked, accounts)
 	}
+}
 
+func authenticate(ctx context.Context, req interface{}, handler grpc.UnaryHandler, keys *token.KeyStore, revoked denylist.Denylist, accounts AccountChecker) (interface{}, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
@@ -33,7 +45,7 @@ func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServe
 
 	authHeader, ok := md["authorization"]
 	if !ok || len(authHeader) == 0 {
-		return nil, status.Errorf(codes.Unauthenticated, "[DEBUG] missing authorization header for method: %s", info.FullMethod)
+		return nil, status.Errorf(codes.Unauthenticated, "missing authorization header")
 	}
 
 	tokenStr := authHeader[0]
@@ -42,33 +54,36 @@ func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServe
 	}
 	tokenStr = strings.TrimPrefix(tokenStr, "Bearer ")
 
-	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "unexpected signing method")
-		}
-		return []byte(os.Getenv("JWT_SECRET")), nil
-	})
-	if err != nil || !token.Valid {
-		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
-	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return nil, status.Errorf(codes.Unauthenticated, "invalid token claims")
+	claims, err := keys.Parse(tokenStr)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid or expired token")
 	}
 
-	userID, ok := claims["user_id"].(string)
-	if !ok {
-		return nil, status.Errorf(codes.Unauthenticated, "missing userId in token")
+	if claims.ID != "" {
+		denied, err := revoked.Contains(ctx, claims.ID)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to check access token denylist", logging.Err(err))
+		}
+		if denied {
+			return nil, status.Errorf(codes.Unauthenticated, "token has been revoked")
+		}
 	}
 
-	role, ok := claims["role"].(string)
-	if !ok {
-		return nil, status.Errorf(codes.Unauthenticated, "missing role in token")
+	if err := accounts.CheckAccount(ctx, claims.UserID); err != nil {
+		return nil, grpcerr.Wrap(err, "account unavailable")
 	}
 
-	ctx = context.WithValue(ctx, "userId", userID)
-	ctx = context.WithValue(ctx, "role", role)
+	ctx = auth.WithIdentity(ctx, policy.Identity{UserID: claims.UserID, Role: policy.Role(claims.Role), Verified: claims.EmailVerified})
+	ctx = context.WithValue(ctx, sessionIDKey{}, claims.SessionID)
 
 	return handler(ctx, req)
 }
+
+type sessionIDKey struct{}
+
+// SessionID returns the session of the caller authenticated by
+// AuthInterceptor, or "" for public methods.
+func SessionID(ctx context.Context) string {
+	id, _ := ctx.Value(sessionIDKey{}).(string)
+	return id
+}
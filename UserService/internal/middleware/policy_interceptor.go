@@ -0,0 +1,13 @@
+package middleware
+
+import (
+	"github.com/KaminurOrynbek/BiznesAsh_lib/auth"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"google.golang.org/grpc"
+)
+
+// PolicyInterceptor enforces authz. It must run after AuthInterceptor, which
+// puts the caller into the context.
+func PolicyInterceptor(authz *policy.Policy) grpc.UnaryServerInterceptor {
+	return policy.UnaryServerInterceptor(authz, auth.Identity)
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_users_status;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS banned BOOLEAN DEFAULT FALSE;
+UPDATE users SET banned = TRUE WHERE status IN ('banned', 'suspended');
+ALTER TABLE users DROP COLUMN IF EXISTS status, DROP COLUMN IF EXISTS ban_reason, DROP COLUMN IF EXISTS ban_details, DROP COLUMN IF EXISTS banned_by, DROP COLUMN IF EXISTS banned_until;
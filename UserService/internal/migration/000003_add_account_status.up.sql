@@ -0,0 +1,19 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'active';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS ban_reason VARCHAR(32) NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS ban_details TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS banned_by VARCHAR(36) NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS banned_until TIMESTAMP WITH TIME ZONE;
+
+-- status replaces the banned flag; carry existing bans over once
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'users' AND column_name = 'banned') THEN
+        UPDATE users SET status = 'banned', ban_reason = 'other' WHERE banned;
+        ALTER TABLE users DROP COLUMN banned;
+    END IF;
+END $$;
+
+-- bio was nullable; the mapping expects text
+UPDATE users SET bio = '' WHERE bio IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_users_status ON users(status);
@@ -0,0 +1,9 @@
+-- Accounts that existed before verification was tracked are taken as
+-- verified unless they were still waiting for it. Done once, with the column.
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'users' AND column_name = 'email_verified_at') THEN
+        ALTER TABLE users ADD COLUMN email_verified_at TIMESTAMP WITH TIME ZONE;
+        UPDATE users SET email_verified_at = created_at WHERE status <> 'unverified';
+    END IF;
+END $$;
@@ -0,0 +1,60 @@
+package migration
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jmoiron/sqlx"
+)
+
+// baselineVersion is the schema databases created before migrations were
+// versioned already have: the users table from 000001.
+const baselineVersion = 1
+
+func RunMigrations(dsn string) {
+	db, err := sqlx.Open("postgres", dsn)
+	if err != nil {
+		logging.Fatal("failed to connect to DB", logging.Err(err))
+	}
+
+	driver, err := postgres.WithInstance(db.DB, &postgres.Config{})
+	if err != nil {
+		logging.Fatal("failed to create migration driver", logging.Err(err))
+	}
+
+	m, err := migrate.NewWithDatabaseInstance(
+		"file://internal/migration",
+		"postgres",
+		driver,
+	)
+	if err != nil {
+		logging.Fatal("migration setup failed", logging.Err(err))
+	}
+
+	// A users table without a recorded version predates versioning; record
+	// 000001 as applied so Up doesn't try to create the table again.
+	if _, _, err := m.Version(); errors.Is(err, migrate.ErrNilVersion) {
+		var exists bool
+		if err := db.Get(&exists, "SELECT to_regclass('public.users') IS NOT NULL"); err != nil {
+			logging.Fatal("failed to inspect schema", logging.Err(err))
+		}
+		if exists {
+			slog.Info("adopting existing schema", "version", baselineVersion)
+			if err := m.Force(baselineVersion); err != nil {
+				logging.Fatal("failed to record baseline migration", logging.Err(err))
+			}
+		}
+	} else if err != nil {
+		logging.Fatal("failed to read migration version", logging.Err(err))
+	}
+
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		logging.Fatal("migration failed", logging.Err(err))
+	}
+
+	slog.Info("database migrated")
+}
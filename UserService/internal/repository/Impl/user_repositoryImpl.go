@@ -23,7 +23,7 @@ func convertToRepoInterfacesFilter(filter entity.UserFilter) RepoInterfaces.User
 		Email:    filter.Email,
 		Username: filter.Username,
 		Role:     filter.Role,
-		Banned:   filter.Banned,
+		Status:   filter.Status,
 		Limit:    filter.Limit,
 		Offset:   filter.Offset,
 	}
@@ -37,6 +37,10 @@ func (r *userRepositoryImpl) GetUserByID(ctx context.Context, id string) (*entit
 	return r.userDAO.GetUserByID(ctx, id)
 }
 
+func (r *userRepositoryImpl) GetUsersByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
+	return r.userDAO.GetUsersByIDs(ctx, ids)
+}
+
 func (r *userRepositoryImpl) GetUserByEmail(ctx context.Context, email string) (*entity.User, error) {
 	return r.userDAO.GetUserByEmail(ctx, email)
 }
@@ -49,10 +53,14 @@ func (r *userRepositoryImpl) DeleteUser(ctx context.Context, id string) error {
 	return r.userDAO.DeleteUser(ctx, id)
 }
 
-func (r *userRepositoryImpl) ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error) {
+func (r *userRepositoryImpl) ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, int, error) {
 	return r.userDAO.ListUsers(ctx, filter)
 }
 
 func (r *userRepositoryImpl) BanUser(ctx context.Context, id string) error {
 	return r.userDAO.BanUser(ctx, id)
 }
+
+func (r *userRepositoryImpl) Stats(ctx context.Context) (*entity.UserStats, error) {
+	return r.userDAO.Stats(ctx)
+}
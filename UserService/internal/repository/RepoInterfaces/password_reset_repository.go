@@ -0,0 +1,19 @@
+package RepoInterfaces
+
+import (
+	"context"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+)
+
+// PasswordResetRepository stores the outstanding password reset tokens. A user
+// has at most one; expired ones are cleared as new ones are created.
+type PasswordResetRepository interface {
+	// CreatePasswordReset stores reset, replacing the user's previous token.
+	CreatePasswordReset(ctx context.Context, reset *entity.PasswordReset) error
+	// ConsumePasswordReset deletes the token that hashes to hash and returns
+	// it, so each token is used at most once. Expiry is the caller's to check.
+	ConsumePasswordReset(ctx context.Context, hash string) (*entity.PasswordReset, error)
+	// DeleteUserPasswordResets deletes any token the user still has.
+	DeleteUserPasswordResets(ctx context.Context, userID string) error
+}
@@ -0,0 +1,25 @@
+package RepoInterfaces
+
+import (
+	"context"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+)
+
+// SessionRepository stores the sessions behind refresh tokens.
+type SessionRepository interface {
+	CreateSession(ctx context.Context, session *entity.Session) error
+	// GetSessionByTokenHash finds the session whose current or previous refresh
+	// token hashes to hash.
+	GetSessionByTokenHash(ctx context.Context, hash string) (*entity.Session, error)
+	// RotateSession stores session's new token, provided its refresh token is
+	// still presentedHash and it is not revoked. It reports whether it did.
+	RotateSession(ctx context.Context, session *entity.Session, presentedHash string) (bool, error)
+	// ListSessions returns the user's sessions that are neither revoked nor
+	// expired, most recently used first.
+	ListSessions(ctx context.Context, userID string) ([]*entity.Session, error)
+	// RevokeSession revokes one of the user's active sessions and returns it.
+	RevokeSession(ctx context.Context, userID, sessionID string) (*entity.Session, error)
+	// RevokeUserSessions revokes all of the user's active sessions and returns them.
+	RevokeUserSessions(ctx context.Context, userID string) ([]*entity.Session, error)
+}
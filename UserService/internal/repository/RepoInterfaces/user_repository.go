@@ -10,7 +10,7 @@ type UserFilter struct {
 	Email    string
 	Username string
 	Role     string
-	Banned   *bool
+	Status   string
 	Limit    int
 	Offset   int
 }
@@ -19,9 +19,11 @@ type UserFilter struct {
 type UserRepository interface {
 	CreateUser(ctx context.Context, user *entity.User) (*entity.User, error)
 	GetUserByID(ctx context.Context, id string) (*entity.User, error)
+	GetUsersByIDs(ctx context.Context, ids []string) ([]*entity.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*entity.User, error)
 	UpdateUser(ctx context.Context, user *entity.User) error
 	DeleteUser(ctx context.Context, id string) error
-	ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, error)
+	ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, int, error)
 	BanUser(ctx context.Context, id string) error
+	Stats(ctx context.Context) (*entity.UserStats, error)
 }
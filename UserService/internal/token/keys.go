@@ -0,0 +1,247 @@
+package token
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/jwks"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSABits is the smallest RSA key accepted for signing.
+const minRSABits = 2048
+
+// KeyStore holds the keys access tokens are signed and verified with. It
+// signs with one key and verifies with all of them, so keys can be rotated
+// with overlapping validity: a new key is added next to the old one and the
+// old one stays until the tokens it signed have expired.
+//
+// Keys are read from a directory and reread by Reload. A key whose file is
+// removed is kept for verification for the retention period, normally the
+// access token lifetime, so removing a key never cuts tokens short. A key
+// whose file is added is published in the JWKS for the lead time before tokens
+// are signed with it, so verifiers whose cached key set predates it can fetch
+// it in time.
+type KeyStore struct {
+	dir       string
+	signingID string
+	retention time.Duration
+	lead      time.Duration
+
+	mu      sync.RWMutex
+	keys    map[string]*signingKey
+	signing *signingKey
+}
+
+type signingKey struct {
+	id      string
+	method  jwt.SigningMethod
+	private crypto.Signer
+	// publishedAt is when Reload first found the key's file; zero for keys
+	// found by the first load, which may already sign.
+	publishedAt time.Time
+	// retiredAt is when the key's file disappeared; zero while it exists.
+	retiredAt time.Time
+}
+
+// LoadKeyStore reads the keys in dir, one <kid>.pem file each. Tokens are
+// signed with signingID, or the key whose id sorts last when it is empty.
+func LoadKeyStore(dir, signingID string, retention time.Duration) (*KeyStore, error) {
+	s := &KeyStore{dir: dir, signingID: signingID, retention: retention, lead: jwks.MinRefreshInterval, keys: map[string]*signingKey{}}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// NewEphemeralKeyStore generates one Ed25519 key that lives as long as the
+// process. Tokens stop verifying on restart and other replicas don't know the
+// key, so it is only for local runs.
+func NewEphemeralKeyStore() (*KeyStore, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("failed to generate key id: %w", err)
+	}
+	key := &signingKey{id: "ephemeral-" + hex.EncodeToString(b), method: jwt.SigningMethodEdDSA, private: private}
+	return &KeyStore{keys: map[string]*signingKey{key.id: key}, signing: key}, nil
+}
+
+// Reload rereads the key directory. On error the keys already loaded stay in
+// use.
+func (s *KeyStore) Reload() error {
+	if s.dir == "" {
+		return nil
+	}
+	paths, err := filepath.Glob(filepath.Join(s.dir, "*.pem"))
+	if err != nil {
+		return err
+	}
+	loaded := make(map[string]*signingKey, len(paths))
+	for _, path := range paths {
+		key, err := readKey(path)
+		if err != nil {
+			return err
+		}
+		loaded[key.id] = key
+	}
+	if len(loaded) == 0 {
+		return fmt.Errorf("no signing keys in %s", s.dir)
+	}
+
+	signingID := s.signingID
+	if signingID == "" {
+		ids := make([]string, 0, len(loaded))
+		for id := range loaded {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		signingID = ids[len(ids)-1]
+	}
+	signing, ok := loaded[signingID]
+	if !ok {
+		return fmt.Errorf("signing key %q not found in %s", signingID, s.dir)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for id, key := range loaded {
+		switch old, ok := s.keys[id]; {
+		case ok:
+			key.publishedAt = old.publishedAt
+		case s.signing != nil:
+			key.publishedAt = now
+		}
+	}
+	if s.signing != nil && signing.id != s.signing.id && now.Sub(signing.publishedAt) < s.lead {
+		if current, ok := loaded[s.signing.id]; ok {
+			slog.Info("new signing key published, signing with it after the lead time", "kid", signing.id, "from", signing.publishedAt.Add(s.lead))
+			signing = current
+		}
+	}
+	for id, old := range s.keys {
+		if _, ok := loaded[id]; ok {
+			continue
+		}
+		if old.retiredAt.IsZero() {
+			old.retiredAt = now
+			slog.Info("signing key removed, still verifying its tokens", "kid", id, "until", now.Add(s.retention))
+		}
+		if now.Sub(old.retiredAt) < s.retention {
+			loaded[id] = old
+		}
+	}
+	if s.signing == nil || s.signing.id != signing.id {
+		slog.Info("signing access tokens with key", "kid", signing.id, "alg", signing.method.Alg())
+	}
+	s.keys, s.signing = loaded, signing
+	return nil
+}
+
+// Watch reloads the keys every interval until ctx is done.
+func (s *KeyStore) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Reload(); err != nil {
+				slog.ErrorContext(ctx, "failed to reload signing keys", logging.Err(err))
+			}
+		}
+	}
+}
+
+// JWKS returns the public halves of every key tokens may be signed with.
+func (s *KeyStore) JWKS() jwks.Set {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	set := jwks.Set{Keys: make([]jwks.Key, 0, len(s.keys))}
+	for _, k := range s.keys {
+		key, err := jwks.NewKey(k.id, k.private.Public())
+		if err != nil {
+			continue
+		}
+		set.Keys = append(set.Keys, key)
+	}
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].Kid < set.Keys[j].Kid })
+	return set
+}
+
+func (s *KeyStore) signingKey() *signingKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.signing
+}
+
+// keyfunc resolves the kid of a token being parsed.
+func (s *KeyStore) keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	s.mu.RLock()
+	key, ok := s.keys[kid]
+	s.mu.RUnlock()
+	if !ok || token.Method.Alg() != key.method.Alg() {
+		return nil, ErrInvalid
+	}
+	return key.private.Public(), nil
+}
+
+// readKey parses a PKCS #8 (or, for RSA, PKCS #1) private key file.
+func readKey(path string) (*signingKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM block", path)
+	}
+
+	var parsed any
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		err = fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	id := strings.TrimSuffix(filepath.Base(path), ".pem")
+	switch private := parsed.(type) {
+	case *rsa.PrivateKey:
+		if private.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("%s: RSA key must have at least %d bits", path, minRSABits)
+		}
+		return &signingKey{id: id, method: jwt.SigningMethodRS256, private: private}, nil
+	case ed25519.PrivateKey:
+		return &signingKey{id: id, method: jwt.SigningMethodEdDSA, private: private}, nil
+	}
+	return nil, errors.New(path + ": only RSA and Ed25519 keys are supported")
+}
@@ -0,0 +1,212 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const retention = time.Hour
+
+func writeKey(t *testing.T, dir, kid string) {
+	t.Helper()
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func jwksKids(s *KeyStore) []string {
+	var kids []string
+	for _, k := range s.JWKS().Keys {
+		kids = append(kids, k.Kid)
+	}
+	return kids
+}
+
+// TestKeyStoreRotation walks a key through a rotation: a new key is added next
+// to the old one and signs once its lead time is over, the old one's file is
+// removed, and its retention runs out.
+// One token is issued at every step and each step checks which of the tokens
+// issued so far still verify.
+func TestKeyStoreRotation(t *testing.T) {
+	dir := t.TempDir()
+	writeKey(t, dir, "2026-01")
+	s, err := LoadKeyStore(dir, "", retention)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steps := []struct {
+		name        string
+		change      func()
+		wantSigning string
+		wantJWKS    []string
+		wantValid   []bool // one per token issued up to and including this step
+	}{
+		{
+			name:        "single key",
+			change:      func() {},
+			wantSigning: "2026-01",
+			wantJWKS:    []string{"2026-01"},
+			wantValid:   []bool{true},
+		},
+		{
+			name:        "new key is published before it signs",
+			change:      func() { writeKey(t, dir, "2026-02") },
+			wantSigning: "2026-01",
+			wantJWKS:    []string{"2026-01", "2026-02"},
+			wantValid:   []bool{true, true},
+		},
+		{
+			name:        "new key still waits on the next reload",
+			change:      func() {},
+			wantSigning: "2026-01",
+			wantJWKS:    []string{"2026-01", "2026-02"},
+			wantValid:   []bool{true, true, true},
+		},
+		{
+			name: "new key signs after its lead time, old key still verifies",
+			change: func() {
+				s.keys["2026-02"].publishedAt = time.Now().Add(-s.lead)
+			},
+			wantSigning: "2026-02",
+			wantJWKS:    []string{"2026-01", "2026-02"},
+			wantValid:   []bool{true, true, true, true},
+		},
+		{
+			name:        "removed key verifies until its retention ends",
+			change:      func() { os.Remove(filepath.Join(dir, "2026-01.pem")) },
+			wantSigning: "2026-02",
+			wantJWKS:    []string{"2026-01", "2026-02"},
+			wantValid:   []bool{true, true, true, true, true},
+		},
+		{
+			name: "removed key is dropped after its retention",
+			change: func() {
+				s.keys["2026-01"].retiredAt = time.Now().Add(-retention)
+			},
+			wantSigning: "2026-02",
+			wantJWKS:    []string{"2026-02"},
+			wantValid:   []bool{false, false, false, true, true, true},
+		},
+	}
+
+	var tokens []string
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			step.change()
+			if err := s.Reload(); err != nil {
+				t.Fatal(err)
+			}
+
+			signed, _, err := s.Issue("u1", "user", true, "s1", time.Minute)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tokens = append(tokens, signed)
+
+			if got := s.signingKey().id; got != step.wantSigning {
+				t.Errorf("signing key = %q, want %q", got, step.wantSigning)
+			}
+			if got := jwksKids(s); !reflect.DeepEqual(got, step.wantJWKS) {
+				t.Errorf("JWKS kids = %v, want %v", got, step.wantJWKS)
+			}
+			for i, tok := range tokens {
+				_, err := s.Parse(tok)
+				if valid := err == nil; valid != step.wantValid[i] {
+					t.Errorf("token %d: valid = %t, want %t (err %v)", i, valid, step.wantValid[i], err)
+				}
+				if err != nil && !errors.Is(err, ErrInvalid) {
+					t.Errorf("token %d: err = %v, want ErrInvalid", i, err)
+				}
+			}
+		})
+	}
+}
+
+func TestKeyStoreReloadKeepsKeysOnError(t *testing.T) {
+	dir := t.TempDir()
+	writeKey(t, dir, "2026-01")
+	s, err := LoadKeyStore(dir, "", retention)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, _, err := s.Issue("u1", "user", true, "s1", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "2026-02.pem"), []byte("not a key"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Reload(); err == nil {
+		t.Fatal("Reload accepted an unreadable key")
+	}
+	if _, err := s.Parse(signed); err != nil {
+		t.Errorf("token signed before the failed reload: %v", err)
+	}
+	if got := s.signingKey().id; got != "2026-01" {
+		t.Errorf("signing key = %q after a failed reload, want 2026-01", got)
+	}
+}
+
+func TestKeyStorePinnedKeyWaitsForLeadTime(t *testing.T) {
+	dir := t.TempDir()
+	writeKey(t, dir, "2026-01")
+	s, err := LoadKeyStore(dir, "2026-01", retention)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Pinning a key that was just added keeps the current one until the new
+	// one has been published for the lead time.
+	writeKey(t, dir, "2026-02")
+	s.signingID = "2026-02"
+	if err := s.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.signingKey().id; got != "2026-01" {
+		t.Errorf("signing key = %q right after the pinned key was added, want 2026-01", got)
+	}
+
+	s.keys["2026-02"].publishedAt = time.Now().Add(-s.lead)
+	if err := s.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.signingKey().id; got != "2026-02" {
+		t.Errorf("signing key = %q after the lead time, want the pinned 2026-02", got)
+	}
+}
+
+func TestLoadKeyStorePinnedSigningKey(t *testing.T) {
+	dir := t.TempDir()
+	writeKey(t, dir, "2026-01")
+	writeKey(t, dir, "2026-02")
+
+	s, err := LoadKeyStore(dir, "2026-01", retention)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.signingKey().id; got != "2026-01" {
+		t.Errorf("signing key = %q, want the pinned 2026-01", got)
+	}
+	if _, err := LoadKeyStore(dir, "2025-12", retention); err == nil {
+		t.Error("LoadKeyStore accepted a signing key id with no file")
+	}
+}
@@ -0,0 +1,91 @@
+// Package token issues and verifies the access tokens UserService hands out.
+// Access tokens are JWTs signed with RS256 or EdDSA by a key from the
+// KeyStore, named in their kid header; the public keys are published as a
+// JWKS so other services verify tokens without the private keys. Besides the
+// caller's id and role they carry the id of the session they were issued for
+// (sid), their own id (jti), under which they are revoked, and whether the
+// user's email address is verified (email_verified) as of issue.
+package token
+
+import (
+	"errors"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/jwks"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+var ErrInvalid = errors.New("invalid token")
+
+// Claims are the verified contents of an access token.
+type Claims struct {
+	ID            string
+	UserID        string
+	Role          string
+	EmailVerified bool
+	SessionID     string
+	ExpiresAt     time.Time
+}
+
+// Issue signs an access token for the user's session, valid for ttl.
+func (s *KeyStore) Issue(userID, role string, emailVerified bool, sessionID string, ttl time.Duration) (string, Claims, error) {
+	now := time.Now()
+	claims := Claims{
+		ID:            uuid.New().String(),
+		UserID:        userID,
+		Role:          role,
+		EmailVerified: emailVerified,
+		SessionID:     sessionID,
+		ExpiresAt:     now.Add(ttl),
+	}
+
+	key := s.signingKey()
+	token := jwt.NewWithClaims(key.method, jwt.MapClaims{
+		"jti":            claims.ID,
+		"user_id":        userID,
+		"role":           role,
+		"email_verified": emailVerified,
+		"sid":            sessionID,
+		"iat":            now.Unix(),
+		"exp":            claims.ExpiresAt.Unix(),
+	})
+	token.Header["kid"] = key.id
+	signed, err := token.SignedString(key.private)
+	if err != nil {
+		return "", Claims{}, err
+	}
+	return signed, claims, nil
+}
+
+// Parse verifies tokenStr and returns its claims.
+func (s *KeyStore) Parse(tokenStr string) (Claims, error) {
+	token, err := jwt.Parse(tokenStr, s.keyfunc,
+		jwt.WithValidMethods(jwks.Algorithms),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || !token.Valid {
+		return Claims{}, ErrInvalid
+	}
+
+	mc, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return Claims{}, ErrInvalid
+	}
+	var claims Claims
+	if claims.UserID, ok = mc["user_id"].(string); !ok || claims.UserID == "" {
+		return Claims{}, ErrInvalid
+	}
+	if claims.Role, ok = mc["role"].(string); !ok {
+		return Claims{}, ErrInvalid
+	}
+	claims.ID, _ = mc["jti"].(string)
+	claims.SessionID, _ = mc["sid"].(string)
+	claims.EmailVerified, _ = mc["email_verified"].(bool)
+	exp, err := mc.GetExpirationTime()
+	if err != nil || exp == nil {
+		return Claims{}, ErrInvalid
+	}
+	claims.ExpiresAt = exp.Time
+	return claims, nil
+}
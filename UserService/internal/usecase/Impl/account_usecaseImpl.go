@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/nats/payloads"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity/enum"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+
+	"github.com/pkg/errors"
+)
+
+// CheckAccount returns an error unless the user may use their account: it
+// must still exist and be neither banned nor suspended. A suspension that has
+// run out is lifted here, the first time the user shows up after it.
+func (u *userUsecaseImpl) CheckAccount(ctx context.Context, userID string) error {
+	user, err := u.userRepo.GetUserByID(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrap(grpcerr.ErrUnauthenticated, "account no longer exists")
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	return u.checkAccount(ctx, user)
+}
+
+func (u *userUsecaseImpl) checkAccount(ctx context.Context, user *entity.User) error {
+	now := time.Now()
+	if user.SuspensionOver(now) {
+		return u.liftSuspension(ctx, user)
+	}
+	if !user.Restricted(now) {
+		return nil
+	}
+	if user.Status == enum.StatusSuspended {
+		return errors.Wrapf(grpcerr.ErrPermissionDenied, "account is suspended until %s", user.BannedUntil.UTC().Format(time.RFC3339))
+	}
+	return errors.Wrap(grpcerr.ErrPermissionDenied, "account is banned")
+}
+
+// liftSuspension reactivates a user whose suspension has run out.
+func (u *userUsecaseImpl) liftSuspension(ctx context.Context, user *entity.User) error {
+	clearBan(user)
+	if err := u.userRepo.UpdateUser(ctx, user); err != nil {
+		return errors.Wrap(err, "failed to lift suspension")
+	}
+	u.publishUnbanned(ctx, user, enum.BanLiftedExpired, "", "")
+	return nil
+}
+
+// MarkEmailVerified records that the user confirmed email, as reported by
+// NotificationService, and activates an account that was waiting for it. It
+// is idempotent. Codes sent before verification was tied to accounts carry
+// the address as the user id, so an unknown id is looked up by email.
+func (u *userUsecaseImpl) MarkEmailVerified(ctx context.Context, userID, email string, at time.Time) error {
+	user, err := u.userRepo.GetUserByID(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		user, err = u.userRepo.GetUserByEmail(ctx, email)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.WarnContext(ctx, "email verified for unknown user", "user_id", userID)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if !strings.EqualFold(user.Email, email) {
+		slog.WarnContext(ctx, "email verified for an address the user no longer has", "user_id", user.ID)
+		return nil
+	}
+	if user.EmailVerified() {
+		return nil
+	}
+
+	verifiedAt := at.UTC()
+	user.EmailVerifiedAt = &verifiedAt
+	if user.Status == enum.StatusUnverified {
+		user.Status = enum.StatusActive
+	}
+	user.UpdatedAt = time.Now()
+	if err := u.userRepo.UpdateUser(ctx, user); err != nil {
+		return errors.Wrap(err, "failed to mark email verified")
+	}
+	slog.InfoContext(ctx, "email verified", "user_id", user.ID)
+	return nil
+}
+
+// BanUser bans the user, or suspends them when ban.Until is set, and ends
+// their sessions. Banning a user who is already banned replaces the ban. Who
+// may call it is decided by the policy interceptor; the actor must also
+// outrank the user.
+func (u *userUsecaseImpl) BanUser(ctx context.Context, targetUserId string, ban entity.Ban) error {
+	now := time.Now()
+	if ban.Until != nil && !ban.Until.After(now) {
+		return errors.Wrap(grpcerr.ErrInvalidArgument, "suspension must end in the future")
+	}
+	if ban.Reason == "" {
+		ban.Reason = enum.BanReasonOther
+	}
+
+	targetUser, err := u.userRepo.GetUserByID(ctx, targetUserId)
+	if err != nil {
+		return errors.Wrap(err, "failed to get target user")
+	}
+	if err := u.checkOutranks(ctx, ban.ActorID, targetUser); err != nil {
+		return err
+	}
+
+	targetUser.Status = enum.StatusBanned
+	if ban.Until != nil {
+		targetUser.Status = enum.StatusSuspended
+	}
+	targetUser.BanReason = ban.Reason
+	targetUser.BanDetails = ban.Details
+	targetUser.BannedBy = ban.ActorID
+	targetUser.BannedUntil = ban.Until
+	targetUser.UpdatedAt = now
+	err = u.userRepo.UpdateUser(ctx, targetUser)
+	if err != nil {
+		return errors.Wrap(err, "failed to ban user")
+	}
+
+	if _, err := u.LogoutAllSessions(ctx, targetUser.ID); err != nil {
+		return errors.Wrap(err, "failed to end sessions")
+	}
+
+	payload := payloads.UserBanEventPayload{
+		UserID:  targetUser.ID,
+		Email:   targetUser.Email,
+		Reason:  payloads.BanReason{Code: ban.Reason, Details: ban.Details},
+		ActorID: ban.ActorID,
+	}
+	if ban.Until != nil {
+		payload.ExpiresAt = ban.Until.UTC().Format(time.RFC3339)
+	}
+	if err := u.publisher.PublishUserBanned(ctx, payload); err != nil {
+		slog.ErrorContext(ctx, "failed to publish event", "subject", "user.banned", logging.Err(err))
+	}
+
+	return nil
+}
+
+// UnbanUser lifts the user's ban or suspension. The account becomes active.
+// Like BanUser, the actor must outrank the user.
+func (u *userUsecaseImpl) UnbanUser(ctx context.Context, targetUserId, reason, details, actorID string) error {
+	if reason == "" {
+		reason = enum.BanLiftedOther
+	}
+
+	targetUser, err := u.userRepo.GetUserByID(ctx, targetUserId)
+	if err != nil {
+		return errors.Wrap(err, "failed to get target user")
+	}
+	if err := u.checkOutranks(ctx, actorID, targetUser); err != nil {
+		return err
+	}
+	if !targetUser.Restricted(time.Now()) {
+		return errors.Wrap(grpcerr.ErrFailedPrecondition, "user is not banned")
+	}
+
+	clearBan(targetUser)
+	err = u.userRepo.UpdateUser(ctx, targetUser)
+	if err != nil {
+		return errors.Wrap(err, "failed to unban user")
+	}
+
+	u.publishUnbanned(ctx, targetUser, reason, details, actorID)
+	return nil
+}
+
+// checkOutranks returns PermissionDenied unless the actor's role ranks above
+// the target's, so moderators can't ban each other or admins. The actor's role
+// is read from the database rather than the access token, which may predate a
+// demotion.
+func (u *userUsecaseImpl) checkOutranks(ctx context.Context, actorID string, target *entity.User) error {
+	actor, err := u.userRepo.GetUserByID(ctx, actorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrap(grpcerr.ErrPermissionDenied, "unknown actor")
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to get actor")
+	}
+	if actor.Role.Rank() <= target.Role.Rank() {
+		return errors.Wrap(grpcerr.ErrPermissionDenied, "target's role is equal to or higher than yours")
+	}
+	return nil
+}
+
+func (u *userUsecaseImpl) publishUnbanned(ctx context.Context, user *entity.User, reason, details, actorID string) {
+	err := u.publisher.PublishUserUnbanned(ctx, payloads.UserBanEventPayload{
+		UserID:  user.ID,
+		Email:   user.Email,
+		Reason:  payloads.BanReason{Code: reason, Details: details},
+		ActorID: actorID,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to publish event", "subject", "user.unbanned", logging.Err(err))
+	}
+}
+
+func clearBan(user *entity.User) {
+	user.Status = user.UnrestrictedStatus()
+	user.BanReason = ""
+	user.BanDetails = ""
+	user.BannedBy = ""
+	user.BannedUntil = nil
+	user.UpdatedAt = time.Now()
+}
@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/nats/payloads"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/nats/publisher"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity/enum"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"github.com/pkg/errors"
+)
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestBanUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		until      *time.Time
+		wantErr    error
+		wantStatus enum.AccountStatus
+	}{
+		{name: "ban", wantStatus: enum.StatusBanned},
+		{name: "suspension", until: timePtr(time.Now().Add(time.Hour)), wantStatus: enum.StatusSuspended},
+		{name: "suspension ending in the past", until: timePtr(time.Now().Add(-time.Hour)), wantErr: grpcerr.ErrInvalidArgument, wantStatus: enum.StatusActive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			f.addUser(t, "mod", policy.RoleModerator)
+			f.addUser(t, "u1", policy.RoleUser)
+			tokens := f.login(t, "u1")
+
+			err := f.usecase.BanUser(context.Background(), "u1", entity.Ban{Reason: enum.BanReasonSpam, ActorID: "mod", Until: tt.until})
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil) != (err == nil) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if got := f.users.users["u1"].Status; got != tt.wantStatus {
+				t.Errorf("status = %q, want %q", got, tt.wantStatus)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if f.sessions.active("u1") != 0 || !f.accessDenied(t, tokens.AccessToken) {
+				t.Error("the banned user's sessions were not ended")
+			}
+			if err := f.usecase.CheckAccount(context.Background(), "u1"); !errors.Is(err, grpcerr.ErrPermissionDenied) {
+				t.Errorf("CheckAccount after the ban: err = %v, want PermissionDenied", err)
+			}
+			if f.queue.last(publisher.UserBannedSubject) == nil {
+				t.Error("no user.banned event was published")
+			}
+		})
+	}
+}
+
+func TestCheckAccountLiftsExpiredSuspension(t *testing.T) {
+	f := newFixture(t)
+	user := f.addUser(t, "u1", policy.RoleUser)
+	user.Status = enum.StatusSuspended
+	user.BanReason = enum.BanReasonSpam
+	user.BannedUntil = timePtr(time.Now().Add(-time.Minute))
+	f.users.users["u1"] = *user
+
+	if err := f.usecase.CheckAccount(context.Background(), "u1"); err != nil {
+		t.Fatalf("CheckAccount after the suspension ended: %v", err)
+	}
+	got := f.users.users["u1"]
+	if got.Status != enum.StatusActive || got.BanReason != "" || got.BannedUntil != nil {
+		t.Errorf("user after the suspension = %+v, want active with the ban cleared", got)
+	}
+
+	var payload payloads.UserBanEventPayload
+	if err := json.Unmarshal(f.queue.last(publisher.UserUnbannedSubject), &payload); err != nil {
+		t.Fatalf("no user.unbanned event: %v", err)
+	}
+	if payload.Reason.Code != enum.BanLiftedExpired {
+		t.Errorf("unban reason = %q, want %q", payload.Reason.Code, enum.BanLiftedExpired)
+	}
+}
+
+func TestUnbanUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     enum.AccountStatus
+		until      *time.Time
+		verified   bool
+		wantErr    error
+		wantStatus enum.AccountStatus
+	}{
+		{name: "banned user", status: enum.StatusBanned, verified: true, wantStatus: enum.StatusActive},
+		{name: "suspended user", status: enum.StatusSuspended, until: timePtr(time.Now().Add(time.Hour)), verified: true, wantStatus: enum.StatusActive},
+		{name: "banned before verifying", status: enum.StatusBanned, wantStatus: enum.StatusUnverified},
+		{name: "active user", status: enum.StatusActive, verified: true, wantErr: grpcerr.ErrFailedPrecondition, wantStatus: enum.StatusActive},
+		{name: "unverified user", status: enum.StatusUnverified, wantErr: grpcerr.ErrFailedPrecondition, wantStatus: enum.StatusUnverified},
+		{name: "suspension already over", status: enum.StatusSuspended, until: timePtr(time.Now().Add(-time.Hour)), verified: true, wantErr: grpcerr.ErrFailedPrecondition, wantStatus: enum.StatusSuspended},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			f.addUser(t, "mod", policy.RoleModerator)
+			user := f.addUser(t, "u1", policy.RoleUser)
+			user.Status = tt.status
+			user.BannedUntil = tt.until
+			if !tt.verified {
+				user.EmailVerifiedAt = nil
+			}
+			f.users.users["u1"] = *user
+
+			err := f.usecase.UnbanUser(context.Background(), "u1", enum.BanLiftedAppealAccepted, "", "mod")
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil) != (err == nil) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if got := f.users.users["u1"].Status; got != tt.wantStatus {
+				t.Errorf("status = %q, want %q", got, tt.wantStatus)
+			}
+			published := f.queue.last(publisher.UserUnbannedSubject) != nil
+			if published != (tt.wantErr == nil) {
+				t.Errorf("user.unbanned published = %t, want %t", published, tt.wantErr == nil)
+			}
+		})
+	}
+}
+
+func TestBanRequiresHigherRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		actor   policy.Role
+		target  policy.Role
+		wantErr error
+	}{
+		{name: "moderator bans a user", actor: policy.RoleModerator, target: policy.RoleUser},
+		{name: "moderator bans an expert", actor: policy.RoleModerator, target: policy.RoleExpert},
+		{name: "admin bans a moderator", actor: policy.RoleAdmin, target: policy.RoleModerator},
+		{name: "moderator bans a moderator", actor: policy.RoleModerator, target: policy.RoleModerator, wantErr: grpcerr.ErrPermissionDenied},
+		{name: "moderator bans an admin", actor: policy.RoleModerator, target: policy.RoleAdmin, wantErr: grpcerr.ErrPermissionDenied},
+		{name: "admin bans an admin", actor: policy.RoleAdmin, target: policy.RoleAdmin, wantErr: grpcerr.ErrPermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			f.addUser(t, "actor", tt.actor)
+			f.addUser(t, "target", tt.target)
+			ctx := context.Background()
+
+			err := f.usecase.BanUser(ctx, "target", entity.Ban{Reason: enum.BanReasonSpam, ActorID: "actor"})
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil) != (err == nil) {
+				t.Fatalf("BanUser: err = %v, want %v", err, tt.wantErr)
+			}
+			wantStatus := enum.StatusBanned
+			if tt.wantErr != nil {
+				wantStatus = enum.StatusActive
+			}
+			if got := f.users.users["target"].Status; got != wantStatus {
+				t.Errorf("status after BanUser = %q, want %q", got, wantStatus)
+			}
+
+			// Unbanning takes the same rank, whoever banned the user.
+			target := f.users.users["target"]
+			target.Status = enum.StatusBanned
+			f.users.users["target"] = target
+			err = f.usecase.UnbanUser(ctx, "target", enum.BanLiftedAppealAccepted, "", "actor")
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil) != (err == nil) {
+				t.Fatalf("UnbanUser: err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
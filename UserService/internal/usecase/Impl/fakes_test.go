@@ -0,0 +1,331 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/nats/publisher"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity/enum"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/token"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/config"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/denylist"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
+)
+
+// The in-memory repositories below behave like the Postgres DAOs for the
+// queries the usecase relies on, in particular the conditional updates.
+
+type memoryUsers struct {
+	mu    sync.Mutex
+	users map[string]entity.User
+}
+
+func (r *memoryUsers) CreateUser(_ context.Context, user *entity.User) (*entity.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.users[user.ID] = *user
+	return user, nil
+}
+
+func (r *memoryUsers) GetUserByID(_ context.Context, id string) (*entity.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	user, ok := r.users[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &user, nil
+}
+
+func (r *memoryUsers) GetUsersByIDs(ctx context.Context, ids []string) ([]*entity.User, error) {
+	var users []*entity.User
+	for _, id := range ids {
+		if user, err := r.GetUserByID(ctx, id); err == nil {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+func (r *memoryUsers) GetUserByEmail(_ context.Context, email string) (*entity.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, user := range r.users {
+		if user.Email == email {
+			return &user, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r *memoryUsers) UpdateUser(_ context.Context, user *entity.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.users[user.ID]; !ok {
+		return sql.ErrNoRows
+	}
+	r.users[user.ID] = *user
+	return nil
+}
+
+func (r *memoryUsers) DeleteUser(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.users, id)
+	return nil
+}
+
+func (r *memoryUsers) ListUsers(context.Context, entity.UserFilter) ([]*entity.User, int, error) {
+	return nil, 0, nil
+}
+
+func (r *memoryUsers) BanUser(context.Context, string) error {
+	return nil
+}
+
+func (r *memoryUsers) Stats(context.Context) (*entity.UserStats, error) {
+	return &entity.UserStats{}, nil
+}
+
+type memorySessions struct {
+	mu       sync.Mutex
+	sessions map[string]entity.Session
+}
+
+func (r *memorySessions) CreateSession(_ context.Context, session *entity.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions[session.ID] = *session
+	return nil
+}
+
+func (r *memorySessions) GetSessionByTokenHash(_ context.Context, hash string) (*entity.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.sessions {
+		if s.RefreshTokenHash == hash || s.PreviousTokenHash == hash {
+			return &s, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r *memorySessions) RotateSession(_ context.Context, session *entity.Session, presentedHash string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.sessions[session.ID]
+	if !ok || stored.RefreshTokenHash != presentedHash || stored.RevokedAt != nil {
+		return false, nil
+	}
+	stored.RefreshTokenHash = session.RefreshTokenHash
+	stored.PreviousTokenHash = presentedHash
+	stored.AccessTokenID = session.AccessTokenID
+	stored.IP = session.IP
+	stored.LastSeenAt = session.LastSeenAt
+	stored.ExpiresAt = session.ExpiresAt
+	r.sessions[session.ID] = stored
+	return true, nil
+}
+
+func (r *memorySessions) ListSessions(_ context.Context, userID string) ([]*entity.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var sessions []*entity.Session
+	for _, s := range r.sessions {
+		if s.UserID == userID && s.RevokedAt == nil && s.ExpiresAt.After(time.Now()) {
+			sessions = append(sessions, &s)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt) })
+	return sessions, nil
+}
+
+func (r *memorySessions) RevokeSession(_ context.Context, userID, sessionID string) (*entity.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.sessions[sessionID]
+	if !ok || s.UserID != userID || s.RevokedAt != nil {
+		return nil, sql.ErrNoRows
+	}
+	now := time.Now()
+	s.RevokedAt = &now
+	r.sessions[sessionID] = s
+	return &s, nil
+}
+
+func (r *memorySessions) RevokeUserSessions(_ context.Context, userID string) ([]*entity.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	var revoked []*entity.Session
+	for id, s := range r.sessions {
+		if s.UserID == userID && s.RevokedAt == nil && s.ExpiresAt.After(now) {
+			s.RevokedAt = &now
+			r.sessions[id] = s
+			revoked = append(revoked, &s)
+		}
+	}
+	return revoked, nil
+}
+
+// active returns the user's sessions that are not revoked.
+func (r *memorySessions) active(userID string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, s := range r.sessions {
+		if s.UserID == userID && s.RevokedAt == nil {
+			n++
+		}
+	}
+	return n
+}
+
+type memoryResets struct {
+	mu     sync.Mutex
+	resets map[string]entity.PasswordReset
+}
+
+func (r *memoryResets) CreatePasswordReset(_ context.Context, reset *entity.PasswordReset) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for hash, old := range r.resets {
+		if old.UserID == reset.UserID || !old.ExpiresAt.After(time.Now()) {
+			delete(r.resets, hash)
+		}
+	}
+	r.resets[reset.TokenHash] = *reset
+	return nil
+}
+
+func (r *memoryResets) ConsumePasswordReset(_ context.Context, hash string) (*entity.PasswordReset, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	reset, ok := r.resets[hash]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	delete(r.resets, hash)
+	return &reset, nil
+}
+
+func (r *memoryResets) DeleteUserPasswordResets(_ context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for hash, reset := range r.resets {
+		if reset.UserID == userID {
+			delete(r.resets, hash)
+		}
+	}
+	return nil
+}
+
+// recordingQueue keeps what the publisher sends.
+type recordingQueue struct {
+	queue.MessageQueue
+	mu       sync.Mutex
+	messages []*queue.Message
+}
+
+func (q *recordingQueue) PublishMsg(msg *queue.Message) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.messages = append(q.messages, msg)
+	return nil
+}
+
+// last returns the data of the last message published on subject.
+func (q *recordingQueue) last(subject string) []byte {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i := len(q.messages) - 1; i >= 0; i-- {
+		if q.messages[i].Subject == subject {
+			return q.messages[i].Data
+		}
+	}
+	return nil
+}
+
+var testSessions = config.Sessions{
+	AccessTTL:        15 * time.Minute,
+	RefreshTTL:       time.Hour,
+	PasswordResetTTL: time.Hour,
+}
+
+type fixture struct {
+	usecase  *userUsecaseImpl
+	users    *memoryUsers
+	sessions *memorySessions
+	resets   *memoryResets
+	denied   *denylist.Memory
+	queue    *recordingQueue
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	keys, err := token.NewEphemeralKeyStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fixture{
+		users:    &memoryUsers{users: map[string]entity.User{}},
+		sessions: &memorySessions{sessions: map[string]entity.Session{}},
+		resets:   &memoryResets{resets: map[string]entity.PasswordReset{}},
+		denied:   denylist.NewMemory(),
+		queue:    &recordingQueue{},
+	}
+	f.usecase = NewUserUsecase(f.users, f.sessions, f.resets, keys, f.denied, publisher.NewUserPublisher(f.queue), testSessions).(*userUsecaseImpl)
+	return f
+}
+
+// addUser stores an active, verified user with role.
+func (f *fixture) addUser(t *testing.T, id string, role policy.Role) *entity.User {
+	t.Helper()
+	verified := time.Now()
+	user := &entity.User{
+		ID:              id,
+		Email:           id + "@example.com",
+		Username:        id,
+		Role:            role,
+		Status:          enum.StatusActive,
+		EmailVerifiedAt: &verified,
+	}
+	if _, err := f.users.CreateUser(context.Background(), user); err != nil {
+		t.Fatal(err)
+	}
+	return user
+}
+
+// login starts a session for the user.
+func (f *fixture) login(t *testing.T, userID string) *entity.Tokens {
+	t.Helper()
+	user, err := f.users.GetUserByID(context.Background(), userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokens, err := f.usecase.startSession(context.Background(), user, entity.ClientInfo{Device: "test", IP: "203.0.113.7"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tokens
+}
+
+// accessDenied reports whether the access token was denylisted.
+func (f *fixture) accessDenied(t *testing.T, accessToken string) bool {
+	t.Helper()
+	claims, err := f.usecase.keys.Parse(accessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	denied, err := f.denied.Contains(context.Background(), claims.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return denied
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/nats/payloads"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// How a password was changed, as published on user.password_changed.
+const (
+	passwordChangedByReset  = "reset"
+	passwordChangedByChange = "change"
+)
+
+var errInvalidResetToken = errors.Wrap(grpcerr.ErrInvalidArgument, "invalid or expired reset token")
+
+// RequestPasswordReset emails the user a password reset token through
+// NotificationService, replacing any token sent before. Unknown addresses
+// succeed silently so the result doesn't reveal who has an account.
+func (u *userUsecaseImpl) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := u.userRepo.GetUserByEmail(ctx, email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+
+	resetToken, hash, err := newOpaqueToken()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	reset := &entity.PasswordReset{
+		TokenHash: hash,
+		UserID:    user.ID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(u.sessions.PasswordResetTTL),
+	}
+	if err := u.resetRepo.CreatePasswordReset(ctx, reset); err != nil {
+		return errors.Wrap(err, "failed to create password reset")
+	}
+
+	err = u.publisher.PublishPasswordResetRequested(ctx, payloads.PasswordResetEventPayload{
+		UserID:    user.ID,
+		Email:     user.Email,
+		Token:     resetToken,
+		ExpiresAt: reset.ExpiresAt.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to publish event", "subject", "user.password_reset_requested", logging.Err(err))
+	}
+	return nil
+}
+
+// ResetPassword sets a new password with an emailed reset token. The token
+// works once; every session of the user is ended and their number returned.
+func (u *userUsecaseImpl) ResetPassword(ctx context.Context, resetToken, newPassword string) (int, error) {
+	reset, err := u.resetRepo.ConsumePasswordReset(ctx, hashToken(resetToken))
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, errInvalidResetToken
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get password reset")
+	}
+	if time.Now().After(reset.ExpiresAt) {
+		return 0, errInvalidResetToken
+	}
+
+	user, err := u.userRepo.GetUserByID(ctx, reset.UserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, errInvalidResetToken
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get user")
+	}
+	return u.setPassword(ctx, user, newPassword, passwordChangedByReset)
+}
+
+// ChangePassword replaces the user's password after checking the current
+// one. Every session, the caller's included, is ended and their number
+// returned.
+func (u *userUsecaseImpl) ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) (int, error) {
+	user, err := u.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get user")
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(currentPassword)); err != nil {
+		return 0, errors.Wrap(grpcerr.ErrInvalidArgument, "current password is incorrect")
+	}
+	if currentPassword == newPassword {
+		return 0, errors.Wrap(grpcerr.ErrInvalidArgument, "new password must differ from the current one")
+	}
+	return u.setPassword(ctx, user, newPassword, passwordChangedByChange)
+}
+
+// setPassword stores the new password, drops outstanding reset tokens, ends
+// every session and tells the user their password changed.
+func (u *userUsecaseImpl) setPassword(ctx context.Context, user *entity.User, newPassword, method string) (int, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to hash password")
+	}
+	now := time.Now()
+	user.Password = string(hashedPassword)
+	user.UpdatedAt = now
+	if err := u.userRepo.UpdateUser(ctx, user); err != nil {
+		return 0, errors.Wrap(err, "failed to update password")
+	}
+
+	if err := u.resetRepo.DeleteUserPasswordResets(ctx, user.ID); err != nil {
+		return 0, errors.Wrap(err, "failed to delete password resets")
+	}
+	revoked, err := u.LogoutAllSessions(ctx, user.ID)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to end sessions")
+	}
+
+	err = u.publisher.PublishPasswordChanged(ctx, payloads.PasswordChangedEventPayload{
+		UserID:    user.ID,
+		Email:     user.Email,
+		Method:    method,
+		ChangedAt: now.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to publish event", "subject", "user.password_changed", logging.Err(err))
+	}
+	return revoked, nil
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/nats/payloads"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/nats/publisher"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// requestReset asks for a reset for the user and returns the emailed token.
+func (f *fixture) requestReset(t *testing.T, userID string) string {
+	t.Helper()
+	if err := f.usecase.RequestPasswordReset(context.Background(), userID+"@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	var payload payloads.PasswordResetEventPayload
+	if err := json.Unmarshal(f.queue.last(publisher.PasswordResetRequestedSubject), &payload); err != nil {
+		t.Fatal(err)
+	}
+	return payload.Token
+}
+
+func (f *fixture) setPassword(t *testing.T, userID, password string) {
+	t.Helper()
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := f.users.users[userID]
+	user.Password = string(hashed)
+	f.users.users[userID] = user
+}
+
+func (f *fixture) passwordIs(t *testing.T, userID, password string) bool {
+	t.Helper()
+	user := f.users.users[userID]
+	return bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)) == nil
+}
+
+func TestResetPassword(t *testing.T) {
+	f := newFixture(t)
+	f.addUser(t, "u1", policy.RoleUser)
+	tokens := f.login(t, "u1")
+	f.login(t, "u1")
+	resetToken := f.requestReset(t, "u1")
+	ctx := context.Background()
+
+	revoked, err := f.usecase.ResetPassword(ctx, resetToken, "new-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revoked != 2 || f.sessions.active("u1") != 0 {
+		t.Errorf("revoked %d sessions, %d still active; want every session ended", revoked, f.sessions.active("u1"))
+	}
+	if !f.accessDenied(t, tokens.AccessToken) {
+		t.Error("the access token of an ended session was not denylisted")
+	}
+	if !f.passwordIs(t, "u1", "new-secret") {
+		t.Error("the password was not changed")
+	}
+	if f.queue.last(publisher.PasswordChangedSubject) == nil {
+		t.Error("no password changed event was published")
+	}
+
+	if _, err := f.usecase.ResetPassword(ctx, resetToken, "another-secret"); !errors.Is(err, grpcerr.ErrInvalidArgument) {
+		t.Errorf("second use of the token: err = %v, want InvalidArgument", err)
+	}
+	if !f.passwordIs(t, "u1", "new-secret") {
+		t.Error("a used token changed the password again")
+	}
+}
+
+func TestResetPasswordRejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T, f *fixture) string
+	}{
+		{
+			name:    "unknown token",
+			prepare: func(*testing.T, *fixture) string { return "unknown" },
+		},
+		{
+			name: "expired token",
+			prepare: func(t *testing.T, f *fixture) string {
+				token := f.requestReset(t, "u1")
+				reset := f.resets.resets[hashToken(token)]
+				reset.ExpiresAt = time.Now().Add(-time.Second)
+				f.resets.resets[hashToken(token)] = reset
+				return token
+			},
+		},
+		{
+			name: "token replaced by a newer request",
+			prepare: func(t *testing.T, f *fixture) string {
+				token := f.requestReset(t, "u1")
+				f.requestReset(t, "u1")
+				return token
+			},
+		},
+		{
+			name: "token outstanding when the password was changed",
+			prepare: func(t *testing.T, f *fixture) string {
+				token := f.requestReset(t, "u1")
+				if _, err := f.usecase.ChangePassword(context.Background(), "u1", "old-secret", "changed-secret"); err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			f.addUser(t, "u1", policy.RoleUser)
+			f.setPassword(t, "u1", "old-secret")
+			f.login(t, "u1")
+			token := tt.prepare(t, f)
+			before := f.users.users["u1"].Password
+			active := f.sessions.active("u1")
+
+			if _, err := f.usecase.ResetPassword(context.Background(), token, "new-secret"); !errors.Is(err, grpcerr.ErrInvalidArgument) {
+				t.Fatalf("err = %v, want InvalidArgument", err)
+			}
+			if f.users.users["u1"].Password != before {
+				t.Error("a rejected token changed the password")
+			}
+			if f.sessions.active("u1") != active {
+				t.Error("a rejected token ended sessions")
+			}
+		})
+	}
+}
+
+func TestRequestPasswordResetUnknownEmail(t *testing.T) {
+	f := newFixture(t)
+	if err := f.usecase.RequestPasswordReset(context.Background(), "nobody@example.com"); err != nil {
+		t.Fatalf("unknown address: err = %v, want nil so accounts can't be probed", err)
+	}
+	if f.queue.last(publisher.PasswordResetRequestedSubject) != nil {
+		t.Error("a reset was sent for an unknown address")
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	tests := []struct {
+		name    string
+		current string
+		next    string
+		want    error
+	}{
+		{name: "right current password", current: "old-secret", next: "new-secret"},
+		{name: "wrong current password", current: "guess", next: "new-secret", want: grpcerr.ErrInvalidArgument},
+		{name: "unchanged password", current: "old-secret", next: "old-secret", want: grpcerr.ErrInvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			f.addUser(t, "u1", policy.RoleUser)
+			f.setPassword(t, "u1", "old-secret")
+			f.login(t, "u1")
+
+			_, err := f.usecase.ChangePassword(context.Background(), "u1", tt.current, tt.next)
+			if !errors.Is(err, tt.want) || (tt.want == nil) != (err == nil) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			wantActive := 1
+			if tt.want == nil {
+				wantActive = 0
+			}
+			if n := f.sessions.active("u1"); n != wantActive {
+				t.Errorf("%d sessions active, want %d", n, wantActive)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"log/slog"
+	"time"
+	"unicode/utf8"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// maxDeviceLength bounds the stored User-Agent.
+const maxDeviceLength = 255
+
+var errInvalidRefreshToken = errors.Wrap(grpcerr.ErrUnauthenticated, "invalid or expired refresh token")
+
+// RefreshToken exchanges a refresh token for a new access token and a new
+// refresh token. The presented token stops working; presenting it again means
+// it was copied, so the whole session is revoked.
+func (u *userUsecaseImpl) RefreshToken(ctx context.Context, refreshToken string, client entity.ClientInfo) (*entity.User, *entity.Tokens, error) {
+	hash := hashToken(refreshToken)
+	session, err := u.sessionRepo.GetSessionByTokenHash(ctx, hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, errInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get session")
+	}
+
+	now := time.Now()
+	if session.RevokedAt != nil || now.After(session.ExpiresAt) {
+		return nil, nil, errInvalidRefreshToken
+	}
+	if session.RefreshTokenHash != hash {
+		slog.WarnContext(ctx, "rotated refresh token reused, revoking session", "session_id", session.ID, "user_id", session.UserID)
+		if err := u.revokeSession(ctx, session.UserID, session.ID); err != nil && !errors.Is(err, grpcerr.ErrNotFound) {
+			return nil, nil, err
+		}
+		return nil, nil, errInvalidRefreshToken
+	}
+
+	user, err := u.userRepo.GetUserByID(ctx, session.UserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, errInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get user")
+	}
+	if err := u.checkAccount(ctx, user); err != nil {
+		if err := u.revokeSession(ctx, user.ID, session.ID); err != nil && !errors.Is(err, grpcerr.ErrNotFound) {
+			return nil, nil, err
+		}
+		return nil, nil, err
+	}
+
+	nextRefreshToken, nextHash, err := newOpaqueToken()
+	if err != nil {
+		return nil, nil, err
+	}
+	accessToken, claims, err := u.keys.Issue(user.ID, string(user.Role), user.EmailVerified(), session.ID, u.sessions.AccessTTL)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to sign token")
+	}
+
+	previousAccessTokenID := session.AccessTokenID
+	session.RefreshTokenHash = nextHash
+	session.AccessTokenID = claims.ID
+	session.LastSeenAt = now
+	session.ExpiresAt = now.Add(u.sessions.RefreshTTL)
+	if client.IP != "" {
+		session.IP = client.IP
+	}
+	rotated, err := u.sessionRepo.RotateSession(ctx, session, hash)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to rotate session")
+	}
+	if !rotated {
+		// A concurrent refresh or a logout got there first.
+		return nil, nil, errInvalidRefreshToken
+	}
+	u.deny(ctx, previousAccessTokenID)
+
+	return user, &entity.Tokens{
+		AccessToken:  accessToken,
+		RefreshToken: nextRefreshToken,
+		ExpiresIn:    u.sessions.AccessTTL,
+		SessionID:    session.ID,
+	}, nil
+}
+
+// Logout ends one of the user's sessions.
+func (u *userUsecaseImpl) Logout(ctx context.Context, userID, sessionID string) error {
+	return u.revokeSession(ctx, userID, sessionID)
+}
+
+// LogoutAllSessions ends every session of the user and returns how many there were.
+func (u *userUsecaseImpl) LogoutAllSessions(ctx context.Context, userID string) (int, error) {
+	sessions, err := u.sessionRepo.RevokeUserSessions(ctx, userID)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to revoke sessions")
+	}
+	for _, s := range sessions {
+		u.deny(ctx, s.AccessTokenID)
+	}
+	return len(sessions), nil
+}
+
+func (u *userUsecaseImpl) ListSessions(ctx context.Context, userID string) ([]*entity.Session, error) {
+	sessions, err := u.sessionRepo.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sessions")
+	}
+	return sessions, nil
+}
+
+// startSession stores a new session for the user and issues its first tokens.
+func (u *userUsecaseImpl) startSession(ctx context.Context, user *entity.User, client entity.ClientInfo) (*entity.Tokens, error) {
+	refreshToken, hash, err := newOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := &entity.Session{
+		ID:               uuid.New().String(),
+		UserID:           user.ID,
+		RefreshTokenHash: hash,
+		Device:           truncate(client.Device, maxDeviceLength),
+		IP:               client.IP,
+		CreatedAt:        now,
+		LastSeenAt:       now,
+		ExpiresAt:        now.Add(u.sessions.RefreshTTL),
+	}
+	accessToken, claims, err := u.keys.Issue(user.ID, string(user.Role), user.EmailVerified(), session.ID, u.sessions.AccessTTL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign token")
+	}
+	session.AccessTokenID = claims.ID
+
+	if err := u.sessionRepo.CreateSession(ctx, session); err != nil {
+		return nil, errors.Wrap(err, "failed to create session")
+	}
+
+	return &entity.Tokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    u.sessions.AccessTTL,
+		SessionID:    session.ID,
+	}, nil
+}
+
+func (u *userUsecaseImpl) revokeSession(ctx context.Context, userID, sessionID string) error {
+	session, err := u.sessionRepo.RevokeSession(ctx, userID, sessionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrap(grpcerr.ErrNotFound, "session not found")
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke session")
+	}
+	u.deny(ctx, session.AccessTokenID)
+	return nil
+}
+
+// deny denylists an access token for the longest it can still be valid. A
+// failure is logged rather than returned: the session is already revoked, so
+// the token stops working at its expiry at the latest.
+func (u *userUsecaseImpl) deny(ctx context.Context, tokenID string) {
+	if tokenID == "" {
+		return
+	}
+	if err := u.denylist.Add(ctx, tokenID, u.sessions.AccessTTL); err != nil {
+		slog.ErrorContext(ctx, "failed to denylist access token", "token_id", tokenID, logging.Err(err))
+	}
+}
+
+// revoked reports whether the access token has been denylisted. When the
+// denylist can't be reached the token is accepted.
+func (u *userUsecaseImpl) revoked(ctx context.Context, tokenID string) bool {
+	if tokenID == "" {
+		return false
+	}
+	denied, err := u.denylist.Contains(ctx, tokenID)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to check access token denylist", logging.Err(err))
+		return false
+	}
+	return denied
+}
+
+// newOpaqueToken returns a random token, used for refresh and password reset
+// tokens, and the hash it is stored under.
+func newOpaqueToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", errors.Wrap(err, "failed to generate token")
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, hashToken(token), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// truncate cuts s to at most n bytes without splitting a character.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity/enum"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"github.com/pkg/errors"
+)
+
+var client = entity.ClientInfo{Device: "test", IP: "198.51.100.1"}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	f := newFixture(t)
+	f.addUser(t, "u1", policy.RoleUser)
+	first := f.login(t, "u1")
+	ctx := context.Background()
+
+	user, second, err := f.usecase.RefreshToken(ctx, first.RefreshToken, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.ID != "u1" || second.SessionID != first.SessionID {
+		t.Fatalf("refresh returned user %q session %q, want u1 in the same session", user.ID, second.SessionID)
+	}
+	if second.RefreshToken == first.RefreshToken || second.AccessToken == first.AccessToken {
+		t.Fatal("refresh did not issue new tokens")
+	}
+	if !f.accessDenied(t, first.AccessToken) {
+		t.Error("the replaced access token was not denylisted")
+	}
+	if f.accessDenied(t, second.AccessToken) {
+		t.Error("the new access token is denylisted")
+	}
+	if s := f.sessions.sessions[first.SessionID]; s.IP != client.IP {
+		t.Errorf("session IP = %q, want the refreshing client's %q", s.IP, client.IP)
+	}
+
+	if _, _, err := f.usecase.RefreshToken(ctx, second.RefreshToken, client); err != nil {
+		t.Errorf("the rotated refresh token was rejected: %v", err)
+	}
+}
+
+func TestRefreshTokenReuseRevokesSession(t *testing.T) {
+	f := newFixture(t)
+	f.addUser(t, "u1", policy.RoleUser)
+	first := f.login(t, "u1")
+	ctx := context.Background()
+
+	_, second, err := f.usecase.RefreshToken(ctx, first.RefreshToken, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = f.usecase.RefreshToken(ctx, first.RefreshToken, client)
+	if !errors.Is(err, grpcerr.ErrUnauthenticated) {
+		t.Fatalf("replayed refresh token: err = %v, want Unauthenticated", err)
+	}
+	if n := f.sessions.active("u1"); n != 0 {
+		t.Errorf("%d sessions still active after a replayed refresh token", n)
+	}
+	if !f.accessDenied(t, second.AccessToken) {
+		t.Error("the session's current access token was not denylisted")
+	}
+	if _, _, err := f.usecase.RefreshToken(ctx, second.RefreshToken, client); !errors.Is(err, grpcerr.ErrUnauthenticated) {
+		t.Errorf("the latest refresh token still works after reuse: err = %v", err)
+	}
+}
+
+func TestRefreshTokenRejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T, f *fixture, tokens *entity.Tokens)
+		token   func(tokens *entity.Tokens) string
+		want    error
+	}{
+		{
+			name:  "unknown token",
+			token: func(*entity.Tokens) string { return "unknown" },
+			want:  grpcerr.ErrUnauthenticated,
+		},
+		{
+			name: "expired session",
+			prepare: func(t *testing.T, f *fixture, tokens *entity.Tokens) {
+				s := f.sessions.sessions[tokens.SessionID]
+				s.ExpiresAt = time.Now().Add(-time.Second)
+				f.sessions.sessions[tokens.SessionID] = s
+			},
+			want: grpcerr.ErrUnauthenticated,
+		},
+		{
+			name: "logged out session",
+			prepare: func(t *testing.T, f *fixture, tokens *entity.Tokens) {
+				if err := f.usecase.Logout(context.Background(), "u1", tokens.SessionID); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: grpcerr.ErrUnauthenticated,
+		},
+		{
+			name: "banned user",
+			prepare: func(t *testing.T, f *fixture, tokens *entity.Tokens) {
+				u := f.users.users["u1"]
+				u.Status = enum.StatusBanned
+				f.users.users["u1"] = u
+			},
+			want: grpcerr.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFixture(t)
+			f.addUser(t, "u1", policy.RoleUser)
+			tokens := f.login(t, "u1")
+			if tt.prepare != nil {
+				tt.prepare(t, f, tokens)
+			}
+			presented := tokens.RefreshToken
+			if tt.token != nil {
+				presented = tt.token(tokens)
+			}
+
+			_, _, err := f.usecase.RefreshToken(context.Background(), presented, client)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if n := f.sessions.active("u1"); tt.want == grpcerr.ErrPermissionDenied && n != 0 {
+				t.Errorf("%d sessions still active for a banned user", n)
+			}
+		})
+	}
+}
+
+// racingSessions loses every RotateSession, as when a concurrent refresh of
+// the same token or a logout commits first.
+type racingSessions struct {
+	*memorySessions
+}
+
+func (racingSessions) RotateSession(context.Context, *entity.Session, string) (bool, error) {
+	return false, nil
+}
+
+func TestRefreshTokenLosesRotationRace(t *testing.T) {
+	f := newFixture(t)
+	f.addUser(t, "u1", policy.RoleUser)
+	tokens := f.login(t, "u1")
+	f.usecase.sessionRepo = racingSessions{f.sessions}
+
+	_, _, err := f.usecase.RefreshToken(context.Background(), tokens.RefreshToken, client)
+	if !errors.Is(err, grpcerr.ErrUnauthenticated) {
+		t.Fatalf("err = %v, want Unauthenticated", err)
+	}
+	if f.accessDenied(t, tokens.AccessToken) {
+		t.Error("losing the race denylisted the winner's session token")
+	}
+}
+
+func TestLogoutAllSessions(t *testing.T) {
+	f := newFixture(t)
+	f.addUser(t, "u1", policy.RoleUser)
+	f.addUser(t, "u2", policy.RoleUser)
+	a, b := f.login(t, "u1"), f.login(t, "u1")
+	other := f.login(t, "u2")
+
+	n, err := f.usecase.LogoutAllSessions(context.Background(), "u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("revoked %d sessions, want 2", n)
+	}
+	if !f.accessDenied(t, a.AccessToken) || !f.accessDenied(t, b.AccessToken) {
+		t.Error("access tokens of the revoked sessions are not denylisted")
+	}
+	if f.accessDenied(t, other.AccessToken) || f.sessions.active("u2") != 1 {
+		t.Error("another user's session was revoked")
+	}
+}
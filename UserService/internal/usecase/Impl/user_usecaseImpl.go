@@ -2,33 +2,60 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/nats/payloads"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/adapter/nats/publisher"
-	"log"
-	"os"
+	"log/slog"
 	"time"
 
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity/enum"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/repository/RepoInterfaces"
+	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/token"
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/usecase/Usecase_Interfaces"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/config"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/denylist"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/grpcerr"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/jwks"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
 
-	"github.com/dgrijalva/jwt-go"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type userUsecaseImpl struct {
-	userRepo  RepoInterfaces.UserRepository
-	publisher *publisher.UserPublisher
+	userRepo    RepoInterfaces.UserRepository
+	sessionRepo RepoInterfaces.SessionRepository
+	resetRepo   RepoInterfaces.PasswordResetRepository
+	keys        *token.KeyStore
+	denylist    denylist.Denylist
+	publisher   *publisher.UserPublisher
+	sessions    config.Sessions
 }
 
-func NewUserUsecase(userRepo RepoInterfaces.UserRepository, publisher *publisher.UserPublisher) Usecase_Interfaces.UserUsecase {
-	return &userUsecaseImpl{userRepo: userRepo, publisher: publisher}
+func NewUserUsecase(
+	userRepo RepoInterfaces.UserRepository,
+	sessionRepo RepoInterfaces.SessionRepository,
+	resetRepo RepoInterfaces.PasswordResetRepository,
+	keys *token.KeyStore,
+	denylist denylist.Denylist,
+	publisher *publisher.UserPublisher,
+	sessions config.Sessions,
+) Usecase_Interfaces.UserUsecase {
+	return &userUsecaseImpl{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		resetRepo:   resetRepo,
+		keys:        keys,
+		denylist:    denylist,
+		publisher:   publisher,
+		sessions:    sessions,
+	}
 }
 
-func (u *userUsecaseImpl) Register(email, username, password string) (*entity.User, error) {
+func (u *userUsecaseImpl) Register(ctx context.Context, email, username, password string) (*entity.User, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to hash password")
@@ -39,85 +66,125 @@ func (u *userUsecaseImpl) Register(email, username, password string) (*entity.Us
 		Email:     email,
 		Username:  username,
 		Password:  string(hashedPassword),
-		Role:      enum.RoleUser,
+		Role:      policy.RoleUser,
 		Bio:       "",
-		Banned:    false,
+		Status:    enum.StatusUnverified,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
-	createdUser, err := u.userRepo.CreateUser(context.Background(), user)
+	createdUser, err := u.userRepo.CreateUser(ctx, user)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create user")
 	}
 
-	err = u.publisher.PublishUserRegistered(payloads.UserEventPayload{
+	err = u.publisher.PublishUserRegistered(ctx, payloads.UserEventPayload{
 		UserID: createdUser.ID,
 		Email:  createdUser.Email,
 	})
 
 	if err != nil {
-		log.Printf("Failed to publish user.registered: %v", err)
+		slog.ErrorContext(ctx, "failed to publish event", "subject", "user.registered", logging.Err(err))
 	}
 
 	return createdUser, nil
 }
 
-func (u *userUsecaseImpl) Login(email, password string) (*entity.User, string, error) {
-	user, err := u.userRepo.GetUserByEmail(context.Background(), email)
+// Login checks the credentials and starts a new session for client.
+func (u *userUsecaseImpl) Login(ctx context.Context, email, password string, client entity.ClientInfo) (*entity.User, *entity.Tokens, error) {
+	user, err := u.userRepo.GetUserByEmail(ctx, email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, errors.Wrap(grpcerr.ErrUnauthenticated, "invalid email or password")
+	}
 	if err != nil {
-		return nil, "", errors.Wrap(err, "user not found")
+		return nil, nil, errors.Wrap(err, "failed to get user")
 	}
 
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
 	if err != nil {
-		return nil, "", errors.New("invalid password")
+		return nil, nil, errors.Wrap(grpcerr.ErrUnauthenticated, "invalid email or password")
+	}
+	if err := u.checkAccount(ctx, user); err != nil {
+		return nil, nil, err
 	}
 
-	token, err := generateToken(user.ID, string(user.Role))
+	tokens, err := u.startSession(ctx, user, client)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "failed to generate token")
+		return nil, nil, errors.Wrap(err, "failed to start session")
 	}
 
-	return user, token, nil
+	return user, tokens, nil
 }
 
-func (u *userUsecaseImpl) Authorize(token string) (bool, string, error) {
-	parsedToken, err := jwt.Parse(token, func(t *jwt.Token) (interface{}, error) {
-		return []byte(os.Getenv("JWT_SECRET")), nil
-	})
-	if err != nil || !parsedToken.Valid {
+func (u *userUsecaseImpl) Authorize(ctx context.Context, tokenStr string) (bool, string, error) {
+	claims, err := u.keys.Parse(tokenStr)
+	if err != nil {
 		return false, "invalid token", errors.Wrap(err, "failed to parse token")
 	}
+	if u.revoked(ctx, claims.ID) {
+		return false, "token revoked", errors.Wrap(grpcerr.ErrUnauthenticated, "token has been revoked")
+	}
+	if err := u.CheckAccount(ctx, claims.UserID); err != nil {
+		return false, "account unavailable", err
+	}
 	return true, "token valid", nil
 }
 
-func (u *userUsecaseImpl) GetCurrentUser(userID string) (*entity.User, error) {
-	user, err := u.userRepo.GetUserByID(context.Background(), userID)
+// JWKS returns the public keys access tokens are verified with.
+func (u *userUsecaseImpl) JWKS() jwks.Set {
+	return u.keys.JWKS()
+}
+
+func (u *userUsecaseImpl) GetCurrentUser(ctx context.Context, userID string) (*entity.User, error) {
+	user, err := u.userRepo.GetUserByID(ctx, userID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get current user")
 	}
 	return user, nil
 }
 
-func (u *userUsecaseImpl) GetUser(userID string) (*entity.User, error) {
-	user, err := u.userRepo.GetUserByID(context.Background(), userID)
+func (u *userUsecaseImpl) GetUser(ctx context.Context, userID string) (*entity.User, error) {
+	user, err := u.userRepo.GetUserByID(ctx, userID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get user")
 	}
 	return user, nil
 }
 
-func (u *userUsecaseImpl) UpdateProfile(userID, username, bio string) (*entity.User, error) {
-	user, err := u.userRepo.GetUserByID(context.Background(), userID)
+// GetUsersByIDs loads several users in one query. Duplicate and empty ids are
+// dropped; ids that don't exist are simply missing from the result.
+func (u *userUsecaseImpl) GetUsersByIDs(ctx context.Context, userIDs []string) ([]*entity.User, error) {
+	seen := make(map[string]bool, len(userIDs))
+	ids := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return []*entity.User{}, nil
+	}
+
+	users, err := u.userRepo.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get users")
+	}
+	return users, nil
+}
+
+func (u *userUsecaseImpl) UpdateProfile(ctx context.Context, userID, username, bio string) (*entity.User, error) {
+	user, err := u.userRepo.GetUserByID(ctx, userID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get user")
 	}
 
 	user.Username = username
 	user.Bio = bio
+	user.UpdatedAt = time.Now()
 
-	err = u.userRepo.UpdateUser(context.Background(), user)
+	err = u.userRepo.UpdateUser(ctx, user)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to update profile")
 	}
@@ -125,22 +192,14 @@ func (u *userUsecaseImpl) UpdateProfile(userID, username, bio string) (*entity.U
 	return user, nil
 }
 
-func (u *userUsecaseImpl) PromoteToModerator(currentUserID, targetUserID string) (*entity.User, error) {
-	currentUser, err := u.userRepo.GetUserByID(context.Background(), currentUserID)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get current user")
-	}
-	if !currentUser.Role.IsAdmin() {
-		return nil, errors.New("only admins can promote to moderator")
-	}
-
-	targetUser, err := u.userRepo.GetUserByID(context.Background(), targetUserID)
+func (u *userUsecaseImpl) PromoteToModerator(ctx context.Context, targetUserID string) (*entity.User, error) {
+	targetUser, err := u.userRepo.GetUserByID(ctx, targetUserID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get target user")
 	}
 
-	targetUser.Role = enum.RoleModerator
-	err = u.userRepo.UpdateUser(context.Background(), targetUser)
+	targetUser.Role = policy.RoleModerator
+	err = u.userRepo.UpdateUser(ctx, targetUser)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to promote to moderator")
 	}
@@ -148,146 +207,101 @@ func (u *userUsecaseImpl) PromoteToModerator(currentUserID, targetUserID string)
 	return targetUser, nil
 }
 
-func (u *userUsecaseImpl) PromoteToAdmin(currentUserID, targetUserID string) (*entity.User, error) {
-	currentUser, err := u.userRepo.GetUserByID(context.Background(), currentUserID)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get current user")
-	}
-	if !currentUser.Role.IsAdmin() {
-		return nil, errors.New("only admins can promote to admin")
-	}
-
-	targetUser, err := u.userRepo.GetUserByID(context.Background(), targetUserID)
+func (u *userUsecaseImpl) PromoteToAdmin(ctx context.Context, targetUserID string) (*entity.User, error) {
+	targetUser, err := u.userRepo.GetUserByID(ctx, targetUserID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get target user")
 	}
 
-	targetUser.Role = enum.RoleAdmin
-	err = u.userRepo.UpdateUser(context.Background(), targetUser)
+	targetUser.Role = policy.RoleAdmin
+	err = u.userRepo.UpdateUser(ctx, targetUser)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to promote to admin")
 	}
 
-	err = u.publisher.PublishUserPromotedToAdmin(payloads.UserEventPayload{
+	err = u.publisher.PublishUserPromotedToAdmin(ctx, payloads.UserEventPayload{
 		UserID: targetUserID,
 	})
 
 	if err != nil {
-		log.Printf("Failed to publish user.promoted_to_admin event: %v", err)
+		slog.ErrorContext(ctx, "failed to publish event", "subject", "user.promoted_to_admin", logging.Err(err))
 	}
 
 	return targetUser, nil
 }
 
-func (u *userUsecaseImpl) DemoteToUser(currentUserID, targetUserID string) (*entity.User, error) {
-	currentUser, err := u.userRepo.GetUserByID(context.Background(), currentUserID)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get current user")
-	}
-	if !currentUser.Role.IsAdmin() {
-		return nil, errors.New("only admins can demote to user")
-	}
-
-	targetUser, err := u.userRepo.GetUserByID(context.Background(), targetUserID)
+func (u *userUsecaseImpl) PromoteToExpert(ctx context.Context, targetUserID string) (*entity.User, error) {
+	targetUser, err := u.userRepo.GetUserByID(ctx, targetUserID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get target user")
 	}
 
-	targetUser.Role = enum.RoleUser
-	err = u.userRepo.UpdateUser(context.Background(), targetUser)
+	targetUser.Role = policy.RoleExpert
+	err = u.userRepo.UpdateUser(ctx, targetUser)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to demote to user")
+		return nil, errors.Wrap(err, "failed to promote to expert")
 	}
 
 	return targetUser, nil
 }
 
-func (u *userUsecaseImpl) DeleteAccount(currentUserID, targetUserID string) error {
-	currentUser, err := u.userRepo.GetUserByID(context.Background(), currentUserID)
-	if err != nil {
-		return errors.Wrap(err, "failed to get current user")
-	}
-	if !currentUser.Role.IsAdmin() {
-		return errors.New("only admins can delete accounts")
-	}
-
-	targetUser, err := u.userRepo.GetUserByID(context.Background(), targetUserID)
-	if err != nil {
-		return errors.Wrap(err, "failed to get target user")
-	}
-
-	err = u.userRepo.DeleteUser(context.Background(), targetUserID)
+func (u *userUsecaseImpl) DemoteToUser(ctx context.Context, targetUserID string) (*entity.User, error) {
+	targetUser, err := u.userRepo.GetUserByID(ctx, targetUserID)
 	if err != nil {
-		return errors.Wrap(err, "failed to delete user")
+		return nil, errors.Wrap(err, "failed to get target user")
 	}
 
-	err = u.publisher.PublishUserDeleted(payloads.UserEventPayload{
-		UserID: targetUser.ID,
-		Email:  targetUser.Email,
-	})
+	targetUser.Role = policy.RoleUser
+	err = u.userRepo.UpdateUser(ctx, targetUser)
 	if err != nil {
-		log.Printf("Failed to publish user.deleted event: %v", err)
-	}
-
-	return nil
-}
-func (u *userUsecaseImpl) ListUsers(searchQuery string) ([]*entity.User, error) {
-	filter := entity.UserFilter{
-		SearchQuery: searchQuery,
+		return nil, errors.Wrap(err, "failed to demote to user")
 	}
 
-	users, err := u.userRepo.ListUsers(context.Background(), filter)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to list users")
-	}
-	return users, nil
+	return targetUser, nil
 }
 
-// BanUser sets the 'Banned' status of a user to true.
-func (u *userUsecaseImpl) BanUser(currentUserId string, targetUserId string) error {
-	currentUser, err := u.userRepo.GetUserByID(context.Background(), currentUserId)
+func (u *userUsecaseImpl) DeleteAccount(ctx context.Context, targetUserID string) error {
+	targetUser, err := u.userRepo.GetUserByID(ctx, targetUserID)
 	if err != nil {
-		return errors.Wrap(err, "failed to get current user")
-	}
-	if !currentUser.Role.IsAdmin() {
-		return errors.New("only admins can ban users")
+		return errors.Wrap(err, "failed to get target user")
 	}
 
-	targetUser, err := u.userRepo.GetUserByID(context.Background(), targetUserId)
-	if err != nil {
-		return errors.Wrap(err, "failed to get target user")
+	// The sessions go with the user; revoking them first denylists the access
+	// tokens still in use.
+	if _, err := u.LogoutAllSessions(ctx, targetUserID); err != nil {
+		return errors.Wrap(err, "failed to end sessions")
 	}
 
-	targetUser.Banned = true
-	err = u.userRepo.UpdateUser(context.Background(), targetUser)
+	err = u.userRepo.DeleteUser(ctx, targetUserID)
 	if err != nil {
-		return errors.Wrap(err, "failed to ban user")
+		return errors.Wrap(err, "failed to delete user")
 	}
 
-	err = u.publisher.PublishUserBanned(payloads.UserEventPayload{
+	err = u.publisher.PublishUserDeleted(ctx, payloads.UserEventPayload{
 		UserID: targetUser.ID,
 		Email:  targetUser.Email,
-		Reason: "Rule violation or other reason",
 	})
-
 	if err != nil {
-		log.Printf("Failed to publish user.banned event: %v", err)
+		slog.ErrorContext(ctx, "failed to publish event", "subject", "user.deleted", logging.Err(err))
 	}
 
 	return nil
 }
 
-func generateToken(userID, role string) (string, error) {
-	claims := jwt.MapClaims{
-		"user_id": userID,
-		"role":    role,
-		"exp":     time.Now().Add(time.Hour * 24).Unix(),
+// ListUsers returns the page of users selected by filter and the number of
+// users matching it.
+func (u *userUsecaseImpl) ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, int, error) {
+	users, total, err := u.userRepo.ListUsers(ctx, filter)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to list users")
 	}
+	return users, total, nil
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(os.Getenv("JWT_SECRET")))
+func (u *userUsecaseImpl) GetUserStats(ctx context.Context) (*entity.UserStats, error) {
+	stats, err := u.userRepo.Stats(ctx)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to sign token")
+		return nil, errors.Wrap(err, "failed to get user stats")
 	}
-	return tokenString, nil
+	return stats, nil
 }
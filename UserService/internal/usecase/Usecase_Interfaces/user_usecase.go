@@ -1,20 +1,38 @@
 package Usecase_Interfaces
 
 import (
+	"context"
+	"time"
+
 	"github.com/KaminurOrynbek/BiznesAsh/UserService/internal/entity"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/jwks"
 )
 
 type UserUsecase interface {
-	Register(email, username, password string) (*entity.User, error)
-	Login(email, password string) (*entity.User, string, error)
-	Authorize(token string) (bool, string, error)
-	GetCurrentUser(userId string) (*entity.User, error)
-	GetUser(userId string) (*entity.User, error)
-	UpdateProfile(userId, username, bio string) (*entity.User, error)
-	PromoteToModerator(currentUserId, targetUserId string) (*entity.User, error)
-	PromoteToAdmin(currentUserId, targetUserId string) (*entity.User, error)
-	DemoteToUser(currentUserId, targetUserId string) (*entity.User, error)
-	DeleteAccount(currentUserId, targetUserId string) error
-	ListUsers(searchQuery string) ([]*entity.User, error)
-	BanUser(currentUserId, targetUserId string) error
+	Register(ctx context.Context, email, username, password string) (*entity.User, error)
+	Login(ctx context.Context, email, password string, client entity.ClientInfo) (*entity.User, *entity.Tokens, error)
+	Authorize(ctx context.Context, token string) (bool, string, error)
+	JWKS() jwks.Set
+	GetCurrentUser(ctx context.Context, userId string) (*entity.User, error)
+	GetUser(ctx context.Context, userId string) (*entity.User, error)
+	GetUsersByIDs(ctx context.Context, userIds []string) ([]*entity.User, error)
+	UpdateProfile(ctx context.Context, userId, username, bio string) (*entity.User, error)
+	PromoteToModerator(ctx context.Context, targetUserId string) (*entity.User, error)
+	PromoteToAdmin(ctx context.Context, targetUserId string) (*entity.User, error)
+	PromoteToExpert(ctx context.Context, targetUserId string) (*entity.User, error)
+	DemoteToUser(ctx context.Context, targetUserId string) (*entity.User, error)
+	DeleteAccount(ctx context.Context, targetUserId string) error
+	ListUsers(ctx context.Context, filter entity.UserFilter) ([]*entity.User, int, error)
+	BanUser(ctx context.Context, targetUserId string, ban entity.Ban) error
+	UnbanUser(ctx context.Context, targetUserId, reason, details, actorId string) error
+	CheckAccount(ctx context.Context, userId string) error
+	MarkEmailVerified(ctx context.Context, userId, email string, at time.Time) error
+	GetUserStats(ctx context.Context) (*entity.UserStats, error)
+	RefreshToken(ctx context.Context, refreshToken string, client entity.ClientInfo) (*entity.User, *entity.Tokens, error)
+	Logout(ctx context.Context, userId, sessionId string) error
+	LogoutAllSessions(ctx context.Context, userId string) (int, error)
+	ListSessions(ctx context.Context, userId string) ([]*entity.Session, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) (int, error)
+	ChangePassword(ctx context.Context, userId, currentPassword, newPassword string) (int, error)
 }
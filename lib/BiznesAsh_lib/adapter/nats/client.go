@@ -0,0 +1,24 @@
+package nats
+
+import (
+	"log/slog"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/config"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/nats-io/nats.go"
+)
+
+func NewConnection(cfg config.NATS) *nats.Conn {
+	opts := []nats.Option{
+		nats.MaxReconnects(cfg.MaxReconnects),
+		nats.Timeout(cfg.Timeout),
+	}
+
+	conn, err := nats.Connect(cfg.URL, opts...)
+	if err != nil {
+		logging.Fatal("failed to connect to NATS", logging.Err(err))
+	}
+
+	slog.Info("connected to NATS", "url", cfg.URL)
+	return conn
+}
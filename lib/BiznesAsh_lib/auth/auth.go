@@ -0,0 +1,181 @@
+// Package auth authenticates callers by their access token. Tokens are signed
+// by UserService and verified here with the public keys it publishes, so
+// verifiers hold no signing secret. The gateway verifies the Bearer token of
+// each HTTP request and forwards it in the authorization metadata; the gRPC
+// services verify it again with UnaryServerInterceptor, so a caller reaching a
+// service directly can't claim to be someone else.
+package auth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/jwks"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	userpb "github.com/KaminurOrynbek/BiznesAsh_lib/proto/auto-proto/user"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataAuthorization carries the caller's "Bearer <token>" to the services.
+const MetadataAuthorization = "authorization"
+
+var (
+	ErrInvalidToken = errors.New("invalid access token")
+	ErrRevokedToken = errors.New("access token revoked")
+)
+
+// Revocations tells revoked access tokens apart, as denylist.Denylist does.
+type Revocations interface {
+	Contains(ctx context.Context, jti string) (bool, error)
+}
+
+// Claims are the parts of an access token verifiers use.
+type Claims struct {
+	// ID is the token's jti, the key it is revoked under.
+	ID            string
+	UserID        string
+	Role          policy.Role
+	EmailVerified bool
+}
+
+// Identity is the caller the token was issued to.
+func (c Claims) Identity() policy.Identity {
+	return policy.Identity{UserID: c.UserID, Role: c.Role, Verified: c.EmailVerified}
+}
+
+// ParseToken verifies token against keys and returns its claims. The token
+// must be signed with a published key and the alg that key is published for,
+// and must not have expired.
+func ParseToken(ctx context.Context, keys *jwks.Cache, token string) (Claims, error) {
+	parsed, err := jwt.Parse(token, keys.Keyfunc(ctx),
+		jwt.WithValidMethods(jwks.Algorithms),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || !parsed.Valid {
+		return Claims{}, ErrInvalidToken
+	}
+
+	claims, ok := parsed.Claims.(jwt.MapClaims)
+	if !ok {
+		return Claims{}, ErrInvalidToken
+	}
+	userID, ok := claims["user_id"].(string)
+	if !ok || userID == "" {
+		return Claims{}, ErrInvalidToken
+	}
+	role, ok := claims["role"].(string)
+	if !ok {
+		return Claims{}, ErrInvalidToken
+	}
+
+	id, _ := claims["jti"].(string)
+	emailVerified, _ := claims["email_verified"].(bool)
+	return Claims{ID: id, UserID: userID, Role: policy.Role(role), EmailVerified: emailVerified}, nil
+}
+
+// Revoked reports whether the token with id tokenID is on revoked. With a nil
+// revoked, or when it can't be reached, tokens count as live until they
+// expire.
+func Revoked(ctx context.Context, revoked Revocations, tokenID string) bool {
+	if revoked == nil || tokenID == "" {
+		return false
+	}
+	denied, err := revoked.Contains(ctx, tokenID)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to check access token denylist", logging.Err(err))
+		return false
+	}
+	return denied
+}
+
+// JWKSFetcher fetches the token verification keys from UserService.
+func JWKSFetcher(client userpb.UserServiceClient) jwks.Fetcher {
+	return func(ctx context.Context) (jwks.Set, error) {
+		resp, err := client.GetJWKS(ctx, &userpb.Empty{})
+		if err != nil {
+			return jwks.Set{}, err
+		}
+		set := jwks.Set{Keys: make([]jwks.Key, 0, len(resp.GetKeys()))}
+		for _, k := range resp.GetKeys() {
+			set.Keys = append(set.Keys, jwks.Key{
+				Kty: k.GetKty(),
+				Kid: k.GetKid(),
+				Alg: k.GetAlg(),
+				Use: k.GetUse(),
+				Crv: k.GetCrv(),
+				X:   k.GetX(),
+				N:   k.GetN(),
+				E:   k.GetE(),
+			})
+		}
+		return set, nil
+	}
+}
+
+// NewKeyCache caches the keys published by UserService at addr. Nothing is
+// fetched until the first token is verified.
+func NewKeyCache(addr string) (*jwks.Cache, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()), tracing.DialOption())
+	if err != nil {
+		return nil, err
+	}
+	return jwks.NewCache(JWKSFetcher(userpb.NewUserServiceClient(conn)), jwks.DefaultMaxAge), nil
+}
+
+type identityKey struct{}
+
+// UnaryServerInterceptor verifies the Bearer token in the authorization
+// metadata and makes the caller it names available to Identity. Calls without
+// a token are anonymous, leaving it to the policy whether they may proceed;
+// calls with a token that doesn't verify or was revoked fail with
+// Unauthenticated. revoked may be nil.
+func UnaryServerInterceptor(keys *jwks.Cache, revoked Revocations) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		token, ok := bearerToken(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+		claims, err := ParseToken(ctx, keys, token)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if Revoked(ctx, revoked, claims.ID) {
+			return nil, status.Error(codes.Unauthenticated, ErrRevokedToken.Error())
+		}
+		return handler(WithIdentity(ctx, claims.Identity()), req)
+	}
+}
+
+// WithIdentity returns a copy of ctx in which Identity finds id. It is for
+// services that authenticate callers themselves, as UserService does.
+func WithIdentity(ctx context.Context, id policy.Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// Identity returns the caller authenticated by UnaryServerInterceptor, or the
+// zero Identity for anonymous calls. It is a policy.IdentityFunc.
+func Identity(ctx context.Context) policy.Identity {
+	id, _ := ctx.Value(identityKey{}).(policy.Identity)
+	return id
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md.Get(MetadataAuthorization)
+	if len(values) == 0 || !strings.HasPrefix(values[0], "Bearer ") {
+		return "", false
+	}
+	return strings.TrimPrefix(values[0], "Bearer "), true
+}
@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/denylist"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/jwks"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/policy"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testKid = "test"
+
+func newSigner(t *testing.T) (ed25519.PrivateKey, *jwks.Cache) {
+	t.Helper()
+	pub, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := jwks.NewKey(testKid, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := jwks.NewCache(func(context.Context) (jwks.Set, error) {
+		return jwks.Set{Keys: []jwks.Key{key}}, nil
+	}, jwks.DefaultMaxAge)
+	return private, cache
+}
+
+func sign(t *testing.T, key ed25519.PrivateKey, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = testKid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	key, cache := newSigner(t)
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := func(jti string, exp time.Duration) jwt.MapClaims {
+		return jwt.MapClaims{
+			"jti":            jti,
+			"user_id":        "u1",
+			"role":           "moderator",
+			"email_verified": true,
+			"exp":            time.Now().Add(exp).Unix(),
+		}
+	}
+	revoked := denylist.NewMemory()
+	if err := revoked.Add(context.Background(), "revoked", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		want     policy.Identity
+		wantCode codes.Code
+	}{
+		{
+			name: "no token is anonymous",
+			md:   metadata.Pairs(),
+		},
+		{
+			name: "identity headers without a token are ignored",
+			md:   metadata.Pairs("x-user-id", "admin-1", "x-user-role", "admin"),
+		},
+		{
+			name: "valid token",
+			md:   metadata.Pairs(MetadataAuthorization, "Bearer "+sign(t, key, claims("live", time.Minute))),
+			want: policy.Identity{UserID: "u1", Role: policy.RoleModerator, Verified: true},
+		},
+		{
+			name:     "token signed with another key",
+			md:       metadata.Pairs(MetadataAuthorization, "Bearer "+sign(t, otherKey, claims("live", time.Minute))),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "expired token",
+			md:       metadata.Pairs(MetadataAuthorization, "Bearer "+sign(t, key, claims("live", -time.Minute))),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "revoked token",
+			md:       metadata.Pairs(MetadataAuthorization, "Bearer "+sign(t, key, claims("revoked", time.Minute))),
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	interceptor := UnaryServerInterceptor(cache, revoked)
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Call"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got policy.Identity
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			_, err := interceptor(ctx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+				got = Identity(ctx)
+				return nil, nil
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("code = %v, want %v (err %v)", code, tt.wantCode, err)
+			}
+			if got != tt.want {
+				t.Errorf("identity = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
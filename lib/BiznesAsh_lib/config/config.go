@@ -0,0 +1,371 @@
+// Package config loads each service's typed configuration. Values come from
+// three layers, later ones winning: the defaults in the struct tags, config
+// files (.env in the working directory, then CONFIG_FILE or --config), and the
+// process environment. The result is validated as a whole, so a misconfigured
+// service reports every problem at once and exits before it starts serving.
+//
+// Fields are described with struct tags:
+//
+//	Port     string `env:"GRPC_PORT" default:"8081" validate:"port"`
+//	URL      string `env:"DATABASE_URL,POSTGRES_DSN" secret:"true"`
+//	Level    string `env:"LOG_LEVEL" default:"info" validate:"oneof=debug info warn error"`
+//
+// env lists the variable and, after it, deprecated aliases still accepted.
+// Values of secret fields are never printed. Struct fields without an env tag
+// are sections and are loaded recursively; a section or service struct can
+// check rules spanning several fields by implementing Validator.
+//
+// Files use the .env syntax, or JSON for a .json file holding an object keyed
+// by variable name. Their values are also exported to the environment, without
+// overriding it, so packages that read their own variables (LOG_LEVEL,
+// OTEL_TRACES_EXPORTER, ...) see them as they did with godotenv.
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/joho/godotenv"
+)
+
+// EnvFile names a config file layered over .env, like the --config flag.
+const EnvFile = "CONFIG_FILE"
+
+// DefaultFile is the config file read from the working directory when present.
+const DefaultFile = ".env"
+
+// Sources reported by Print.
+const (
+	SourceDefault = "default"
+	SourceEnv     = "env"
+)
+
+// Validator is implemented by configs with rules that span several fields.
+type Validator interface {
+	Validate() error
+}
+
+// Field is one resolved setting.
+type Field struct {
+	Key    string
+	Value  string
+	Secret bool
+	// Source is SourceDefault, SourceEnv or the path of the file that set it,
+	// noting the deprecated name when an alias was used.
+	Source string
+}
+
+// Error lists every problem found while loading a config.
+type Error struct {
+	Problems []string
+}
+
+func (e *Error) Error() string {
+	return "invalid configuration:\n  " + strings.Join(e.Problems, "\n  ")
+}
+
+// MustLoad is Load for main functions. It reads the --config and --print-config
+// flags: with --print-config it prints the effective configuration of service,
+// secrets redacted, and exits. An invalid configuration is reported on stderr
+// and exits with status 1.
+func MustLoad(service string, cfg any) {
+	flags := flag.NewFlagSet(service, flag.ExitOnError)
+	file := flags.String("config", "", "config file layered over .env and under the environment")
+	printConfig := flags.Bool("print-config", false, "print the effective configuration, secrets redacted, and exit")
+	flags.Parse(os.Args[1:])
+
+	files := []string{DefaultFile}
+	if f := os.Getenv(EnvFile); f != "" {
+		files = append(files, f)
+	}
+	if *file != "" {
+		files = append(files, *file)
+	}
+
+	fields, err := Load(cfg, files...)
+	if *printConfig {
+		fmt.Printf("# %s configuration\n", service)
+		Print(os.Stdout, fields)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", service, err)
+		os.Exit(1)
+	}
+	if *printConfig {
+		os.Exit(0)
+	}
+}
+
+// Load fills cfg, a pointer to a struct, from its defaults, the files in
+// order and the environment, then validates it. Missing files are skipped,
+// except when named explicitly by the caller after the first. The returned
+// fields describe every setting even when validation fails.
+func Load(cfg any, files ...string) ([]Field, error) {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("config: Load needs a pointer to a struct")
+	}
+
+	var problems []string
+	fileValues := map[string]string{}
+	fileSource := map[string]string{}
+	for i, path := range files {
+		values, err := readFile(path)
+		if err != nil {
+			if i == 0 && errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			problems = append(problems, fmt.Sprintf("%s: %v", path, err))
+			continue
+		}
+		for key, value := range values {
+			fileValues[key] = value
+			fileSource[key] = path
+		}
+	}
+
+	l := &loader{files: fileValues, sources: fileSource}
+	l.load(v.Elem())
+	problems = append(problems, l.problems...)
+
+	for key, value := range fileValues {
+		if _, ok := os.LookupEnv(key); !ok {
+			os.Setenv(key, value)
+		}
+	}
+
+	if len(problems) > 0 {
+		return l.fields, &Error{Problems: problems}
+	}
+	return l.fields, nil
+}
+
+// Print writes fields as KEY=value lines followed by their source. Secret
+// values are replaced unless empty, so a missing secret is still visible.
+func Print(w io.Writer, fields []Field) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, f := range fields {
+		value := f.Value
+		if f.Secret && value != "" {
+			value = logging.Redacted
+		}
+		fmt.Fprintf(tw, "%s=%s\t# %s\n", f.Key, value, f.Source)
+	}
+	tw.Flush()
+}
+
+func readFile(path string) (map[string]string, error) {
+	if !strings.HasSuffix(path, ".json") {
+		return godotenv.Read(path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(raw))
+	for key, value := range raw {
+		values[key] = fmt.Sprint(value)
+	}
+	return values, nil
+}
+
+type loader struct {
+	files    map[string]string
+	sources  map[string]string
+	fields   []Field
+	problems []string
+}
+
+var (
+	durationType  = reflect.TypeOf(time.Duration(0))
+	validatorType = reflect.TypeOf((*Validator)(nil)).Elem()
+)
+
+func (l *loader) load(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf, fv := t.Field(i), v.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		names, ok := sf.Tag.Lookup("env")
+		if !ok {
+			if fv.Kind() == reflect.Struct {
+				l.load(fv)
+			}
+			continue
+		}
+		l.loadField(sf, fv, strings.Split(names, ","))
+	}
+
+	if v.CanAddr() && v.Addr().Type().Implements(validatorType) {
+		if err := v.Addr().Interface().(Validator).Validate(); err != nil {
+			l.problems = append(l.problems, err.Error())
+		}
+	}
+}
+
+func (l *loader) loadField(sf reflect.StructField, fv reflect.Value, names []string) {
+	key := names[0]
+	value, source := sf.Tag.Get("default"), SourceDefault
+	if name, v, ok := l.lookup(names); ok {
+		value, source = v, SourceEnv
+		if _, inEnv := os.LookupEnv(name); !inEnv {
+			source = l.sources[name]
+		}
+		if name != key {
+			source += " (deprecated " + name + ")"
+		}
+	}
+
+	secret := sf.Tag.Get("secret") == "true"
+	l.fields = append(l.fields, Field{Key: key, Value: value, Secret: secret, Source: source})
+	if err := set(fv, value); err != nil {
+		l.problems = append(l.problems, problem(key, err, secret))
+		return
+	}
+	if rules := sf.Tag.Get("validate"); rules != "" {
+		for _, rule := range strings.Split(rules, ",") {
+			if err := check(rule, value); err != nil {
+				l.problems = append(l.problems, problem(key, err, secret))
+			}
+		}
+	}
+}
+
+// problem describes an invalid field, leaving out the value of a secret one.
+func problem(key string, err error, secret bool) string {
+	var bad *badValue
+	if secret && errors.As(err, &bad) {
+		return key + ": " + bad.reason
+	}
+	return fmt.Sprintf("%s: %v", key, err)
+}
+
+// lookup returns the first of names set in the environment, then in the files.
+func (l *loader) lookup(names []string) (string, string, bool) {
+	for _, name := range names {
+		if value, ok := os.LookupEnv(name); ok {
+			return name, value, true
+		}
+	}
+	for _, name := range names {
+		if value, ok := l.files[name]; ok {
+			return name, value, true
+		}
+	}
+	return "", "", false
+}
+
+func set(fv reflect.Value, value string) error {
+	if fv.Type() == durationType {
+		if value == "" {
+			fv.SetInt(0)
+			return nil
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return &badValue{"must be a duration such as 5s or 1m", value}
+		}
+		fv.SetInt(int64(d))
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(value)
+	case reflect.Bool:
+		if value == "" {
+			fv.SetBool(false)
+			return nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return &badValue{"must be true or false", value}
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		if value == "" {
+			fv.SetInt(0)
+			return nil
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return &badValue{"must be an integer", value}
+		}
+		fv.SetInt(n)
+	case reflect.Slice:
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		fv.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported field type %s", fv.Type())
+	}
+	return nil
+}
+
+// check applies one validate rule: required, port, min=N or oneof=a b c.
+// Rules other than required accept an empty value.
+func check(rule, value string) error {
+	name, arg, _ := strings.Cut(rule, "=")
+	if name == "required" {
+		if value == "" {
+			return errors.New("is required")
+		}
+		return nil
+	}
+	if value == "" {
+		return nil
+	}
+
+	switch name {
+	case "port":
+		if n, err := strconv.Atoi(value); err != nil || n < 1 || n > 65535 {
+			return &badValue{"must be a port number between 1 and 65535", value}
+		}
+	case "min":
+		min, _ := strconv.Atoi(arg)
+		if n, err := strconv.Atoi(value); err != nil || n < min {
+			return &badValue{fmt.Sprintf("must be at least %d", min), value}
+		}
+	case "oneof":
+		allowed := strings.Fields(arg)
+		for _, a := range allowed {
+			if value == a {
+				return nil
+			}
+		}
+		return &badValue{"must be one of " + strings.Join(allowed, ", "), value}
+	default:
+		return fmt.Errorf("unknown validate rule %q", rule)
+	}
+	return nil
+}
+
+// badValue is a value a field rejects, with the reason.
+type badValue struct {
+	reason string
+	value  string
+}
+
+func (e *badValue) Error() string {
+	return fmt.Sprintf("%s, got %q", e.reason, e.value)
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Postgres locates the database, either as a URL or as separate parts.
+type Postgres struct {
+	// URL wins over the parts when set. POSTGRES_DSN is its old name.
+	URL      string `env:"DATABASE_URL,POSTGRES_DSN" secret:"true"`
+	Host     string `env:"POSTGRES_HOST" default:"localhost"`
+	Port     string `env:"POSTGRES_PORT" default:"5432" validate:"port"`
+	User     string `env:"POSTGRES_USER" default:"postgres"`
+	Password string `env:"POSTGRES_PASSWORD" default:"0000" secret:"true"`
+	DBName   string `env:"POSTGRES_DB" default:"biznesAsh"`
+	SSLMode  string `env:"POSTGRES_SSLMODE" default:"disable" validate:"oneof=disable allow prefer require verify-ca verify-full"`
+}
+
+// DSN is the connection string for lib/pq.
+func (c Postgres) DSN() string {
+	if c.URL != "" {
+		return c.URL
+	}
+	return fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		c.Host, c.Port, c.User, c.Password, c.DBName, c.SSLMode,
+	)
+}
+
+// Redis locates the Redis server.
+type Redis struct {
+	Addr     string `env:"REDIS_ADDR" default:"localhost:6379"`
+	Password string `env:"REDIS_PASSWORD" secret:"true"`
+	DB       int    `env:"REDIS_DB" default:"0" validate:"min=0"`
+}
+
+// NATS locates the NATS server.
+type NATS struct {
+	URL           string        `env:"NATS_URL" default:"nats://localhost:4222" validate:"required"`
+	MaxReconnects int           `env:"NATS_MAX_RECONNECTS" default:"60"`
+	Timeout       time.Duration `env:"NATS_TIMEOUT" default:"2s"`
+}
+
+// SMTP is the mail server notifications are sent through.
+type SMTP struct {
+	Host     string `env:"SMTP_HOST" default:"smtp.gmail.com"`
+	Port     string `env:"SMTP_PORT" default:"587" validate:"port"`
+	Username string `env:"SMTP_USERNAME"`
+	Password string `env:"SMTP_PASSWORD" secret:"true"`
+}
+
+// JWT locates the private keys UserService signs access tokens with: one PEM
+// file per key in KeysDir (RSA or Ed25519, PKCS #8), named <kid>.pem. Tokens
+// are signed with SigningKeyID, or else the key whose id sorts last; every key
+// in the directory is published for verification. KeysDir is required unless
+// DevKey is set, which generates a key at startup instead; that suits a single
+// local instance only, since every restart signs everyone out.
+type JWT struct {
+	KeysDir      string `env:"JWT_KEYS_DIR"`
+	SigningKeyID string `env:"JWT_SIGNING_KEY_ID"`
+	DevKey       bool   `env:"JWT_DEV_KEY" default:"false"`
+}
+
+func (j *JWT) Validate() error {
+	if j.KeysDir == "" && !j.DevKey {
+		return errors.New("JWT_KEYS_DIR is required; set JWT_DEV_KEY=true to sign with a generated key in local development")
+	}
+	return nil
+}
+
+// Sessions sets the lifetimes of the tokens UserService issues. Access tokens
+// are verified without a lookup, so their lifetime bounds how long a revoked
+// token works where the denylist isn't checked; refresh tokens are stored and
+// replaced on every use, each use extending the session by RefreshTTL.
+// PasswordResetTTL is how long an emailed password reset token works.
+type Sessions struct {
+	AccessTTL        time.Duration `env:"ACCESS_TOKEN_TTL" default:"15m"`
+	RefreshTTL       time.Duration `env:"REFRESH_TOKEN_TTL" default:"720h"`
+	PasswordResetTTL time.Duration `env:"PASSWORD_RESET_TTL" default:"1h"`
+}
+
+func (s *Sessions) Validate() error {
+	if s.AccessTTL <= 0 || s.RefreshTTL <= s.AccessTTL {
+		return errors.New("ACCESS_TOKEN_TTL must be positive and shorter than REFRESH_TOKEN_TTL")
+	}
+	if s.PasswordResetTTL <= 0 {
+		return errors.New("PASSWORD_RESET_TTL must be positive")
+	}
+	return nil
+}
+
+// Telemetry is read by the logging and tracing packages from the environment;
+// it is declared here so it is validated and printed with the rest.
+type Telemetry struct {
+	LogLevel       string `env:"LOG_LEVEL" default:"info" validate:"oneof=debug info warn warning error"`
+	LogFormat      string `env:"LOG_FORMAT" default:"json" validate:"oneof=json text"`
+	TracesExporter string `env:"OTEL_TRACES_EXPORTER" default:"none"`
+	TracesFile     string `env:"OTEL_TRACES_FILE" default:"traces.jsonl"`
+	ServiceName    string `env:"OTEL_SERVICE_NAME"`
+}
@@ -0,0 +1,145 @@
+package config
+
+import "errors"
+
+// The configuration of each service. Ports default to the ones the gateway
+// and docker-compose expect.
+
+// APIGateway is the gateway's configuration. Per-service call tuning
+// (<SERVICE>_TIMEOUT, _RETRIES, _BREAKER_*), rate limits (RATE_LIMIT_<GROUP>)
+// and upload limits (MEDIA_MAX_*_BYTES) are optional overrides read by the
+// packages that own them.
+type APIGateway struct {
+	Port string `env:"PORT" default:"8080" validate:"port"`
+	// AllowedOrigins is a comma-separated list of origins allowed by CORS.
+	AllowedOrigins []string `env:"CORS_ALLOWED_ORIGINS,FRONTEND_URL" default:"http://localhost:5173"`
+	// TrustedProxies is a comma-separated list of proxy IPs or CIDRs whose
+	// X-Forwarded-For is believed. Without it the client IP rate limits are
+	// keyed by is the connection's peer address.
+	TrustedProxies []string `env:"TRUSTED_PROXIES"`
+	// ClientIPHeader names a header the hosting platform sets to the client's
+	// address on every request, such as CF-Connecting-IP. It wins over
+	// X-Forwarded-For, so only set it when clients can't send it themselves.
+	ClientIPHeader string `env:"CLIENT_IP_HEADER"`
+	Services       Services
+	Redis          Redis
+	NATSURL        string `env:"NATS_URL" default:"nats://localhost:4222"`
+	Media          Media
+	Telemetry      Telemetry
+}
+
+// Services are the gateway's dial targets.
+type Services struct {
+	User         string `env:"USER_SERVICE_URL" default:"localhost:8081" validate:"required"`
+	Content      string `env:"CONTENT_SERVICE_URL" default:"localhost:8082" validate:"required"`
+	Notification string `env:"NOTIFICATION_SERVICE_URL" default:"localhost:8083" validate:"required"`
+	Subscription string `env:"SUBSCRIPTION_SERVICE_URL" default:"localhost:8086" validate:"required"`
+	Payment      string `env:"PAYMENT_SERVICE_URL" default:"localhost:8087" validate:"required"`
+	Consultation string `env:"CONSULTATION_SERVICE_URL" default:"localhost:8088" validate:"required"`
+}
+
+// Media selects where uploads are stored: the local filesystem or an
+// S3-compatible bucket.
+type Media struct {
+	Store string `env:"MEDIA_STORE" default:"local" validate:"oneof=local s3"`
+	Dir   string `env:"MEDIA_DIR" default:"data/media"`
+	// PublicURL defaults to the gateway's own /blobs route.
+	PublicURL string `env:"MEDIA_PUBLIC_URL"`
+	// SigningSecret signs local download URLs. Without it a per-process key is
+	// used, so links break on restart and across replicas.
+	SigningSecret string `env:"MEDIA_SIGNING_SECRET" secret:"true"`
+	S3            S3
+}
+
+// S3 is the bucket used when MEDIA_STORE=s3.
+type S3 struct {
+	Endpoint        string `env:"MEDIA_S3_ENDPOINT"`
+	Region          string `env:"MEDIA_S3_REGION" default:"us-east-1"`
+	Bucket          string `env:"MEDIA_S3_BUCKET"`
+	AccessKeyID     string `env:"MEDIA_S3_ACCESS_KEY_ID"`
+	SecretAccessKey string `env:"MEDIA_S3_SECRET_ACCESS_KEY" secret:"true"`
+	PathStyle       bool   `env:"MEDIA_S3_PATH_STYLE" default:"false"`
+}
+
+func (m *Media) Validate() error {
+	if m.Store != "s3" {
+		return nil
+	}
+	if m.S3.Endpoint == "" || m.S3.Bucket == "" || m.S3.AccessKeyID == "" || m.S3.SecretAccessKey == "" {
+		return errors.New("MEDIA_STORE=s3 needs MEDIA_S3_ENDPOINT, MEDIA_S3_BUCKET, MEDIA_S3_ACCESS_KEY_ID and MEDIA_S3_SECRET_ACCESS_KEY")
+	}
+	return nil
+}
+
+// UserService is the user service's configuration.
+type UserService struct {
+	GRPCPort    string `env:"GRPC_PORT" default:"8081" validate:"port"`
+	MetricsPort string `env:"METRICS_PORT" default:"9101" validate:"port"`
+	Postgres    Postgres
+	// Redis holds the access token denylist.
+	Redis     Redis
+	NATS      NATS
+	JWT       JWT
+	Sessions  Sessions
+	Telemetry Telemetry
+}
+
+// ContentService is the content service's configuration.
+type ContentService struct {
+	GRPCPort    string `env:"GRPC_PORT" default:"8082" validate:"port"`
+	MetricsPort string `env:"METRICS_PORT" default:"9102" validate:"port"`
+	// UserServiceAddr publishes the keys access tokens are verified with.
+	UserServiceAddr string `env:"USER_SERVICE_ADDR" default:"localhost:8081" validate:"required"`
+	Postgres        Postgres
+	Redis           Redis
+	NATS            NATS
+	Telemetry       Telemetry
+}
+
+// NotificationService is the notification service's configuration.
+type NotificationService struct {
+	GRPCPort    string `env:"GRPC_PORT" default:"8083" validate:"port"`
+	MetricsPort string `env:"METRICS_PORT" default:"9103" validate:"port"`
+	// UserServiceAddr resolves notification recipients and publishes the keys
+	// access tokens are verified with.
+	UserServiceAddr string `env:"USER_SERVICE_ADDR" default:"localhost:8081" validate:"required"`
+	// SupportEmail receives contact form messages.
+	SupportEmail string `env:"SERVICE_EMAIL"`
+	// PasswordResetURL is the page password reset emails link to, with the
+	// token appended as ?token=. Without it the email only quotes the token.
+	PasswordResetURL string `env:"PASSWORD_RESET_URL"`
+	Postgres         Postgres
+	NATS             NATS
+	SMTP             SMTP
+	Telemetry        Telemetry
+}
+
+// SubscriptionService is the subscription service's configuration.
+type SubscriptionService struct {
+	GRPCPort    string `env:"GRPC_PORT" default:"8086" validate:"port"`
+	MetricsPort string `env:"METRICS_PORT" default:"9104" validate:"port"`
+	// UserServiceAddr publishes the keys access tokens are verified with.
+	UserServiceAddr string `env:"USER_SERVICE_ADDR" default:"localhost:8081" validate:"required"`
+	Postgres        Postgres
+	Telemetry       Telemetry
+}
+
+// PaymentService is the payment service's configuration.
+type PaymentService struct {
+	GRPCPort    string `env:"GRPC_PORT" default:"8087" validate:"port"`
+	MetricsPort string `env:"METRICS_PORT" default:"9105" validate:"port"`
+	// UserServiceAddr publishes the keys access tokens are verified with.
+	UserServiceAddr string `env:"USER_SERVICE_ADDR" default:"localhost:8081" validate:"required"`
+	Postgres        Postgres
+	Telemetry       Telemetry
+}
+
+// ConsultationService is the consultation service's configuration.
+type ConsultationService struct {
+	GRPCPort    string `env:"GRPC_PORT" default:"8088" validate:"port"`
+	MetricsPort string `env:"METRICS_PORT" default:"9106" validate:"port"`
+	// UserServiceAddr publishes the keys access tokens are verified with.
+	UserServiceAddr string `env:"USER_SERVICE_ADDR" default:"localhost:8081" validate:"required"`
+	Postgres        Postgres
+	Telemetry       Telemetry
+}
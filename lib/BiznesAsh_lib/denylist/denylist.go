@@ -0,0 +1,84 @@
+// Package denylist records access tokens revoked before they expire. Tokens
+// are keyed by their id (the jti claim) and kept only until they would have
+// expired anyway, so the list stays as small as the number of live revoked
+// tokens. UserService writes to it on logout, ban and deletion; every verifier
+// of access tokens reads it.
+package denylist
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const keyPrefix = "denylist:jti:"
+
+// Denylist holds the ids of revoked access tokens.
+type Denylist interface {
+	// Add revokes the token with id jti for ttl, its remaining lifetime.
+	Add(ctx context.Context, jti string, ttl time.Duration) error
+	// Contains reports whether the token with id jti has been revoked.
+	Contains(ctx context.Context, jti string) (bool, error)
+}
+
+// Redis shares the denylist between the services and their replicas.
+type Redis struct {
+	client *redis.Client
+}
+
+func NewRedis(client *redis.Client) *Redis {
+	return &Redis{client: client}
+}
+
+func (r *Redis) Add(ctx context.Context, jti string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	return r.client.Set(ctx, keyPrefix+jti, 1, ttl).Err()
+}
+
+func (r *Redis) Contains(ctx context.Context, jti string) (bool, error) {
+	n, err := r.client.Exists(ctx, keyPrefix+jti).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Memory is a single-process denylist for local runs without Redis. Tokens it
+// holds are only refused by the process that revoked them.
+type Memory struct {
+	mu      sync.Mutex
+	expires map[string]time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{expires: make(map[string]time.Time)}
+}
+
+func (m *Memory) Add(_ context.Context, jti string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for id, exp := range m.expires {
+		if now.After(exp) {
+			delete(m.expires, id)
+		}
+	}
+	m.expires[jti] = now.Add(ttl)
+	return nil
+}
+
+func (m *Memory) Contains(_ context.Context, jti string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	exp, ok := m.expires[jti]
+	return ok && time.Now().Before(exp), nil
+}
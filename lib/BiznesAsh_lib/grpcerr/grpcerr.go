@@ -0,0 +1,82 @@
+// Package grpcerr lets services classify domain errors once and have the
+// delivery layer turn them into gRPC statuses with the matching code.
+package grpcerr
+
+import (
+	"database/sql"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Sentinel errors. Wrap them with fmt.Errorf("...: %w", ErrX) (or errors.Wrap)
+// to give an error a gRPC code; anything unclassified becomes codes.Internal.
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+)
+
+var sentinels = []struct {
+	err  error
+	code codes.Code
+}{
+	{ErrNotFound, codes.NotFound},
+	{sql.ErrNoRows, codes.NotFound},
+	{ErrAlreadyExists, codes.AlreadyExists},
+	{ErrInvalidArgument, codes.InvalidArgument},
+	{ErrFailedPrecondition, codes.FailedPrecondition},
+	{ErrPermissionDenied, codes.PermissionDenied},
+	{ErrUnauthenticated, codes.Unauthenticated},
+}
+
+// Code classifies err. A gRPC status anywhere in the chain keeps its code, so
+// errors from downstream calls pass through unchanged.
+func Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	for _, s := range sentinels {
+		if errors.Is(err, s.err) {
+			return s.code
+		}
+	}
+	var se interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &se) {
+		return se.GRPCStatus().Code()
+	}
+	return codes.Internal
+}
+
+// Wrap returns a status error with err's code. Clients only get msg, since
+// err may describe queries, hosts or other internals; the error itself reads
+// "msg: err", so the server's call log still shows the cause.
+func Wrap(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	return &wrapped{status: status.New(Code(err), msg), cause: err}
+}
+
+// wrapped is the error Wrap returns. gRPC sends the status it carries, not
+// its Error text.
+type wrapped struct {
+	status *status.Status
+	cause  error
+}
+
+func (e *wrapped) Error() string {
+	return e.status.Message() + ": " + e.cause.Error()
+}
+
+func (e *wrapped) GRPCStatus() *status.Status {
+	return e.status
+}
+
+func (e *wrapped) Unwrap() error {
+	return e.cause
+}
@@ -0,0 +1,79 @@
+// Package health implements the standard gRPC health-checking protocol for the
+// services, with the serving status driven by their dependencies (Postgres,
+// Redis, NATS).
+package health
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	checkInterval = 10 * time.Second
+	checkTimeout  = 2 * time.Second
+)
+
+// Check probes one dependency. A nil error means it is reachable.
+type Check struct {
+	Name  string
+	Probe func(ctx context.Context) error
+}
+
+// Postgres checks that db answers a ping.
+func Postgres(db *sql.DB) Check {
+	return Check{Name: "postgres", Probe: db.PingContext}
+}
+
+// Redis checks that the client answers a ping.
+func Redis(client interface{ Ping(ctx context.Context) error }) Check {
+	return Check{Name: "redis", Probe: client.Ping}
+}
+
+// NATS checks that conn is currently connected.
+func NATS(conn *nats.Conn) Check {
+	return Check{Name: "nats", Probe: func(context.Context) error {
+		if !conn.IsConnected() {
+			return errors.New(conn.Status().String())
+		}
+		return nil
+	}}
+}
+
+// Register adds the grpc.health.v1.Health service to s and keeps its status up to
+// date: both the overall status ("") and service report SERVING only while every
+// check passes. Answering the Check RPC at all is the liveness signal.
+func Register(s *grpc.Server, service string, checks ...Check) {
+	server := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(s, server)
+
+	update := func() {
+		status := healthpb.HealthCheckResponse_SERVING
+		for _, check := range checks {
+			ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+			err := check.Probe(ctx)
+			cancel()
+			if err != nil {
+				slog.Warn("health check failed", "check", check.Name, logging.Err(err))
+				status = healthpb.HealthCheckResponse_NOT_SERVING
+			}
+		}
+		server.SetServingStatus("", status)
+		server.SetServingStatus(service, status)
+	}
+
+	update()
+	go func() {
+		for range time.Tick(checkInterval) {
+			update()
+		}
+	}()
+}
@@ -0,0 +1,174 @@
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultMaxAge is how long a fetched key set is used before it is refetched.
+const DefaultMaxAge = 10 * time.Minute
+
+// MinRefreshInterval spaces out fetches for unknown key ids after a
+// successful one, so tokens with made-up key ids can't turn every request into
+// a call to the key source. Failed fetches aren't throttled. A key source
+// should publish a new key at least this long before signing with it.
+const MinRefreshInterval = 30 * time.Second
+
+// fetchTimeout bounds a fetch. Fetches don't run under the context of the
+// request that triggered them, so a canceled request doesn't fail it for the
+// others waiting on it.
+const fetchTimeout = 5 * time.Second
+
+// ErrUnknownKey is returned for a key id the key source doesn't publish.
+var ErrUnknownKey = errors.New("jwks: unknown key id")
+
+// Fetcher returns the key set currently published.
+type Fetcher func(ctx context.Context) (Set, error)
+
+// Cache holds the published keys for verifiers. It refetches them when they
+// are older than the max age and when a token names a key it doesn't know
+// yet, which is how a newly rotated key is picked up. If a fetch fails the
+// keys already known keep working.
+//
+// One fetch runs at a time and the lock isn't held while it does. Callers
+// needing a key the cache doesn't have wait for it; a stale set is refreshed
+// in the background and used meanwhile.
+type Cache struct {
+	fetch  Fetcher
+	maxAge time.Duration
+
+	mu        sync.Mutex
+	set       Set
+	keys      map[string]publicKey
+	fetchedAt time.Time
+	// fetching is closed when the fetch in flight finishes; nil when idle.
+	fetching chan struct{}
+}
+
+type publicKey struct {
+	key crypto.PublicKey
+	alg string
+}
+
+func NewCache(fetch Fetcher, maxAge time.Duration) *Cache {
+	return &Cache{fetch: fetch, maxAge: maxAge, keys: map[string]publicKey{}}
+}
+
+// Lookup returns the key with id kid and the alg it signs with.
+func (c *Cache) Lookup(ctx context.Context, kid string) (crypto.PublicKey, string, error) {
+	c.mu.Lock()
+	k, ok := c.keys[kid]
+	switch {
+	case !ok && time.Since(c.fetchedAt) >= MinRefreshInterval:
+		done := c.startFetch(ctx)
+		c.mu.Unlock()
+		wait(ctx, done)
+		c.mu.Lock()
+		k, ok = c.keys[kid]
+	case ok && time.Since(c.fetchedAt) > c.maxAge:
+		c.startFetch(ctx)
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		return nil, "", fmt.Errorf("%w %q", ErrUnknownKey, kid)
+	}
+	return k.key, k.alg, nil
+}
+
+// Set returns the key set, refetched first if none has been fetched yet. A
+// set older than the max age is returned while it is refetched.
+func (c *Cache) Set(ctx context.Context) (Set, error) {
+	c.mu.Lock()
+	if c.fetchedAt.IsZero() {
+		done := c.startFetch(ctx)
+		c.mu.Unlock()
+		wait(ctx, done)
+		c.mu.Lock()
+	} else if time.Since(c.fetchedAt) > c.maxAge {
+		c.startFetch(ctx)
+	}
+	defer c.mu.Unlock()
+
+	if c.fetchedAt.IsZero() {
+		return Set{}, errors.New("jwks: key set unavailable")
+	}
+	return c.set, nil
+}
+
+// Keyfunc returns a jwt.Keyfunc resolving the token's kid through the cache.
+// A token must be signed with the alg its key is published for.
+func (c *Cache) Keyfunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, errors.New("jwks: token has no kid")
+		}
+		key, alg, err := c.Lookup(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if token.Method.Alg() != alg {
+			return nil, fmt.Errorf("jwks: key %q signs %s, token uses %s", kid, alg, token.Method.Alg())
+		}
+		return key, nil
+	}
+}
+
+// startFetch starts fetching the key set unless a fetch is already running,
+// and returns a channel closed when the running fetch finishes. Callers hold
+// c.mu.
+func (c *Cache) startFetch(ctx context.Context) <-chan struct{} {
+	if c.fetching == nil {
+		c.fetching = make(chan struct{})
+		go c.refresh(context.WithoutCancel(ctx), c.fetching)
+	}
+	return c.fetching
+}
+
+// refresh fetches the key set and closes done. Keys that fail to decode are
+// skipped.
+func (c *Cache) refresh(ctx context.Context, done chan struct{}) {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	set, err := c.fetch(ctx)
+	var keys map[string]publicKey
+	if err == nil {
+		keys = make(map[string]publicKey, len(set.Keys))
+		for _, k := range set.Keys {
+			key, err := k.PublicKey()
+			if err != nil {
+				slog.WarnContext(ctx, "skipping token verification key", "kid", k.Kid, logging.Err(err))
+				continue
+			}
+			keys[k.Kid] = publicKey{key: key, alg: k.Alg}
+		}
+	} else {
+		slog.WarnContext(ctx, "failed to fetch token verification keys", logging.Err(err))
+	}
+
+	c.mu.Lock()
+	if err == nil {
+		c.set, c.keys, c.fetchedAt = set, keys, time.Now()
+	}
+	c.fetching = nil
+	c.mu.Unlock()
+	close(done)
+}
+
+// wait blocks until done is closed or ctx is done, whichever comes first.
+func wait(ctx context.Context, done <-chan struct{}) {
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
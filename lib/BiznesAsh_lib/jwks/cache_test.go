@@ -0,0 +1,133 @@
+package jwks
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testKey(t *testing.T, kid string) Key {
+	t.Helper()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := NewKey(kid, pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestCacheLookup(t *testing.T) {
+	key := testKey(t, "k1")
+	tests := []struct {
+		name      string
+		responses []error // one per fetch; nil serves the key
+		lookups   []string
+		wantErr   []bool
+		wantCalls int32
+	}{
+		{
+			name:      "known key is fetched once",
+			responses: []error{nil},
+			lookups:   []string{"k1", "k1"},
+			wantErr:   []bool{false, false},
+			wantCalls: 1,
+		},
+		{
+			name:      "unknown key is throttled after a successful fetch",
+			responses: []error{nil},
+			lookups:   []string{"k1", "k2", "k2"},
+			wantErr:   []bool{false, true, true},
+			wantCalls: 1,
+		},
+		{
+			name:      "failed fetch is retried at once",
+			responses: []error{errors.New("unavailable"), nil},
+			lookups:   []string{"k1", "k1"},
+			wantErr:   []bool{true, false},
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			cache := NewCache(func(context.Context) (Set, error) {
+				n := calls.Add(1)
+				if err := tt.responses[n-1]; err != nil {
+					return Set{}, err
+				}
+				return Set{Keys: []Key{key}}, nil
+			}, DefaultMaxAge)
+
+			for i, kid := range tt.lookups {
+				_, _, err := cache.Lookup(context.Background(), kid)
+				if (err != nil) != tt.wantErr[i] {
+					t.Fatalf("lookup %d of %q: err = %v, want error %v", i, kid, err, tt.wantErr[i])
+				}
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("fetches = %d, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCacheSharesOneFetch(t *testing.T) {
+	key := testKey(t, "k1")
+	release := make(chan struct{})
+	var calls atomic.Int32
+	cache := NewCache(func(context.Context) (Set, error) {
+		calls.Add(1)
+		<-release
+		return Set{Keys: []Key{key}}, nil
+	}, DefaultMaxAge)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := cache.Lookup(context.Background(), "k1"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("fetches = %d, want 1", got)
+	}
+}
+
+func TestCacheFetchOutlivesCanceledRequest(t *testing.T) {
+	key := testKey(t, "k1")
+	release := make(chan struct{})
+	cache := NewCache(func(ctx context.Context) (Set, error) {
+		<-release
+		if err := ctx.Err(); err != nil {
+			return Set{}, err
+		}
+		return Set{Keys: []Key{key}}, nil
+	}, DefaultMaxAge)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := cache.Lookup(ctx, "k1"); err == nil {
+		t.Fatal("lookup with a canceled context found the key before the fetch finished")
+	}
+	close(release)
+
+	if _, _, err := cache.Lookup(context.Background(), "k1"); err != nil {
+		t.Fatalf("lookup after the fetch finished: %v", err)
+	}
+}
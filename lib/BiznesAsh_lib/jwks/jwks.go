@@ -0,0 +1,102 @@
+// Package jwks describes the public keys access tokens are verified with, in
+// the JSON Web Key Set format (RFC 7517). UserService signs access tokens with
+// private keys only it holds and publishes the public halves; verifiers keep
+// them in a Cache and look them up by the token's kid header.
+package jwks
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Signing algorithms access tokens may use, as JWS alg names.
+const (
+	RS256 = "RS256"
+	EdDSA = "EdDSA"
+)
+
+// Algorithms lists every accepted alg. Tokens with any other alg, "none" and
+// the HMAC family included, are refused.
+var Algorithms = []string{RS256, EdDSA}
+
+// Set is a JSON Web Key Set.
+type Set struct {
+	Keys []Key `json:"keys"`
+}
+
+// Key is one public key. RSA keys carry N and E, Ed25519 keys Crv and X, all
+// base64url encoded without padding.
+type Key struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// NewKey describes pub, an *rsa.PublicKey or ed25519.PublicKey, under kid.
+func NewKey(kid string, pub crypto.PublicKey) (Key, error) {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		return Key{
+			Kty: "RSA",
+			Kid: kid,
+			Alg: RS256,
+			Use: "sig",
+			N:   encode(pub.N.Bytes()),
+			E:   encode(big.NewInt(int64(pub.E)).Bytes()),
+		}, nil
+	case ed25519.PublicKey:
+		return Key{Kty: "OKP", Kid: kid, Alg: EdDSA, Use: "sig", Crv: "Ed25519", X: encode(pub)}, nil
+	}
+	return Key{}, fmt.Errorf("jwks: unsupported key type %T", pub)
+}
+
+// PublicKey decodes k into an *rsa.PublicKey or ed25519.PublicKey.
+func (k Key) PublicKey() (crypto.PublicKey, error) {
+	switch {
+	case k.Kty == "RSA" && k.Alg == RS256:
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(k.E)
+		if err != nil {
+			return nil, err
+		}
+		exp := new(big.Int).SetBytes(e)
+		if len(n) == 0 || !exp.IsInt64() || exp.Int64() < 3 || exp.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("jwks: key %q: invalid RSA key", k.Kid)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+	case k.Kty == "OKP" && k.Crv == "Ed25519" && k.Alg == EdDSA:
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("jwks: key %q: invalid Ed25519 key", k.Kid)
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("jwks: key %q: unsupported kty %q with alg %q", k.Kid, k.Kty, k.Alg)
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decode(s string) ([]byte, error) {
+	if s == "" {
+		return nil, errors.New("jwks: missing key parameter")
+	}
+	return base64.RawURLEncoding.DecodeString(s)
+}
@@ -0,0 +1,100 @@
+// Package logging sets up the structured logger every service uses. Records are
+// written as JSON to stdout through log/slog, carry the service name and, when
+// the context has them, the request id and trace id, and pass through Redact so
+// credentials never reach the logs. The standard library log package is routed
+// through the same handler, so nothing bypasses redaction.
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Environment variables read by Init.
+const (
+	// EnvLevel is the minimum level: "debug", "info" (default), "warn" or "error".
+	EnvLevel = "LOG_LEVEL"
+	// EnvFormat is "json" (default) or "text", which is easier to read locally.
+	EnvFormat = "LOG_FORMAT"
+)
+
+// Attribute keys added to every record that has them.
+const (
+	KeyService   = "service"
+	KeyRequestID = "request_id"
+	KeyTraceID   = "trace_id"
+	KeyError     = "error"
+)
+
+// Init installs the logger for service as the slog and log default and returns it.
+func Init(service string) *slog.Logger {
+	logger := New(os.Stdout, service, parseLevel(os.Getenv(EnvLevel)), os.Getenv(EnvFormat) != "text")
+	slog.SetDefault(logger)
+	return logger
+}
+
+// New builds a logger writing to w. It is Init without the environment and
+// globals, for tools and tests.
+func New(w io.Writer, service string, level slog.Leveler, json bool) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: level, ReplaceAttr: redactAttr}
+	var h slog.Handler
+	if json {
+		h = slog.NewJSONHandler(w, opts)
+	} else {
+		h = slog.NewTextHandler(w, opts)
+	}
+	return slog.New(contextHandler{h}).With(KeyService, service)
+}
+
+// Fatal logs msg at error level and exits, for main functions that can't start.
+func Fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+// Err is the attribute for an error, under the same key everywhere.
+func Err(err error) slog.Attr {
+	return slog.Any(KeyError, err)
+}
+
+func parseLevel(s string) slog.Level {
+	switch strings.ToLower(s) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	}
+	return slog.LevelInfo
+}
+
+// contextHandler adds the request id and trace id found in the context.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ctx != nil {
+		if id := RequestID(ctx); id != "" {
+			r.AddAttrs(slog.String(KeyRequestID, id))
+		}
+		if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+			r.AddAttrs(slog.String(KeyTraceID, sc.TraceID().String()))
+		}
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,74 @@
+package logging
+
+import (
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces every value the redaction policy removes.
+const Redacted = "[REDACTED]"
+
+// sensitiveKeys are attribute keys whose values are never logged, matched
+// case-insensitively against the whole key after dropping '_' and '-'.
+var sensitiveKeys = map[string]bool{
+	"password":         true,
+	"newpassword":      true,
+	"oldpassword":      true,
+	"currentpassword":  true,
+	"token":            true,
+	"accesstoken":      true,
+	"refreshtoken":     true,
+	"resettoken":       true,
+	"authorization":    true,
+	"cookie":           true,
+	"secret":           true,
+	"code":             true,
+	"verificationcode": true,
+	"otp":              true,
+}
+
+// sensitiveValues match credentials inside free text: bearer tokens, JWTs,
+// JSON members and key=value pairs named like a password, token or secret, and
+// numeric verification codes. Plain "token: ..." prose is left alone so error
+// messages such as "invalid token: expired" stay readable.
+var sensitiveValues = []struct {
+	pattern *regexp.Regexp
+	replace string
+}{
+	{regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9\-._~+/]+=*`), "Bearer " + Redacted},
+	{regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`), Redacted},
+	{regexp.MustCompile(`(?i)("[a-z_]*(?:password|token|secret)"\s*:\s*)("[^"]*"|[^\s,}]+)`), "${1}\"" + Redacted + "\""},
+	{regexp.MustCompile(`(?i)(\b[a-z_]*(?:password|token|secret)=)[^\s&,;]+`), "${1}" + Redacted},
+	{regexp.MustCompile(`(?i)(\b(?:[a-z_]*code|otp)"?\s*[:=]\s*"?)\d{4,8}\b`), "${1}" + Redacted},
+}
+
+// Redact removes credentials from free text such as a message or an error.
+func Redact(s string) string {
+	for _, v := range sensitiveValues {
+		s = v.pattern.ReplaceAllString(s, v.replace)
+	}
+	return s
+}
+
+func sensitiveKey(key string) bool {
+	key = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	return sensitiveKeys[key]
+}
+
+// redactAttr is the handlers' ReplaceAttr: it drops the values of sensitive
+// keys and scrubs strings and errors, including the message.
+func redactAttr(_ []string, a slog.Attr) slog.Attr {
+	if sensitiveKey(a.Key) {
+		return slog.String(a.Key, Redacted)
+	}
+	switch a.Value.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, Redact(a.Value.String()))
+	case slog.KindAny:
+		if err, ok := a.Value.Any().(error); ok {
+			return slog.String(a.Key, Redact(err.Error()))
+		}
+	}
+	return a
+}
@@ -0,0 +1,60 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// MetadataRequestID is the gRPC metadata key and NATS header that carry the
+// request id between services.
+const MetadataRequestID = "x-request-id"
+
+// MaxRequestIDLength caps ids accepted from callers so they can't bloat logs.
+const MaxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// WithRequestID returns ctx carrying id.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request id carried by ctx, or "".
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// NewRequestID returns a random 128-bit id in hex.
+func NewRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Propagator carries the request id alongside the trace context. tracing.Init
+// installs it, so the id crosses every gRPC call and NATS message the trace
+// context does.
+type Propagator struct{}
+
+var _ propagation.TextMapPropagator = Propagator{}
+
+func (Propagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	if id := RequestID(ctx); id != "" {
+		carrier.Set(MetadataRequestID, id)
+	}
+}
+
+func (Propagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	if id := carrier.Get(MetadataRequestID); id != "" && len(id) <= MaxRequestIDLength {
+		return WithRequestID(ctx, id)
+	}
+	return ctx
+}
+
+func (Propagator) Fields() []string {
+	return []string{MetadataRequestID}
+}
@@ -0,0 +1,67 @@
+// Package metrics holds the Prometheus instrumentation shared by the services:
+// RED metrics for gRPC servers, database pool stats and the /metrics listener.
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcStarted = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_started_total",
+			Help: "Total number of unary RPCs started on the server.",
+		},
+		[]string{"grpc_service", "grpc_method"},
+	)
+	grpcHandled = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "Total number of unary RPCs completed on the server, by status code.",
+		},
+		[]string{"grpc_service", "grpc_method", "grpc_code"},
+	)
+	grpcDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Latency of unary RPCs handled by the server.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"grpc_service", "grpc_method"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(grpcStarted, grpcHandled, grpcDuration)
+}
+
+// UnaryServerInterceptor records request count, status codes and latency for every
+// unary RPC. Install it first in the chain so rejected calls are counted too.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		service, method := splitMethod(info.FullMethod)
+		grpcStarted.WithLabelValues(service, method).Inc()
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		grpcDuration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+		grpcHandled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+		return resp, err
+	}
+}
+
+// splitMethod turns "/pkg.Service/Method" into ("pkg.Service", "Method").
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"database/sql"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// RegisterDB exports connection pool stats (open, in use, idle, waits) for db,
+// labelled with dbName.
+func RegisterDB(dbName string, db *sql.DB) {
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// Serve exposes /metrics on METRICS_PORT, falling back to defaultPort, in the
+// background. A listener failure is logged but doesn't stop the service.
+func Serve(defaultPort string) {
+	port := os.Getenv("METRICS_PORT")
+	if port == "" {
+		port = defaultPort
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	go func() {
+		slog.Info("metrics available", "addr", "http://localhost:"+port+"/metrics")
+		if err := http.ListenAndServe(":"+port, mux); err != nil {
+			slog.Error("metrics listener stopped", logging.Err(err))
+		}
+	}()
+}
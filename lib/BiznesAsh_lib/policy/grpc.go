@@ -0,0 +1,59 @@
+package policy
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IdentityFunc extracts the caller from an incoming request context. It must
+// only trust what it has verified, such as auth.Identity after the access
+// token was checked, never identity sent along by the caller.
+type IdentityFunc func(ctx context.Context) Identity
+
+// UnaryServerInterceptor enforces p on every unary call, keyed by full method name.
+func UnaryServerInterceptor(p *Policy, identity IdentityFunc) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := p.Authorize(ctx, info.FullMethod, identity(ctx), req); err != nil {
+			return nil, toStatus(err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Uncovered lists the unary methods registered on s that Rules has no rule
+// for. Services check it at startup so an RPC can't ship without one.
+func Uncovered(s *grpc.Server) []string {
+	var missing []string
+	for name, info := range s.GetServiceInfo() {
+		for _, m := range info.Methods {
+			if m.IsClientStream || m.IsServerStream {
+				continue
+			}
+			key := "/" + name + "/" + m.Name
+			if _, ok := Rules[key]; !ok {
+				missing = append(missing, key)
+			}
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+func toStatus(err error) error {
+	switch err {
+	case ErrUnauthenticated:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case ErrForbidden, ErrUnverified, ErrNoRule:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case ErrNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, err.Error())
+}
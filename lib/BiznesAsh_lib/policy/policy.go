@@ -0,0 +1,134 @@
+// Package policy is the declarative authorization layer shared by the gateway
+// and the services. Rules are keyed by gRPC full method name
+// ("/user.UserService/BanUser") or by REST route ("DELETE /content/posts/:id").
+package policy
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrUnauthenticated = errors.New("authentication required")
+	ErrForbidden       = errors.New("insufficient permissions")
+	ErrNotFound        = errors.New("resource not found")
+	ErrUnverified      = errors.New("verify your email address first")
+	ErrNoRule          = errors.New("no access rule for this endpoint")
+)
+
+// Rule describes who may call an endpoint. Public endpoints are open to
+// anyone. Otherwise a caller is allowed when they hold one of Roles or pass
+// the named Owner check; a rule with neither only requires an authenticated
+// caller. Verified additionally requires the caller to have verified their
+// email address.
+type Rule struct {
+	Public   bool
+	Roles    []Role
+	Owner    string
+	Verified bool
+}
+
+// Identity is the authenticated caller.
+type Identity struct {
+	UserID   string
+	Role     Role
+	Verified bool
+}
+
+// OwnerCheck reports whether the caller owns the resource addressed by the
+// request. resource is the gRPC request message or the *gin.Context.
+type OwnerCheck func(ctx context.Context, id Identity, resource interface{}) (bool, error)
+
+type Policy struct {
+	rules  map[string]Rule
+	owners map[string]OwnerCheck
+}
+
+func New(rules map[string]Rule) *Policy {
+	p := &Policy{
+		rules:  rules,
+		owners: make(map[string]OwnerCheck),
+	}
+	p.RegisterOwner(OwnerSelf, selfCheck)
+	p.RegisterOwner(OwnerAuthor, authorCheck)
+	return p
+}
+
+// RegisterOwner binds the ownership check used by rules referencing name.
+func (p *Policy) RegisterOwner(name string, check OwnerCheck) {
+	p.owners[name] = check
+}
+
+// Public reports whether the rule for key lets anyone call it.
+func (p *Policy) Public(key string) bool {
+	return p.rules[key].Public
+}
+
+// Authorize checks id against the rule for key. Endpoints without a rule are
+// denied with ErrNoRule, so a new endpoint stays closed until it gets one.
+func (p *Policy) Authorize(ctx context.Context, key string, id Identity, resource interface{}) error {
+	rule, ok := p.rules[key]
+	if !ok {
+		return ErrNoRule
+	}
+	if rule.Public {
+		return nil
+	}
+	if id.UserID == "" {
+		return ErrUnauthenticated
+	}
+	if rule.Verified && !id.Verified {
+		return ErrUnverified
+	}
+	if len(rule.Roles) == 0 && rule.Owner == "" {
+		return nil
+	}
+
+	for _, r := range rule.Roles {
+		if id.Role == r {
+			return nil
+		}
+	}
+
+	if rule.Owner != "" {
+		check, ok := p.owners[rule.Owner]
+		if !ok {
+			return ErrForbidden
+		}
+		owned, err := check(ctx, id, resource)
+		if err != nil {
+			return err
+		}
+		if owned {
+			return nil
+		}
+	}
+
+	return ErrForbidden
+}
+
+// Route builds the rule key for a REST endpoint.
+func Route(method, path string) string {
+	return method + " " + path
+}
+
+// selfCheck passes when the request addresses the caller's own user id.
+func selfCheck(_ context.Context, id Identity, resource interface{}) (bool, error) {
+	r, ok := resource.(interface{ GetUserId() string })
+	if !ok {
+		return false, nil
+	}
+	return r.GetUserId() == id.UserID, nil
+}
+
+// authorCheck passes when the request names the caller as the author or owner
+// of what it creates.
+func authorCheck(_ context.Context, id Identity, resource interface{}) (bool, error) {
+	switch r := resource.(type) {
+	case interface{ GetAuthorId() string }:
+		return r.GetAuthorId() == id.UserID, nil
+	case interface{ GetOwnerId() string }:
+		return r.GetOwnerId() == id.UserID, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,67 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type userReq struct{ userID string }
+
+func (r userReq) GetUserId() string { return r.userID }
+
+type ownerReq struct{ ownerID string }
+
+func (r ownerReq) GetOwnerId() string { return r.ownerID }
+
+var errLookup = errors.New("lookup failed")
+
+func TestPolicyAuthorize(t *testing.T) {
+	p := New(map[string]Rule{
+		"public":    {Public: true},
+		"signed-in": {},
+		"verified":  {Verified: true},
+		"staff":     {Roles: []Role{RoleAdmin, RoleModerator}},
+		"self":      {Roles: []Role{RoleAdmin}, Owner: OwnerSelf},
+		"author":    {Owner: OwnerAuthor},
+		"unknown":   {Owner: "missing"},
+		"failing":   {Owner: "failing"},
+	})
+	p.RegisterOwner("failing", func(context.Context, Identity, interface{}) (bool, error) {
+		return false, errLookup
+	})
+
+	alice := Identity{UserID: "alice", Role: RoleUser}
+	tests := []struct {
+		name     string
+		key      string
+		id       Identity
+		resource interface{}
+		want     error
+	}{
+		{name: "endpoint without a rule is denied", key: "absent", id: Identity{UserID: "root", Role: RoleAdmin}, want: ErrNoRule},
+		{name: "public endpoint allows anonymous callers", key: "public"},
+		{name: "anonymous caller is rejected", key: "signed-in", want: ErrUnauthenticated},
+		{name: "any signed-in caller passes a rule without roles", key: "signed-in", id: alice},
+		{name: "unverified caller is rejected", key: "verified", id: alice, want: ErrUnverified},
+		{name: "verified caller passes", key: "verified", id: Identity{UserID: "alice", Verified: true}},
+		{name: "listed role passes", key: "staff", id: Identity{UserID: "mod", Role: RoleModerator}},
+		{name: "other role is forbidden", key: "staff", id: alice, want: ErrForbidden},
+		{name: "owner passes the self check", key: "self", id: alice, resource: userReq{"alice"}},
+		{name: "someone else fails the self check", key: "self", id: alice, resource: userReq{"bob"}, want: ErrForbidden},
+		{name: "role passes without owning the resource", key: "self", id: Identity{UserID: "root", Role: RoleAdmin}, resource: userReq{"bob"}},
+		{name: "resource without an id fails the self check", key: "self", id: alice, resource: struct{}{}, want: ErrForbidden},
+		{name: "author check reads the owner id", key: "author", id: alice, resource: ownerReq{"alice"}},
+		{name: "unregistered owner check is forbidden", key: "unknown", id: alice, want: ErrForbidden},
+		{name: "owner check error is returned", key: "failing", id: alice, want: errLookup},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Authorize(context.Background(), tt.key, tt.id, tt.resource)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Authorize(%q) = %v, want %v", tt.key, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package policy
+
+type Role string
+
+const (
+	RoleAdmin     Role = "admin"
+	RoleModerator Role = "moderator"
+	RoleUser      Role = "user"
+	RoleExpert    Role = "expert"
+)
+
+// Rank orders roles by the staff privileges they carry: admins outrank
+// moderators, who outrank everyone else.
+func (r Role) Rank() int {
+	switch r {
+	case RoleAdmin:
+		return 2
+	case RoleModerator:
+		return 1
+	}
+	return 0
+}
+
+func (r Role) IsAdmin() bool {
+	return r == RoleAdmin
+}
+
+func (r Role) IsModerator() bool {
+	return r == RoleModerator
+}
+
+func (r Role) IsUser() bool {
+	return r == RoleUser
+}
+
+func (r Role) IsExpert() bool {
+	return r == RoleExpert
+}
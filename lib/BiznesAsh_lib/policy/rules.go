@@ -0,0 +1,201 @@
+package policy
+
+import (
+	"os"
+	"strings"
+)
+
+// Ownership checks referenced by the rules below. OwnerSelf and OwnerAuthor
+// are built in; the rest are registered by whoever can resolve the resource.
+const (
+	OwnerSelf          = "self"
+	OwnerAuthor        = "author"
+	OwnerPostAuthor    = "post_author"
+	OwnerCommentAuthor = "comment_author"
+	OwnerBooking       = "booking_owner"
+	OwnerSubscription  = "subscription_owner"
+)
+
+var (
+	staff          = []Role{RoleAdmin, RoleModerator}
+	adminOnly      = []Role{RoleAdmin}
+	expertsOrAdmin = []Role{RoleExpert, RoleAdmin}
+)
+
+// Rules is the single place where privileges are configured for both the
+// gRPC services and the gateway's REST routes. Every gRPC method needs a rule:
+// the services refuse methods without one. REST routes without a rule are
+// left to the services they call.
+var Rules = map[string]Rule{
+	// Health checks, served by every service
+	"/grpc.health.v1.Health/Check": {Public: true},
+	"/grpc.health.v1.Health/List":  {Public: true},
+
+	// UserService
+	"/user.UserService/Register":             {Public: true},
+	"/user.UserService/Login":                {Public: true},
+	"/user.UserService/RefreshToken":         {Public: true},
+	"/user.UserService/RequestPasswordReset": {Public: true},
+	"/user.UserService/ResetPassword":        {Public: true},
+	"/user.UserService/GetJWKS":              {Public: true},
+	"/user.UserService/GetUser":              {Public: true},
+	"/user.UserService/GetUsersByIDs":        {Public: true},
+	"/user.UserService/Authorize":            {},
+	"/user.UserService/GetCurrentUser":       {},
+	"/user.UserService/UpdateProfile":        {},
+	"/user.UserService/PromoteToModerator":   {Roles: adminOnly},
+	"/user.UserService/PromoteToAdmin":       {Roles: adminOnly},
+	"/user.UserService/PromoteToExpert":      {Roles: adminOnly},
+	"/user.UserService/DemoteToUser":         {Roles: adminOnly},
+	"/user.UserService/DeleteAccount":        {Roles: adminOnly, Owner: OwnerSelf},
+	"/user.UserService/BanUser":              {Roles: staff},
+	"/user.UserService/UnbanUser":            {Roles: staff},
+	"/user.UserService/ListUsers":            {Roles: staff},
+	"/user.UserService/GetUserStats":         {Roles: adminOnly},
+	"/user.UserService/Logout":               {},
+	"/user.UserService/LogoutAllSessions":    {},
+	"/user.UserService/ListSessions":         {},
+	"/user.UserService/ChangePassword":       {},
+
+	// ContentService
+	"/content.ContentService/GetPost":         {Public: true},
+	"/content.ContentService/ListPosts":       {Public: true},
+	"/content.ContentService/SearchPosts":     {Public: true},
+	"/content.ContentService/ListComments":    {Public: true},
+	"/content.ContentService/GetMedia":        {Public: true},
+	"/content.ContentService/CreatePost":      {Owner: OwnerAuthor},
+	"/content.ContentService/UpdatePost":      {Roles: adminOnly, Owner: OwnerPostAuthor},
+	"/content.ContentService/DeletePost":      {Roles: staff, Owner: OwnerPostAuthor},
+	"/content.ContentService/CreateComment":   {Owner: OwnerAuthor},
+	"/content.ContentService/UpdateComment":   {Owner: OwnerCommentAuthor},
+	"/content.ContentService/DeleteComment":   {Roles: staff, Owner: OwnerCommentAuthor},
+	"/content.ContentService/LikePost":        {Owner: OwnerSelf},
+	"/content.ContentService/UnlikePost":      {Owner: OwnerSelf},
+	"/content.ContentService/LikeComment":     {Owner: OwnerSelf},
+	"/content.ContentService/UnlikeComment":   {Owner: OwnerSelf},
+	"/content.ContentService/VotePoll":        {Owner: OwnerSelf},
+	"/content.ContentService/CreateMedia":     {Owner: OwnerAuthor},
+	"/content.ContentService/GetContentStats": {Roles: adminOnly},
+
+	// NotificationService. The content notifications are sent from NATS
+	// events; their RPCs are for operators. Codes are checked and resent for
+	// the signed-in caller, who needn't be verified yet.
+	"/notification.NotificationService/SendVerificationEmail":   {},
+	"/notification.NotificationService/VerifyCode":              {},
+	"/notification.NotificationService/ResendCode":              {},
+	"/notification.NotificationService/SendContactRequest":      {Public: true},
+	"/notification.NotificationService/SendWelcomeEmail":        {Roles: adminOnly},
+	"/notification.NotificationService/NotifySystemMessage":     {Roles: staff},
+	"/notification.NotificationService/SendCommentNotification": {Roles: staff},
+	"/notification.NotificationService/SendReportNotification":  {Roles: staff},
+	"/notification.NotificationService/NotifyNewPost":           {Roles: staff},
+	"/notification.NotificationService/NotifyPostUpdate":        {Roles: staff},
+	"/notification.NotificationService/NotifyPostLike":          {Roles: staff},
+	"/notification.NotificationService/NotifyCommentLike":       {Roles: staff},
+	"/notification.NotificationService/SubscribeToUpdates":      {Roles: adminOnly, Owner: OwnerSelf},
+	"/notification.NotificationService/UnsubscribeFromUpdates":  {Roles: adminOnly, Owner: OwnerSelf},
+	"/notification.NotificationService/GetSubscriptions":        {Roles: adminOnly, Owner: OwnerSelf},
+	"/notification.NotificationService/GetNotifications":        {Roles: adminOnly, Owner: OwnerSelf},
+
+	// SubscriptionService
+	"/subscription.SubscriptionService/GetSubscription":        {Roles: adminOnly, Owner: OwnerSelf},
+	"/subscription.SubscriptionService/UpdateSubscription":     {Roles: adminOnly, Owner: OwnerSelf},
+	"/subscription.SubscriptionService/GetSubscriptionHistory": {Roles: adminOnly, Owner: OwnerSelf},
+	"/subscription.SubscriptionService/CancelSubscription":     {Roles: adminOnly, Owner: OwnerSubscription},
+	"/subscription.SubscriptionService/ListSubscriptions":      {Roles: adminOnly},
+	"/subscription.SubscriptionService/GetSubscriptionStats":   {Roles: adminOnly},
+
+	// PaymentService
+	"/payment.PaymentService/ProcessPayment":        {Roles: adminOnly, Owner: OwnerSelf},
+	"/payment.PaymentService/GetTransactionHistory": {Roles: adminOnly, Owner: OwnerSelf},
+	"/payment.PaymentService/GetPaymentStats":       {Roles: adminOnly},
+
+	// ConsultationService
+	"/consultation.ConsultationService/ListAvailableExperts":  {Public: true},
+	"/consultation.ConsultationService/RegisterExpert":        {Roles: expertsOrAdmin},
+	"/consultation.ConsultationService/CreateBooking":         {Roles: adminOnly, Owner: OwnerSelf},
+	"/consultation.ConsultationService/GetUserBookings":       {Roles: adminOnly, Owner: OwnerSelf},
+	"/consultation.ConsultationService/CancelBooking":         {Roles: adminOnly, Owner: OwnerBooking},
+	"/consultation.ConsultationService/ConfirmBookingPayment": {Roles: adminOnly, Owner: OwnerBooking},
+
+	// Gateway REST routes, without the /api/vN prefix
+	"PUT /users/:id":            {Owner: OwnerSelf},
+	"PUT /admin/users/:id/role": {Roles: adminOnly},
+	"DELETE /admin/users/:id":   {Roles: adminOnly},
+	"GET /admin/dashboard":      {Roles: adminOnly},
+}
+
+// forwardedRoutes are gateway routes that only forward to one RPC. They take
+// that RPC's rule, so the two can't drift apart.
+var forwardedRoutes = map[string]string{
+	"POST /auth/logout":           "/user.UserService/Logout",
+	"DELETE /auth/sessions/:id":   "/user.UserService/Logout",
+	"POST /auth/logout-all":       "/user.UserService/LogoutAllSessions",
+	"GET /auth/sessions":          "/user.UserService/ListSessions",
+	"POST /auth/password/change":  "/user.UserService/ChangePassword",
+	"POST /auth/verify-email":     "/notification.NotificationService/VerifyCode",
+	"POST /auth/resend-code":      "/notification.NotificationService/ResendCode",
+	"POST /notify/welcome":        "/notification.NotificationService/SendWelcomeEmail",
+	"POST /notify/system-message": "/notification.NotificationService/NotifySystemMessage",
+	"DELETE /content/posts/:id":   "/content.ContentService/DeletePost",
+	"POST /consultations/experts": "/consultation.ConsultationService/RegisterExpert",
+	"GET /admin/users":            "/user.UserService/ListUsers",
+	"POST /admin/users/:id/ban":   "/user.UserService/BanUser",
+	"POST /admin/users/:id/unban": "/user.UserService/UnbanUser",
+}
+
+func init() {
+	for route, method := range forwardedRoutes {
+		Rules[route] = Rules[method]
+	}
+}
+
+// VerifiedEmailRequired lists the rules closed to callers who haven't verified
+// their email address yet. REQUIRE_VERIFIED_EMAIL replaces it with a
+// comma-separated list of rule keys, or "none" to open them all. Keys without
+// a rule of their own get one requiring an authenticated, verified caller.
+var VerifiedEmailRequired = []string{
+	"/content.ContentService/CreatePost",
+	"/content.ContentService/CreateComment",
+	"/consultation.ConsultationService/CreateBooking",
+}
+
+// Default returns a policy over Rules with only the built-in owner checks
+// registered, requiring a verified email for VerifiedEmailRequired.
+func Default() *Policy {
+	return New(RequireVerified(Rules, LoadVerifiedEmailRequired()))
+}
+
+// LoadVerifiedEmailRequired returns VerifiedEmailRequired, or the list set in
+// REQUIRE_VERIFIED_EMAIL.
+func LoadVerifiedEmailRequired() []string {
+	value, ok := os.LookupEnv("REQUIRE_VERIFIED_EMAIL")
+	if !ok {
+		return VerifiedEmailRequired
+	}
+	if strings.TrimSpace(value) == "none" {
+		return nil
+	}
+	var keys []string
+	for _, key := range strings.Split(value, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// RequireVerified returns a copy of rules in which keys also require a
+// verified email.
+func RequireVerified(rules map[string]Rule, keys []string) map[string]Rule {
+	out := make(map[string]Rule, len(rules)+len(keys))
+	for key, rule := range rules {
+		out[key] = rule
+	}
+	for _, key := range keys {
+		rule := out[key]
+		rule.Verified = true
+		out[key] = rule
+	}
+	return out
+}
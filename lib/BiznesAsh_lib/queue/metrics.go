@@ -0,0 +1,32 @@
+package queue
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	natsPublished = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "nats_messages_published_total",
+			Help: "Messages published to NATS, by subject and result.",
+		},
+		[]string{"subject", "result"},
+	)
+	natsConsumed = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "nats_messages_consumed_total",
+			Help: "Messages received from NATS subscriptions, by subject.",
+		},
+		[]string{"subject"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(natsPublished, natsConsumed)
+}
+
+func observePublish(subject string, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	natsPublished.WithLabelValues(subject, result).Inc()
+}
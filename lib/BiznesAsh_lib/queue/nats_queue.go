@@ -0,0 +1,56 @@
+package queue
+
+import (
+	"github.com/nats-io/nats.go"
+	"log/slog"
+)
+
+type NATSQueue struct {
+	conn *nats.Conn
+}
+
+func NewNATSQueue(conn *nats.Conn) *NATSQueue {
+	return &NATSQueue{conn: conn}
+}
+
+func (n *NATSQueue) Publish(subject string, message []byte) error {
+	err := n.conn.Publish(subject, message)
+	observePublish(subject, err)
+	return err
+}
+
+func (n *NATSQueue) PublishMsg(msg *Message) error {
+	err := n.conn.PublishMsg(&nats.Msg{
+		Subject: msg.Subject,
+		Data:    msg.Data,
+		Header:  nats.Header(msg.Header),
+	})
+	observePublish(msg.Subject, err)
+	return err
+}
+
+func (n *NATSQueue) Subscribe(subject string, handler func(msg []byte)) error {
+	_, err := n.conn.Subscribe(subject, func(m *nats.Msg) {
+		natsConsumed.WithLabelValues(m.Subject).Inc()
+		handler(m.Data)
+	})
+	return err
+}
+
+func (n *NATSQueue) SubscribeMsg(subject string, handler func(msg *Message)) error {
+	_, err := n.conn.Subscribe(subject, func(m *nats.Msg) {
+		natsConsumed.WithLabelValues(m.Subject).Inc()
+		header := Header(m.Header)
+		if header == nil {
+			header = Header{}
+		}
+		handler(&Message{Subject: m.Subject, Data: m.Data, Header: header})
+	})
+	return err
+}
+
+func (n *NATSQueue) Close() error {
+	n.conn.Close()
+	slog.Info("NATS connection closed")
+	return nil
+}
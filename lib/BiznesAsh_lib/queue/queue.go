@@ -0,0 +1,45 @@
+package queue
+
+// Header carries message metadata such as trace context. Keys are case-sensitive,
+// as in NATS. It satisfies OpenTelemetry's propagation.TextMapCarrier.
+type Header map[string][]string
+
+// Get returns the first value for key, or "".
+func (h Header) Get(key string) string {
+	if values := h[key]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// Set replaces the values for key.
+func (h Header) Set(key, value string) {
+	h[key] = []string{value}
+}
+
+// Keys lists the header keys.
+func (h Header) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Message is a published or received message together with its headers.
+type Message struct {
+	Subject string
+	Data    []byte
+	Header  Header
+}
+
+type MessageQueue interface {
+	Publish(subject string, message []byte) error
+	// PublishMsg publishes msg including its headers.
+	PublishMsg(msg *Message) error
+	Subscribe(subject string, handler func(msg []byte)) error
+	// SubscribeMsg is Subscribe for handlers that need the message headers.
+	// Header is never nil.
+	SubscribeMsg(subject string, handler func(msg *Message)) error
+	Close() error
+}
@@ -0,0 +1,62 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"github.com/KaminurOrynbek/BiznesAsh_lib/queue"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/KaminurOrynbek/BiznesAsh_lib/tracing"
+
+// Publish sends data on subject through q inside a producer span and injects the
+// trace context into the message headers, so the consumer's span joins the trace.
+func Publish(ctx context.Context, q queue.MessageQueue, subject string, data []byte) error {
+	ctx, span := otel.Tracer(instrumentationName).Start(ctx, subject+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(messagingAttributes(subject, "publish")...),
+	)
+	defer span.End()
+
+	msg := &queue.Message{Subject: subject, Data: data, Header: queue.Header{}}
+	otel.GetTextMapPropagator().Inject(ctx, msg.Header)
+
+	err := q.PublishMsg(msg)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// Subscribe registers handler on subject. Each message is handled inside a consumer
+// span that continues the publisher's trace; handler receives that span's context,
+// which also carries the publisher's request id or, failing that, a new one.
+func Subscribe(q queue.MessageQueue, subject string, handler func(ctx context.Context, data []byte)) error {
+	return q.SubscribeMsg(subject, func(msg *queue.Message) {
+		ctx := otel.GetTextMapPropagator().Extract(context.Background(), msg.Header)
+		if logging.RequestID(ctx) == "" {
+			ctx = logging.WithRequestID(ctx, logging.NewRequestID())
+		}
+		ctx, span := otel.Tracer(instrumentationName).Start(ctx, msg.Subject+" process",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(messagingAttributes(msg.Subject, "process")...),
+		)
+		defer span.End()
+
+		handler(ctx, msg.Data)
+	})
+}
+
+func messagingAttributes(subject, operation string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		semconv.MessagingSystemKey.String("nats"),
+		semconv.MessagingDestinationName(subject),
+		semconv.MessagingOperationName(operation),
+	}
+}
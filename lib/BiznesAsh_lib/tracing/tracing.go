@@ -0,0 +1,120 @@
+// Package tracing sets up OpenTelemetry for a service and provides the gRPC and
+// NATS hooks that carry trace context between services.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+
+	"github.com/KaminurOrynbek/BiznesAsh_lib/logging"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Environment variables read by Init.
+const (
+	// EnvExporter selects the exporter: "none" (default), "stdout" or "file",
+	// or any name added with RegisterExporter.
+	EnvExporter = "OTEL_TRACES_EXPORTER"
+	// EnvFile is the output path of the "file" exporter.
+	EnvFile = "OTEL_TRACES_FILE"
+	// EnvServiceName overrides the service name passed to Init.
+	EnvServiceName = "OTEL_SERVICE_NAME"
+)
+
+const defaultTraceFile = "traces.jsonl"
+
+// ExporterFactory builds a span exporter. It is called once, from Init.
+type ExporterFactory func(ctx context.Context) (sdktrace.SpanExporter, error)
+
+var (
+	exportersMu sync.Mutex
+	exporters   = map[string]ExporterFactory{
+		"stdout": func(context.Context) (sdktrace.SpanExporter, error) {
+			return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		},
+		"file": func(context.Context) (sdktrace.SpanExporter, error) {
+			path := os.Getenv(EnvFile)
+			if path == "" {
+				path = defaultTraceFile
+			}
+			f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+			if err != nil {
+				return nil, err
+			}
+			return stdouttrace.New(stdouttrace.WithWriter(f))
+		},
+	}
+)
+
+// RegisterExporter makes an exporter selectable through OTEL_TRACES_EXPORTER,
+// e.g. an OTLP exporter in a deployment that runs a collector.
+func RegisterExporter(name string, factory ExporterFactory) {
+	exportersMu.Lock()
+	defer exportersMu.Unlock()
+	exporters[name] = factory
+}
+
+// Init installs the global tracer provider and W3C trace-context propagator for
+// serviceName, together with logging.Propagator so request ids travel with the
+// trace context. Spans are always created and propagated so trace ids flow between
+// services; they are only exported when an exporter is configured. The returned
+// function flushes pending spans and should be called on shutdown.
+func Init(serviceName string) (func(context.Context) error, error) {
+	ctx := context.Background()
+
+	if name := os.Getenv(EnvServiceName); name != "" {
+		serviceName = name
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build trace resource: %w", err)
+	}
+
+	opts := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+
+	if name := os.Getenv(EnvExporter); name != "" && name != "none" {
+		exportersMu.Lock()
+		factory, ok := exporters[name]
+		exportersMu.Unlock()
+		if !ok {
+			return nil, fmt.Errorf("unknown trace exporter %q", name)
+		}
+		exporter, err := factory(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s trace exporter: %w", name, err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+		slog.Info("tracing enabled", "service_name", serviceName, "exporter", name)
+	}
+
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+		logging.Propagator{},
+	))
+
+	return tp.Shutdown, nil
+}
+
+// MustInit is Init for main functions: it exits if tracing can't be set up.
+func MustInit(serviceName string) func(context.Context) error {
+	shutdown, err := Init(serviceName)
+	if err != nil {
+		logging.Fatal("failed to initialise tracing", logging.Err(err))
+	}
+	return shutdown
+}
@@ -0,0 +1,218 @@
+package validate
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Check inspects one value and returns a message describing what is wrong with
+// it, or "" if it passes. Apart from Required, checks pass a zero value, so an
+// optional field is only checked when it is set.
+type Check func(v interface{}) string
+
+// Password length bounds. bcrypt ignores everything past 72 bytes.
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 72
+)
+
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// Required rejects an empty or blank string, a zero number, an empty list and
+// an unset message.
+func Required(v interface{}) string {
+	if isZero(v) {
+		return "is required"
+	}
+	if s, ok := v.(string); ok && strings.TrimSpace(s) == "" {
+		return "is required"
+	}
+	return ""
+}
+
+// Email accepts a bare address such as "alice@example.com".
+func Email(v interface{}) string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return ""
+	}
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Address != s || len(s) > 254 {
+		return "must be a valid email address"
+	}
+	return ""
+}
+
+// Username accepts letters, digits, '_', '.' and '-'.
+func Username(v interface{}) string {
+	s, ok := v.(string)
+	if !ok || s == "" || usernamePattern.MatchString(s) {
+		return ""
+	}
+	return "may only contain letters, digits, '_', '.' and '-'"
+}
+
+// Password enforces the length bounds and at least one letter and one digit.
+func Password(v interface{}) string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return ""
+	}
+	if len(s) < MinPasswordLength || len(s) > MaxPasswordLength {
+		return fmt.Sprintf("must be between %d and %d bytes long", MinPasswordLength, MaxPasswordLength)
+	}
+	if !strings.ContainsFunc(s, unicode.IsLetter) || !strings.ContainsFunc(s, unicode.IsDigit) {
+		return "must contain at least one letter and one digit"
+	}
+	return ""
+}
+
+// Length bounds a string's length in characters.
+func Length(min, max int) Check {
+	return func(v interface{}) string {
+		s, ok := v.(string)
+		if !ok || s == "" {
+			return ""
+		}
+		if n := utf8.RuneCountInString(s); n < min || n > max {
+			return fmt.Sprintf("must be between %d and %d characters long", min, max)
+		}
+		return ""
+	}
+}
+
+// MaxLen caps a string's length in characters.
+func MaxLen(max int) Check {
+	return func(v interface{}) string {
+		if s, ok := v.(string); ok && utf8.RuneCountInString(s) > max {
+			return fmt.Sprintf("must be at most %d characters long", max)
+		}
+		return ""
+	}
+}
+
+// OneOf accepts only the listed strings.
+func OneOf(allowed ...string) Check {
+	return func(v interface{}) string {
+		s, ok := v.(string)
+		if !ok || s == "" {
+			return ""
+		}
+		for _, a := range allowed {
+			if s == a {
+				return ""
+			}
+		}
+		return "must be one of " + strings.Join(allowed, ", ")
+	}
+}
+
+// Range bounds a number, inclusive.
+func Range(min, max float64) Check {
+	return func(v interface{}) string {
+		n, ok := number(v)
+		if !ok || n == 0 {
+			return ""
+		}
+		if n < min || n > max {
+			return fmt.Sprintf("must be between %g and %g", min, max)
+		}
+		return ""
+	}
+}
+
+// Positive rejects negative numbers; combine with Required to reject zero too.
+func Positive(v interface{}) string {
+	if n, ok := number(v); ok && n < 0 {
+		return "must be greater than 0"
+	}
+	return ""
+}
+
+// Count bounds the number of elements of a repeated field.
+func Count(min, max int) Check {
+	return func(v interface{}) string {
+		list, ok := v.([]interface{})
+		if !ok || len(list) == 0 {
+			return ""
+		}
+		if len(list) < min || len(list) > max {
+			return fmt.Sprintf("must have between %d and %d items", min, max)
+		}
+		return ""
+	}
+}
+
+// Timestamp accepts an RFC 3339 time such as "2030-01-01T10:00:00Z".
+func Timestamp(v interface{}) string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return ""
+	}
+	if _, err := time.Parse(time.RFC3339, s); err != nil {
+		return "must be an RFC 3339 timestamp"
+	}
+	return ""
+}
+
+// Future accepts an RFC 3339 time that hasn't passed yet.
+func Future(v interface{}) string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return ""
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return "must be an RFC 3339 timestamp"
+	}
+	if !t.After(time.Now()) {
+		return "must be in the future"
+	}
+	return ""
+}
+
+// Digits accepts a string of exactly n ASCII digits, e.g. a verification code.
+func Digits(n int) Check {
+	return func(v interface{}) string {
+		s, ok := v.(string)
+		if !ok || s == "" {
+			return ""
+		}
+		if len(s) != n || strings.ContainsFunc(s, func(r rune) bool { return r < '0' || r > '9' }) {
+			return fmt.Sprintf("must be %d digits", n)
+		}
+		return ""
+	}
+}
+
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func isZero(v interface{}) bool {
+	switch x := v.(type) {
+	case nil:
+		return true
+	case string:
+		return x == ""
+	case bool:
+		return !x
+	case []interface{}:
+		return len(x) == 0
+	}
+	n, ok := number(v)
+	return ok && n == 0
+}
@@ -0,0 +1,196 @@
+package validate
+
+// Limits shared by the rules below and by callers that want to mention them.
+const (
+	MaxTitleLength   = 200
+	MaxPostLength    = 20000
+	MaxCommentLength = 5000
+	MaxBioLength     = 500
+	MaxMediaPerPost  = 10
+	MaxPageSize      = 100
+	MaxBatchSize     = 1000
+	MaxPollDuration  = 24 * 365 // hours
+)
+
+// Values accepted for enumerated string fields.
+var (
+	PlanTypes      = []string{"BASIC", "PRO"}
+	Currencies     = []string{"KZT", "USD", "EUR", "RUB"}
+	ReferenceTypes = []string{"SUBSCRIPTION", "CONSULTATION"}
+	Roles          = []string{"user", "moderator", "expert", "admin"}
+	UserStatuses   = []string{"active", "unverified", "suspended", "banned"}
+	BanReasons     = []string{"spam", "harassment", "inappropriate_content", "fraud", "impersonation", "other"}
+	UnbanReasons   = []string{"appeal_accepted", "issued_in_error", "other"}
+)
+
+var (
+	id       = []Check{Required, MaxLen(64)}
+	email    = []Check{Required, Email}
+	page     = Range(1, 1e6)
+	pageSize = Range(1, MaxPageSize)
+)
+
+// Messages holds the rules for every request message, keyed by protobuf full
+// name. The gateway checks them before calling a service and each service
+// checks them again in UnaryServerInterceptor.
+var Messages = map[string][]Field{
+	// UserService
+	"user.RegisterRequest": {
+		F("email", email...),
+		F("username", Required, Length(3, 32), Username),
+		F("password", Required, Password),
+	},
+	"user.LoginRequest": {
+		F("email", email...),
+		F("password", Required, MaxLen(MaxPasswordLength)),
+	},
+	"user.TokenRequest": {
+		F("token", Required),
+	},
+	"user.UpdateProfileRequest": {
+		F("username", Length(3, 32), Username),
+		F("bio", MaxLen(MaxBioLength)),
+	},
+	"user.GetUserRequest": {F("userId", id...)},
+	"user.GetUsersByIDsRequest": {
+		{Name: "userIds", Checks: []Check{Count(0, MaxBatchSize)}, Each: []Check{MaxLen(64)}},
+	},
+	"user.UserID":            {F("userId", id...)},
+	"user.RoleChangeRequest": {F("userId", id...)},
+	"user.ListUsersRequest": {
+		F("searchQuery", MaxLen(100)),
+		F("role", OneOf(Roles...)),
+		F("status", OneOf(UserStatuses...)),
+		F("page", page),
+		F("limit", pageSize),
+	},
+	"user.BanUserRequest": {
+		F("userId", id...),
+		F("reason", OneOf(BanReasons...)),
+		F("details", MaxLen(500)),
+		F("expiresAt", Future),
+	},
+	"user.UnbanUserRequest": {
+		F("userId", id...),
+		F("reason", OneOf(UnbanReasons...)),
+		F("details", MaxLen(500)),
+	},
+	"user.RefreshTokenRequest":  {F("refreshToken", Required, MaxLen(128))},
+	"user.LogoutRequest":        {F("sessionId", MaxLen(64))},
+	"user.PasswordResetRequest": {F("email", email...)},
+	"user.ResetPasswordRequest": {
+		F("token", Required, MaxLen(128)),
+		F("newPassword", Required, Password),
+	},
+	"user.ChangePasswordRequest": {
+		F("currentPassword", Required, MaxLen(MaxPasswordLength)),
+		F("newPassword", Required, Password),
+	},
+
+	// ContentService
+	"content.CreatePostRequest": {
+		F("title", Required, MaxLen(MaxTitleLength)),
+		F("content", Required, MaxLen(MaxPostLength)),
+		F("author_id", id...),
+		{Name: "media_ids", Checks: []Check{Count(0, MaxMediaPerPost)}, Each: id},
+		{Name: "poll", Fields: []Field{
+			F("question", Required, MaxLen(MaxTitleLength)),
+			{Name: "options", Checks: []Check{Required, Count(2, 10)}, Each: []Check{Required, MaxLen(100)}},
+			F("duration_hours", Range(1, MaxPollDuration)),
+		}},
+	},
+	"content.UpdatePostRequest": {
+		F("id", id...),
+		F("title", MaxLen(MaxTitleLength)),
+		F("content", MaxLen(MaxPostLength)),
+	},
+	"content.PostIdRequest":      {F("id", id...)},
+	"content.ListPostsRequest":   {F("page", page), F("limit", pageSize)},
+	"content.SearchPostsRequest": {F("query", Required, MaxLen(100)), F("page", page), F("limit", pageSize)},
+	"content.CreateCommentRequest": {
+		F("post_id", id...),
+		F("author_id", id...),
+		F("content", Required, MaxLen(MaxCommentLength)),
+	},
+	"content.UpdateCommentRequest": {
+		F("id", id...),
+		F("content", Required, MaxLen(MaxCommentLength)),
+	},
+	"content.CommentIdRequest":     {F("id", id...)},
+	"content.ListCommentsRequest":  {F("post_id", id...)},
+	"content.LikePostRequest":      {F("post_id", id...), F("user_id", id...)},
+	"content.UnlikePostRequest":    {F("post_id", id...), F("user_id", id...)},
+	"content.LikeCommentRequest":   {F("comment_id", id...), F("user_id", id...)},
+	"content.UnlikeCommentRequest": {F("comment_id", id...), F("user_id", id...)},
+	"content.VotePollRequest":      {F("post_id", id...), F("option_id", id...), F("user_id", id...)},
+	"content.MediaIdRequest":       {F("id", id...)},
+	"content.CreateMediaRequest": {
+		F("id", id...),
+		F("owner_id", id...),
+		F("content_type", Required, MaxLen(100)),
+		F("size", Required, Positive),
+		F("filename", MaxLen(255)),
+	},
+
+	// NotificationService
+	"notification.EmailRequest": {
+		F("email", email...),
+		F("subject", MaxLen(MaxTitleLength)),
+		F("body", MaxLen(MaxPostLength)),
+	},
+	"notification.SystemMessageRequest": {
+		F("userId", MaxLen(64)),
+		F("message", Required, MaxLen(MaxPostLength)),
+	},
+	"notification.ContactRequest": {
+		F("name", Required, MaxLen(100)),
+		F("email", email...),
+		F("subject", Required, MaxLen(MaxTitleLength)),
+		F("message", Required, MaxLen(MaxCommentLength)),
+	},
+	"notification.CommentNotification":     {F("userId", id...), F("postId", id...), F("timestamp", Timestamp)},
+	"notification.ReportNotification":      {F("userId", id...), F("postId", id...), F("reason", Required, MaxLen(MaxCommentLength))},
+	"notification.NewPostNotification":     {F("userId", id...)},
+	"notification.PostUpdateNotification":  {F("userId", id...), F("postId", id...)},
+	"notification.PostLikeNotification":    {F("userId", id...), F("postId", id...)},
+	"notification.CommentLikeNotification": {F("userId", id...), F("commentId", id...)},
+	"notification.UserID":                  {F("userId", id...)},
+	"notification.GetNotificationsRequest": {F("userId", id...), F("page", page), F("limit", pageSize)},
+	"notification.VerifyCodeRequest":       {F("code", Required, Digits(6))},
+
+	// SubscriptionService
+	"subscription.GetSubscriptionRequest":    {F("user_id", id...)},
+	"subscription.CancelSubscriptionRequest": {F("id", id...)},
+	"subscription.UpdateSubscriptionRequest": {
+		F("user_id", id...),
+		F("plan_type", Required, OneOf(PlanTypes...)),
+		F("duration_months", Required, Range(1, 36)),
+	},
+
+	// PaymentService
+	"payment.ProcessPaymentRequest": {
+		F("user_id", id...),
+		F("amount", Required, Range(0.01, 1e9)),
+		F("currency", Required, OneOf(Currencies...)),
+		F("reference_type", Required, OneOf(ReferenceTypes...)),
+		F("reference_id", id...),
+		F("idempotency_key", MaxLen(255)),
+	},
+	"payment.GetHistoryRequest": {F("user_id", id...)},
+
+	// ConsultationService
+	"consultation.ExpertData": {
+		F("user_id", id...),
+		F("specialization", Required, MaxLen(100)),
+		F("price_per_session", Required, Range(1, 1e7)),
+	},
+	"consultation.Filter":                 {F("specialization", MaxLen(100))},
+	"consultation.GetUserBookingsRequest": {F("user_id", id...)},
+	"consultation.CancelBookingRequest":   {F("booking_id", id...)},
+	"consultation.ConfirmPaymentRequest":  {F("booking_id", id...)},
+	"consultation.BookingData": {
+		F("user_id", id...),
+		F("expert_id", id...),
+		F("scheduled_at", Required, Future),
+	},
+}
@@ -0,0 +1,230 @@
+// Package validate checks requests against declarative field rules. The same
+// rules run in the gateway, before a request leaves it, and in every service
+// through UnaryServerInterceptor, so a service never trusts its caller to have
+// checked. Violations are reported per field and cross the wire as a
+// google.rpc.BadRequest status detail.
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Violation is one field that failed a check. Field is the path in the
+// request as the client wrote it, e.g. "poll.options[2]".
+type Violation struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Error lists every violation found in a request.
+type Error struct {
+	Violations []Violation
+}
+
+func (e *Error) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		parts = append(parts, v.Field+": "+v.Message)
+	}
+	return "invalid request: " + strings.Join(parts, "; ")
+}
+
+// Field declares the checks for one field of a request.
+type Field struct {
+	// Name is the protobuf field name, or the JSON name for Go structs. A name
+	// the type doesn't have is skipped.
+	Name string
+	// Checks run against the field's value.
+	Checks []Check
+	// Each runs against every element of a repeated field.
+	Each []Check
+	// Fields validates a nested message or struct, when it is set.
+	Fields []Field
+}
+
+// F declares a field with checks; use a Field literal for Each and Fields.
+func F(name string, checks ...Check) Field {
+	return Field{Name: name, Checks: checks}
+}
+
+// Message checks m against the rules registered for its type in Messages.
+// Messages without rules are valid.
+func Message(m proto.Message) error {
+	if m == nil {
+		return nil
+	}
+	fields, ok := Messages[string(m.ProtoReflect().Descriptor().FullName())]
+	if !ok {
+		return nil
+	}
+	return result(check(protoValue{m.ProtoReflect()}, fields, ""))
+}
+
+// Struct checks v, a struct or a pointer to one, against fields keyed by the
+// struct's JSON names.
+func Struct(v interface{}, fields []Field) error {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	return result(check(structValue{rv}, fields, ""))
+}
+
+func result(violations []Violation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	return &Error{Violations: violations}
+}
+
+// object is a message or struct whose fields can be looked up by name. Values
+// come back normalised to string, int64, uint64, float64, bool,
+// []interface{} for repeated fields and object for nested ones; an unset
+// nested field is nil.
+type object interface {
+	get(name string) (interface{}, bool)
+}
+
+func check(obj object, fields []Field, prefix string) []Violation {
+	var violations []Violation
+	for _, f := range fields {
+		value, ok := obj.get(f.Name)
+		if !ok {
+			continue
+		}
+		path := prefix + f.Name
+		if msg := firstFailure(f.Checks, value); msg != "" {
+			violations = append(violations, Violation{Field: path, Message: msg})
+			continue
+		}
+		if list, ok := value.([]interface{}); ok && len(f.Each) > 0 {
+			for i, elem := range list {
+				if msg := firstFailure(f.Each, elem); msg != "" {
+					violations = append(violations, Violation{Field: fmt.Sprintf("%s[%d]", path, i), Message: msg})
+				}
+			}
+		}
+		if nested, ok := value.(object); ok && len(f.Fields) > 0 {
+			violations = append(violations, check(nested, f.Fields, path+".")...)
+		}
+	}
+	return violations
+}
+
+// firstFailure reports only the first failed check, so "is required" isn't
+// followed by a length complaint about the same empty value.
+func firstFailure(checks []Check, value interface{}) string {
+	for _, c := range checks {
+		if msg := c(value); msg != "" {
+			return msg
+		}
+	}
+	return ""
+}
+
+type protoValue struct {
+	m protoreflect.Message
+}
+
+func (p protoValue) get(name string) (interface{}, bool) {
+	fd := p.m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil {
+		return nil, false
+	}
+	if fd.IsList() {
+		list := p.m.Get(fd).List()
+		out := make([]interface{}, list.Len())
+		for i := range out {
+			out[i] = protoScalar(fd, list.Get(i))
+		}
+		return out, true
+	}
+	if fd.IsMap() {
+		return nil, true
+	}
+	if fd.Message() != nil {
+		if !p.m.Has(fd) {
+			return nil, true
+		}
+		return protoValue{p.m.Get(fd).Message()}, true
+	}
+	return protoScalar(fd, p.m.Get(fd)), true
+}
+
+func protoScalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return v.String()
+	case protoreflect.BoolKind:
+		return v.Bool()
+	case protoreflect.EnumKind:
+		return int64(v.Enum())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return v.Int()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return v.Uint()
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return v.Float()
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return protoValue{v.Message()}
+	}
+	return v.Interface()
+}
+
+type structValue struct {
+	v reflect.Value
+}
+
+func (s structValue) get(name string) (interface{}, bool) {
+	t := s.v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if jsonName(t.Field(i)) == name {
+			return goValue(s.v.Field(i)), true
+		}
+	}
+	return nil, false
+}
+
+func jsonName(f reflect.StructField) string {
+	tag := f.Tag.Get("json")
+	if tag == "" {
+		return f.Name
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	return name
+}
+
+func goValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Slice, reflect.Array:
+		out := make([]interface{}, v.Len())
+		for i := range out {
+			out[i] = goValue(v.Index(i))
+		}
+		return out
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil
+		}
+		return goValue(v.Elem())
+	case reflect.Struct:
+		return structValue{v}
+	}
+	return v.Interface()
+}